@@ -1,29 +1,34 @@
 package main
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/format"
 	"go/token"
 	"go/types"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"golang.org/x/tools/go/packages"
 
 	"github.com/kaptinlin/gozod/pkg/tagparser"
 )
 
-var errUnsupportedFieldType = errors.New("unsupported field type")
-
-// timeType is a marker type for time.Time detection.
-type timeType struct{}
+var (
+	errUnsupportedFieldType = errors.New("unsupported field type")
+	errEmbeddedPointer      = errors.New("promoted fields of an embedded pointer are not supported; embed the struct by value")
+)
 
 // StructAnalyzer analyzes Go source files to find structs requiring code generation.
 type StructAnalyzer struct {
 	fset         *token.FileSet
 	info         *types.Info
+	pkg          *types.Package
 	ruleTagName  string // struct tag used for validation rules (default "gozod")
 	fieldNameTag string // struct tag used for field names (default "json")
 }
@@ -34,8 +39,12 @@ type GenerationInfo struct {
 	Package     string                // Package name
 	Fields      []tagparser.FieldInfo // Field information from tagparser
 	Imports     []string              // Required imports
+	ImportPaths map[string]string     // Import paths of the package qualifiers field types may use, by qualifier
 	HasGenerate bool                  // Whether struct has //go:generate gozodgen directive
 	FilePath    string                // Source file path
+	Enums       map[string][]any      // Declared constants of enum_type field types, by type name
+	Marshalers  map[string]bool       // enum_type field types that marshal to text, by type name
+	Interfaces  map[string]bool       // Interface field types validated by registered unions, by type name
 }
 
 // NewStructAnalyzer creates a new AST analyzer instance.
@@ -80,6 +89,7 @@ func (a *StructAnalyzer) AnalyzePackage(pkgPath string) ([]*GenerationInfo, erro
 
 	a.fset = pkg.Fset
 	a.info = pkg.TypesInfo
+	a.pkg = pkg.Types
 
 	allStructs := make([]*GenerationInfo, 0)
 	for _, file := range pkg.Syntax {
@@ -97,6 +107,7 @@ func (a *StructAnalyzer) AnalyzePackage(pkgPath string) ([]*GenerationInfo, erro
 func (a *StructAnalyzer) analyzeFile(fileName string, file *ast.File, pkgName string) ([]*GenerationInfo, error) {
 	var structs []*GenerationInfo
 	imports := a.extractImports(file)
+	importPaths := a.importPaths(file)
 
 	for _, decl := range file.Decls {
 		genDecl, ok := decl.(*ast.GenDecl)
@@ -115,12 +126,18 @@ func (a *StructAnalyzer) analyzeFile(fileName string, file *ast.File, pkgName st
 			if !ok {
 				continue
 			}
+			// Generic structs have no schema until instantiated; fields of
+			// an instantiation such as Page[User] use FromStruct.
+			if typeSpec.TypeParams != nil {
+				continue
+			}
 
 			info, err := a.analyzeStruct(typeSpec.Name.Name, structType, pkgName, fileName, imports, hasGenerate)
 			if err != nil {
 				return nil, fmt.Errorf("analyze struct %s: %w", typeSpec.Name.Name, err)
 			}
 			if info != nil && (len(info.Fields) > 0 || hasGenerate) {
+				info.ImportPaths = importPaths
 				structs = append(structs, info)
 			}
 		}
@@ -131,7 +148,7 @@ func (a *StructAnalyzer) analyzeFile(fileName string, file *ast.File, pkgName st
 
 // analyzeStruct analyzes a single struct declaration.
 func (a *StructAnalyzer) analyzeStruct(name string, structType *ast.StructType, pkgName, fileName string, imports []string, hasGenerate bool) (*GenerationInfo, error) {
-	fields, err := a.parseStructFields(structType, hasGenerate)
+	fields, named, err := a.parseStructFields(structType, hasGenerate)
 	if err != nil {
 		return nil, fmt.Errorf("parse struct fields: %w", err)
 	}
@@ -143,6 +160,9 @@ func (a *StructAnalyzer) analyzeStruct(name string, structType *ast.StructType,
 		Imports:     imports,
 		HasGenerate: hasGenerate,
 		FilePath:    fileName,
+		Enums:       named.enums,
+		Marshalers:  named.marshalers,
+		Interfaces:  named.interfaces,
 	}, nil
 }
 
@@ -158,6 +178,23 @@ func (a *StructAnalyzer) extractImports(file *ast.File) []string {
 	return imports
 }
 
+// importPaths maps the qualifiers field type names may use, the package names
+// of the package's imports and the aliases of file's imports, to their paths.
+func (a *StructAnalyzer) importPaths(file *ast.File) map[string]string {
+	paths := make(map[string]string)
+	if a.pkg != nil {
+		for _, imported := range a.pkg.Imports() {
+			paths[imported.Name()] = imported.Path()
+		}
+	}
+	for _, imp := range file.Imports {
+		if imp.Name != nil && imp.Name.Name != "_" && imp.Name.Name != "." {
+			paths[imp.Name.Name] = strings.Trim(imp.Path.Value, `"`)
+		}
+	}
+	return paths
+}
+
 // hasGenerateDirective reports whether comments contain //go:generate gozodgen.
 func hasGenerateDirective(comments *ast.CommentGroup) bool {
 	if comments == nil {
@@ -174,12 +211,34 @@ func hasGenerateDirective(comments *ast.CommentGroup) bool {
 	return false
 }
 
-// parseStructFields parses struct fields from AST and extracts tag information.
-func (a *StructAnalyzer) parseStructFields(structType *ast.StructType, hasGenerate bool) ([]tagparser.FieldInfo, error) {
-	var fields []tagparser.FieldInfo
+// fieldEntry is a field competing for a key in the struct's shape. info is
+// nil for a field that takes part in key dominance without a schema.
+type fieldEntry struct {
+	candidate tagparser.FieldCandidate
+	info      *tagparser.FieldInfo
+}
+
+// parseStructFields parses struct fields from AST and extracts tag information,
+// along with the named types its fields use. The exported fields of anonymous
+// struct embeds are promoted and resolve key conflicts as encoding/json does.
+func (a *StructAnalyzer) parseStructFields(structType *ast.StructType, hasGenerate bool) ([]tagparser.FieldInfo, *namedFieldTypes, error) {
+	var entries []fieldEntry
+	named := &namedFieldTypes{}
 
 	for _, field := range structType.Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			tag = strings.Trim(field.Tag.Value, "`")
+		}
 		if len(field.Names) == 0 {
+			embed, ok := a.info.Types[field.Type]
+			if !ok || embed.Type == nil {
+				return nil, named, fmt.Errorf("type information is unavailable at %s", a.fset.Position(field.Pos()))
+			}
+			v := types.NewField(field.Pos(), nil, getTypeNameFromAST(embeddedTypeExpr(field.Type)), embed.Type, true)
+			if err := a.addTypedField(&entries, named, v, tag, promotion{}, hasGenerate); err != nil {
+				return nil, named, err
+			}
 			continue
 		}
 
@@ -192,6 +251,10 @@ func (a *StructAnalyzer) parseStructFields(structType *ast.StructType, hasGenera
 			if skip {
 				continue
 			}
+			entry := fieldEntry{candidate: tagparser.FieldCandidate{
+				Key:    fieldKey,
+				Tagged: tagparser.NamedByTag(a.fieldNameTag, reflect.StructField{Tag: reflect.StructTag(tag)}),
+			}}
 
 			info := tagparser.FieldInfo{
 				Name:     name.Name,
@@ -199,25 +262,280 @@ func (a *StructAnalyzer) parseStructFields(structType *ast.StructType, hasGenera
 				FieldKey: fieldKey,
 			}
 
-			hasGozodTag, err := a.applyGoZodTag(&info, field)
+			hasGozodTag, err := a.applyRuleTag(&info, tag)
 			if err != nil {
-				return nil, fmt.Errorf("parse gozod tag for field %s: %w", name.Name, err)
+				return nil, named, fmt.Errorf("parse gozod tag for field %s: %w", name.Name, err)
 			}
-			if !hasGozodTag && !hasGenerate {
-				continue
+			if (hasGozodTag || hasGenerate) && info.GoZodTag != "-" {
+				fieldType, err := a.getReflectType(field.Type)
+				if err != nil {
+					return nil, named, fmt.Errorf("resolve type for field %s: %w", name.Name, err)
+				}
+				info.Type = fieldType
+				a.collectNamedTypes(field.Type, named, usesEnumType(info))
+				entry.info = &info
 			}
+			entries = append(entries, entry)
+		}
+	}
 
-			fieldType, err := a.getReflectType(field.Type)
-			if err != nil {
-				return nil, fmt.Errorf("resolve type for field %s: %w", name.Name, err)
+	return dominantFields(entries), named, nil
+}
+
+// promotion is the path from the analyzed struct to a field it promotes.
+type promotion struct {
+	depth   int
+	skipped bool         // an embed on the path carries the rule tag "-"
+	pointer string       // the first embedded pointer on the path, if any
+	embeds  []types.Type // the embedded struct types on the path
+}
+
+// through returns the path extended by the embed of type embedded.
+func (p promotion) through(embedded types.Type, ruleTag, pointer string) promotion {
+	return promotion{
+		depth:   p.depth + 1,
+		skipped: p.skipped || ruleTag == "-",
+		pointer: cmp.Or(p.pointer, pointer),
+		embeds:  append(slices.Clip(p.embeds), embedded),
+	}
+}
+
+// addTypedField adds the entry of a field known by its type information: an
+// anonymous field, or a field it promotes. A struct embed without a
+// field-name tag naming it contributes its fields instead. Generated parsers
+// read promoted fields through the embed, so a field that needs a schema
+// must not be promoted through an embedded pointer.
+func (a *StructAnalyzer) addTypedField(entries *[]fieldEntry, named *namedFieldTypes, v *types.Var, tag string, path promotion, hasGenerate bool) error {
+	field := reflect.StructField{Name: v.Name(), Tag: reflect.StructTag(tag)}
+	key := tagparser.FieldName(a.fieldNameTag, field)
+	if key.Skip {
+		return nil
+	}
+	tagged := tagparser.NamedByTag(a.fieldNameTag, field)
+	ruleTag, hasRuleTag := lookupTagValue(tag, a.ruleTagName)
+
+	if v.Embedded() && !tagged {
+		embedded, pointer := types.Unalias(v.Type()), ""
+		if p, ok := embedded.(*types.Pointer); ok {
+			embedded, pointer = types.Unalias(p.Elem()), a.typeString(v.Type())
+		}
+		if promoted, ok := embedded.Underlying().(*types.Struct); ok {
+			if pointer != "" && !v.Exported() {
+				return nil
 			}
-			info.Type = fieldType
+			// An embed already on the path promotes nothing new.
+			if slices.ContainsFunc(path.embeds, func(t types.Type) bool { return types.Identical(t, embedded) }) {
+				return nil
+			}
+			next := path.through(embedded, ruleTag, pointer)
+			for i := range promoted.NumFields() {
+				if err := a.addTypedField(entries, named, promoted.Field(i), promoted.Tag(i), next, hasGenerate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if !v.Exported() {
+		return nil
+	}
 
-			fields = append(fields, info)
+	entry := fieldEntry{candidate: tagparser.FieldCandidate{Key: key.Name, Depth: path.depth, Tagged: tagged}}
+	if !path.skipped && ruleTag != "-" && (hasRuleTag || hasGenerate) {
+		if path.pointer != "" {
+			return fmt.Errorf("field %s of embedded %s: %w", v.Name(), path.pointer, errEmbeddedPointer)
 		}
+		info := tagparser.FieldInfo{
+			Name:     v.Name(),
+			TypeName: a.typeString(v.Type()),
+			FieldKey: key.Name,
+		}
+		if _, err := a.applyRuleTag(&info, tag); err != nil {
+			return fmt.Errorf("parse gozod tag for field %s: %w", v.Name(), err)
+		}
+		fieldType, err := a.typesToReflectType(v.Type())
+		if err != nil {
+			return fmt.Errorf("resolve type for field %s: %w %s", v.Name(), err, a.typeString(v.Type()))
+		}
+		info.Type = fieldType
+		a.collectTypeNames(v.Type(), named, usesEnumType(info))
+		entry.info = &info
 	}
+	*entries = append(*entries, entry)
+	return nil
+}
 
-	return fields, nil
+// dominantFields returns the schema fields of the entries that win their
+// key, in declaration order.
+func dominantFields(entries []fieldEntry) []tagparser.FieldInfo {
+	candidates := make([]tagparser.FieldCandidate, len(entries))
+	for i, entry := range entries {
+		candidates[i] = entry.candidate
+	}
+	var fields []tagparser.FieldInfo
+	for _, i := range tagparser.DominantFields(candidates) {
+		if info := entries[i].info; info != nil {
+			fields = append(fields, *info)
+		}
+	}
+	return fields
+}
+
+// embeddedTypeExpr returns the type name expression of an anonymous field,
+// which names the field.
+func embeddedTypeExpr(expr ast.Expr) ast.Expr {
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+		case *ast.IndexExpr:
+			expr = t.X
+		case *ast.IndexListExpr:
+			expr = t.X
+		case *ast.SelectorExpr:
+			return t.Sel
+		default:
+			return expr
+		}
+	}
+}
+
+func usesEnumType(field tagparser.FieldInfo) bool {
+	isEnumType := func(rule tagparser.TagRule) bool {
+		return rule.Name == string(tagparser.RuleEnumType)
+	}
+	return slices.ContainsFunc(field.Rules, isEnumType) ||
+		slices.ContainsFunc(field.KeyRules, isEnumType) ||
+		slices.ContainsFunc(field.ElemRules, isEnumType)
+}
+
+// namedFieldTypes holds, by the name fields spell them with, the declared
+// constants of the enum_type types, the enum_type types that marshal to
+// text, and the interface types fields use.
+type namedFieldTypes struct {
+	enums      map[string][]any
+	marshalers map[string]bool
+	interfaces map[string]bool
+}
+
+// record adds the named type t, spelled name, when it is an interface type
+// with methods, or, when enums is set, a type that marshals to text or has
+// declared constants.
+func (n *namedFieldTypes) record(name string, t types.Type, enums bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return
+	}
+	if iface, ok := named.Underlying().(*types.Interface); ok && !iface.Empty() {
+		if n.interfaces == nil {
+			n.interfaces = make(map[string]bool)
+		}
+		n.interfaces[name] = true
+		return
+	}
+	if !enums {
+		return
+	}
+	if marshalsToText(named) {
+		if n.marshalers == nil {
+			n.marshalers = make(map[string]bool)
+		}
+		n.marshalers[name] = true
+		return
+	}
+	if values := declaredConstants(named); len(values) > 0 {
+		if n.enums == nil {
+			n.enums = make(map[string][]any)
+		}
+		n.enums[name] = values
+	}
+}
+
+// collectNamedTypes records, under the name the field's type expression
+// spells it with, every named type in expr, looking through pointers, slices,
+// arrays and maps.
+func (a *StructAnalyzer) collectNamedTypes(expr ast.Expr, named *namedFieldTypes, enums bool) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		a.collectNamedTypes(t.X, named, enums)
+	case *ast.ArrayType:
+		a.collectNamedTypes(t.Elt, named, enums)
+	case *ast.MapType:
+		a.collectNamedTypes(t.Key, named, enums)
+		a.collectNamedTypes(t.Value, named, enums)
+	case *ast.Ident, *ast.SelectorExpr:
+		named.record(getTypeNameFromAST(expr), a.info.TypeOf(expr), enums)
+	}
+}
+
+// collectTypeNames is collectNamedTypes for a promoted field, whose type has
+// no expression in the analyzed file.
+func (a *StructAnalyzer) collectTypeNames(t types.Type, named *namedFieldTypes, enums bool) {
+	switch typ := types.Unalias(t).(type) {
+	case *types.Pointer:
+		a.collectTypeNames(typ.Elem(), named, enums)
+	case *types.Slice:
+		a.collectTypeNames(typ.Elem(), named, enums)
+	case *types.Array:
+		a.collectTypeNames(typ.Elem(), named, enums)
+	case *types.Map:
+		a.collectTypeNames(typ.Key(), named, enums)
+		a.collectTypeNames(typ.Elem(), named, enums)
+	case *types.Named:
+		named.record(a.typeString(t), t, enums)
+	}
+}
+
+// typeString spells t as the analyzed package refers to it.
+func (a *StructAnalyzer) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == a.pkg {
+			return ""
+		}
+		return pkg.Name()
+	})
+}
+
+// marshalsToText reports whether named, or a pointer to it, has a
+// MarshalText or MarshalJSON method, so that it encodes to values other than
+// its declared constants.
+func marshalsToText(named *types.Named) bool {
+	methods := types.NewMethodSet(types.NewPointer(named))
+	return methods.Lookup(nil, "MarshalText") != nil || methods.Lookup(nil, "MarshalJSON") != nil
+}
+
+// declaredConstants returns the values of the constants declared with type
+// named in its package, in declaration order: strings as string, signed
+// integers as int64 and unsigned integers as uint64.
+func declaredConstants(named *types.Named) []any {
+	pkg := named.Obj().Pkg()
+	if pkg == nil {
+		return nil
+	}
+	var consts []*types.Const
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && name != "_" && types.Identical(c.Type(), named) {
+			consts = append(consts, c)
+		}
+	}
+	slices.SortFunc(consts, func(x, y *types.Const) int { return cmp.Compare(x.Pos(), y.Pos()) })
+
+	values := make([]any, 0, len(consts))
+	for _, c := range consts {
+		value := c.Val()
+		switch {
+		case value.Kind() == constant.String:
+			values = append(values, constant.StringVal(value))
+		case value.Kind() == constant.Int && c.Type().Underlying().(*types.Basic).Info()&types.IsUnsigned != 0:
+			n, _ := constant.Uint64Val(value)
+			values = append(values, n)
+		case value.Kind() == constant.Int:
+			n, _ := constant.Int64Val(value)
+			values = append(values, n)
+		}
+	}
+	return values
 }
 
 // getReflectType converts a type-checked AST expression to reflect.Type.
@@ -279,12 +597,17 @@ func (a *StructAnalyzer) typesToReflectType(t types.Type) (reflect.Type, error)
 	case *types.Named:
 		obj := typ.Obj()
 		if obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
-			return reflect.TypeFor[timeType](), nil
+			return reflect.TypeFor[time.Time](), nil
 		}
-		if _, ok := typ.Underlying().(*types.Struct); ok {
+		switch underlying := typ.Underlying().(type) {
+		case *types.Struct:
+			return reflect.TypeFor[any](), nil
+		case *types.Interface:
+			// Interfaces with methods resolve their registered union at run time.
 			return reflect.TypeFor[any](), nil
+		default:
+			return a.typesToReflectType(underlying)
 		}
-		return a.typesToReflectType(typ.Underlying())
 	case *types.Interface:
 		if typ.Empty() {
 			return reflect.TypeFor[any](), nil
@@ -352,15 +675,18 @@ func (a *StructAnalyzer) applyGoZodTag(info *tagparser.FieldInfo, field *ast.Fie
 	if field.Tag == nil {
 		return false, nil
 	}
+	return a.applyRuleTag(info, strings.Trim(field.Tag.Value, "`"))
+}
 
-	tagValue := strings.Trim(field.Tag.Value, "`")
-	ruleTag, ok := lookupTagValue(tagValue, a.ruleTagName)
+// applyRuleTag parses the rule tag in the struct tag string tag into info.
+func (a *StructAnalyzer) applyRuleTag(info *tagparser.FieldInfo, tag string) (bool, error) {
+	ruleTag, ok := lookupTagValue(tag, a.ruleTagName)
 	if !ok {
 		return false, nil
 	}
 
 	info.GoZodTag = ruleTag
-	if info.GoZodTag == "" {
+	if info.GoZodTag == "" || info.GoZodTag == "-" {
 		return true, nil
 	}
 
@@ -368,9 +694,7 @@ func (a *StructAnalyzer) applyGoZodTag(info *tagparser.FieldInfo, field *ast.Fie
 	if err != nil {
 		return true, err
 	}
-	info.Rules = rules
-	info.Required = info.HasRule("required")
-	return true, nil
+	return true, info.SetRules(rules)
 }
 
 // extractFieldKey extracts the schema key from the configured field-name tag.
@@ -433,6 +757,14 @@ func getTypeNameFromAST(expr ast.Expr) string {
 			return ident.Name + "." + t.Sel.Name
 		}
 		return t.Sel.Name
+	case *ast.IndexExpr:
+		return getTypeNameFromAST(t.X) + "[" + getTypeNameFromAST(t.Index) + "]"
+	case *ast.IndexListExpr:
+		args := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			args[i] = getTypeNameFromAST(index)
+		}
+		return getTypeNameFromAST(t.X) + "[" + strings.Join(args, ", ") + "]"
 	default:
 		return "unknown"
 	}
@@ -190,6 +190,61 @@ type User struct {
 	assert.Equal(t, "domain.Code", structs[0].Fields[0].TypeName)
 }
 
+func TestStructAnalyzer_AnalyzePackageCollectsEnumTypeConstants(t *testing.T) {
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("go.mod", `module example.test/local
+
+go 1.26.5
+`)
+	helper.CreateGoFile("domain/kind.go", `package domain
+
+type Kind uint8
+
+const (
+	KindB Kind = 2
+	KindA Kind = 1
+)
+`)
+	helper.CreateGoFile("model/order.go", `package model
+
+import "example.test/local/domain"
+
+type State string
+
+const (
+	StateNew    State = "new"
+	StateClosed State = "closed"
+	_           State = "skipped"
+	untyped           = "untyped"
+)
+
+type Level int
+
+const LevelLow Level = 1
+
+func (l *Level) MarshalText() ([]byte, error) { return []byte("low"), nil }
+
+type Order struct {
+	State  State             `+"`gozod:\"enum_type\"`"+`
+	Kinds  []domain.Kind     `+"`gozod:\"dive,enum_type\"`"+`
+	Level  Level             `+"`gozod:\"enum_type\"`"+`
+	Totals map[State]int     `+"`gozod:\"min=1\"`"+`
+}
+`)
+
+	analyzer, err := NewStructAnalyzer()
+	require.NoError(t, err)
+
+	structs, err := analyzer.AnalyzePackage(filepath.Join(helper.GetTempDir(), "model"))
+	require.NoError(t, err)
+	require.Len(t, structs, 1)
+	assert.Equal(t, map[string][]any{
+		"State":       {"new", "closed"},
+		"domain.Kind": {uint64(2), uint64(1)},
+	}, structs[0].Enums)
+	assert.Equal(t, map[string]bool{"Level": true}, structs[0].Marshalers)
+}
+
 func TestStructAnalyzer_AnalyzePackageUsesDefaultBuildConstraints(t *testing.T) {
 	helper := NewTestHelper(t)
 	helper.CreateGoFile("active.go", `package fixture
@@ -641,17 +696,7 @@ func TestStructAnalyzerRejectsUnsupportedFieldTypes(t *testing.T) {
 	}{
 		{name: "channel", fieldType: "chan int", wantType: "chan int"},
 		{name: "function", fieldType: "func(int) error", wantType: "func(int) error"},
-		{
-			name:         "non-empty interface",
-			declarations: "type Runner interface { Run() }\n",
-			fieldType:    "Runner",
-			wantType:     "Runner",
-		},
-		{
-			name:         "type parameter",
-			declarations: "type Box[T any] struct {\n\tValue T `gozod:\"required\"`\n}\n",
-			wantType:     "T",
-		},
+		{name: "unnamed interface", fieldType: "interface{ Run() }", wantType: "interface{Run()}"},
 	}
 
 	for _, tt := range tests {
@@ -675,6 +720,133 @@ func TestStructAnalyzerRejectsUnsupportedFieldTypes(t *testing.T) {
 	}
 }
 
+func TestStructAnalyzer_AnalyzePackagePromotesEmbeddedFields(t *testing.T) {
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("go.mod", `module example.test/local
+
+go 1.26.5
+`)
+	helper.CreateGoFile("audit/audit.go", `package audit
+
+type Stamp struct {
+	By   string `+"`json:\"by\" gozod:\"required\"`"+`
+	Note string `+"`json:\"note\" gozod:\"max=9\"`"+`
+}
+`)
+	helper.CreateGoFile("model/order.go", `package model
+
+import "example.test/local/audit"
+
+type Shape interface{ Area() float64 }
+
+type Page[T any] struct {
+	Items []T `+"`json:\"items\" gozod:\"min=1\"`"+`
+}
+
+type base struct {
+	ID   string `+"`json:\"id\" gozod:\"required\"`"+`
+	Name string `+"`json:\"name\" gozod:\"min=2\"`"+`
+}
+
+type Hidden struct {
+	Secret string `+"`json:\"secret\" gozod:\"required\"`"+`
+}
+
+type Meta struct {
+	Tags []string `+"`json:\"tags\" gozod:\"max=3\"`"+`
+}
+
+type Order struct {
+	base
+	audit.Stamp
+	Hidden `+"`gozod:\"-\"`"+`
+	Meta   `+"`json:\"meta\" gozod:\"required\"`"+`
+	Name   string       `+"`json:\"name\" gozod:\"required\"`"+`
+	Shape  Shape        `+"`json:\"shape\" gozod:\"required\"`"+`
+	Pages  []Page[Meta] `+"`json:\"pages\" gozod:\"max=2\"`"+`
+}
+`)
+
+	analyzer, err := NewStructAnalyzer()
+	require.NoError(t, err)
+
+	structs, err := analyzer.AnalyzePackage(filepath.Join(helper.GetTempDir(), "model"))
+	require.NoError(t, err)
+	var order *GenerationInfo
+	for _, info := range structs {
+		assert.NotEqual(t, "Page", info.Name, "generic declarations are not generated")
+		if info.Name == "Order" {
+			order = info
+		}
+	}
+	require.NotNil(t, order)
+
+	keys := make([]string, len(order.Fields))
+	typeNames := make(map[string]string, len(order.Fields))
+	for i, field := range order.Fields {
+		keys[i] = field.FieldKey
+		typeNames[field.FieldKey] = field.TypeName
+	}
+	assert.Equal(t, []string{"id", "by", "note", "meta", "name", "shape", "pages"}, keys)
+	assert.Equal(t, "Meta", typeNames["meta"])
+	assert.Equal(t, "Shape", typeNames["shape"])
+	assert.Equal(t, "[]Page[Meta]", typeNames["pages"])
+	assert.Equal(t, map[string]bool{"Shape": true}, order.Interfaces)
+}
+
+func TestStructAnalyzer_AnalyzePackageRejectsEmbeddedPointers(t *testing.T) {
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("order.go", `package main
+
+type Meta struct {
+	Note string `+"`json:\"note\" gozod:\"max=9\"`"+`
+}
+
+type Order struct {
+	*Meta
+	ID string `+"`json:\"id\" gozod:\"required\"`"+`
+}
+`)
+
+	analyzer, err := NewStructAnalyzer()
+	require.NoError(t, err)
+	_, err = analyzer.AnalyzePackage(helper.GetTempDir())
+
+	require.ErrorIs(t, err, errEmbeddedPointer)
+	assert.ErrorContains(t, err, "Note")
+	assert.ErrorContains(t, err, "*Meta")
+}
+
+func TestStructAnalyzer_AnalyzePackageAllowsEmbeddedPointersWithoutRules(t *testing.T) {
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("order.go", `package main
+
+type Meta struct {
+	Note string `+"`json:\"note\"`"+`
+}
+
+type Node struct {
+	*Node
+	Label string `+"`json:\"label\"`"+`
+}
+
+type Order struct {
+	*Meta
+	*Node
+	Skipped *Meta `+"`json:\"skipped\" gozod:\"-\"`"+`
+	ID      string `+"`json:\"id\" gozod:\"required\"`"+`
+}
+`)
+
+	analyzer, err := NewStructAnalyzer()
+	require.NoError(t, err)
+	structs, err := analyzer.AnalyzePackage(helper.GetTempDir())
+	require.NoError(t, err)
+	require.Len(t, structs, 1)
+	require.Len(t, structs[0].Fields, 1)
+	assert.Equal(t, "id", structs[0].Fields[0].FieldKey)
+}
+
 func TestStructAnalyzer_ExtractFieldKey(t *testing.T) {
 	analyzer, err := NewStructAnalyzer()
 	require.NoError(t, err)
@@ -807,6 +979,19 @@ func TestGetTypeNameFromAST(t *testing.T) {
 			expr: &ast.SelectorExpr{X: &ast.CallExpr{}, Sel: &ast.Ident{Name: "Name"}},
 			want: "Name",
 		},
+		{
+			name: "generic instantiation",
+			expr: &ast.IndexExpr{X: &ast.Ident{Name: "Page"}, Index: &ast.Ident{Name: "User"}},
+			want: "Page[User]",
+		},
+		{
+			name: "generic instantiation with several arguments",
+			expr: &ast.IndexListExpr{
+				X:       &ast.SelectorExpr{X: &ast.Ident{Name: "kv"}, Sel: &ast.Ident{Name: "Pair"}},
+				Indices: []ast.Expr{&ast.Ident{Name: "string"}, &ast.StarExpr{X: &ast.Ident{Name: "User"}}},
+			},
+			want: "kv.Pair[string, *User]",
+		},
 		{
 			name: "unknown expression",
 			expr: &ast.CallExpr{},
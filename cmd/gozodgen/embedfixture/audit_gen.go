@@ -0,0 +1,57 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package embedfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+// Schema returns a generated gozod schema for Audit.
+// Package-local generated dependencies call their generated schema methods.
+func (a Audit) Schema() *gozod.ZodStruct[Audit, Audit] {
+	return gozod.Struct[Audit](gozod.StructSchema{
+		"created_by": gozod.String().Min(2),
+		"revision":   gozod.Int().Gte(1).Optional(),
+	})
+}
+
+// auditFields holds the Audit field schemas used by Parse and Validate.
+var auditFields = struct {
+	CreatedBy gozod.ZodSchema
+	Revision  gozod.ZodSchema
+}{
+	CreatedBy: gozod.String().Min(2),
+	Revision:  gozod.Int().Gte(1).Optional(),
+}
+
+// Parse validates Audit values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Audit) Parse(input any, ctx ...*core.ParseContext) (Audit, error) {
+	switch v := input.(type) {
+	case Audit:
+		return v.parseFields(ctx...)
+	case *Audit:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Audit{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in a.
+func (a Audit) Validate() error {
+	_, err := a.parseFields()
+	return err
+}
+
+func (a Audit) parseFields(ctx ...*core.ParseContext) (Audit, error) {
+	out := a
+	var errs gozod.StructFieldIssues
+	out.CreatedBy = gozod.ParseStructField(auditFields.CreatedBy, "created_by", a.CreatedBy, &errs, ctx...)
+	out.Revision = gozod.ParseStructField(auditFields.Revision, "revision", a.Revision, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Audit{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package embedfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+// Schema returns a generated gozod schema for Drawing.
+// Package-local generated dependencies call their generated schema methods.
+func (d Drawing) Schema() *gozod.ZodStruct[Drawing, Drawing] {
+	return gozod.Struct[Drawing](gozod.StructSchema{
+		"created_by": gozod.String().Min(2),
+		"revision":   gozod.Int().Gte(2).Optional(),
+		"title":      gozod.String(),
+		"shape":      gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() }),
+		"layers":     gozod.Slice[Shape](gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() })).Max(3).Optional(),
+		"history":    gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[Audit]]() }),
+		"tasks":      gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[Task]]() }),
+	})
+}
+
+// drawingFields holds the Drawing field schemas used by Parse and Validate.
+var drawingFields = struct {
+	CreatedBy gozod.ZodSchema
+	Revision  gozod.ZodSchema
+	Title     gozod.ZodSchema
+	Shape     gozod.ZodSchema
+	Layers    gozod.ZodSchema
+	History   gozod.ZodSchema
+	Tasks     gozod.ZodSchema
+}{
+	CreatedBy: gozod.String().Min(2),
+	Revision:  gozod.Int().Gte(2).Optional(),
+	Title:     gozod.String(),
+	Shape:     gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() }),
+	Layers:    gozod.Slice[Shape](gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() })).Max(3).Optional(),
+	History:   gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[Audit]]() }),
+	Tasks:     gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[Task]]() }),
+}
+
+// Parse validates Drawing values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Drawing) Parse(input any, ctx ...*core.ParseContext) (Drawing, error) {
+	switch v := input.(type) {
+	case Drawing:
+		return v.parseFields(ctx...)
+	case *Drawing:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Drawing{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in d.
+func (d Drawing) Validate() error {
+	_, err := d.parseFields()
+	return err
+}
+
+func (d Drawing) parseFields(ctx ...*core.ParseContext) (Drawing, error) {
+	out := d
+	var errs gozod.StructFieldIssues
+	out.CreatedBy = gozod.ParseStructField(drawingFields.CreatedBy, "created_by", d.CreatedBy, &errs, ctx...)
+	out.Revision = gozod.ParseStructField(drawingFields.Revision, "revision", d.Revision, &errs, ctx...)
+	out.Title = gozod.ParseStructField(drawingFields.Title, "title", d.Title, &errs, ctx...)
+	out.Shape = gozod.ParseStructField(drawingFields.Shape, "shape", d.Shape, &errs, ctx...)
+	out.Layers = gozod.ParseStructField(drawingFields.Layers, "layers", d.Layers, &errs, ctx...)
+	out.History = gozod.ParseStructField(drawingFields.History, "history", d.History, &errs, ctx...)
+	out.Tasks = gozod.ParseStructField(drawingFields.Tasks, "tasks", d.Tasks, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Drawing{}, err
+	}
+	return out, nil
+}
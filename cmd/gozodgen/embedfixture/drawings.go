@@ -0,0 +1,61 @@
+// Package embedfixture verifies generated schemas for promoted fields,
+// registered interfaces and generic instantiations.
+package embedfixture
+
+// Audit is embedded by value and promotes its fields.
+type Audit struct {
+	CreatedBy string `json:"created_by" gozod:"required,min=2"`
+	Revision  int    `json:"revision" gozod:"gte=1"`
+}
+
+// Shape is validated through the union registered for it.
+type Shape interface {
+	Area() float64
+}
+
+// Circle is a Shape with a value receiver.
+type Circle struct {
+	Kind   string  `json:"kind"`
+	Radius float64 `json:"radius"`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+// Square is a Shape with a pointer receiver.
+type Square struct {
+	Kind string  `json:"kind"`
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 { return s.Side * s.Side }
+
+// Page is a generic container whose instantiations use FromStruct.
+type Page[T any] struct {
+	Items []T `json:"items" gozod:"required,min=1"`
+	Total int `json:"total" gozod:"gte=0"`
+}
+
+// Priority is an enum type registered by the package's init function.
+type Priority string
+
+const (
+	PriorityLow  Priority = "low"
+	PriorityHigh Priority = "high"
+)
+
+// Task is a generic type argument whose schema needs a registered enum.
+type Task struct {
+	Title    string   `json:"title" gozod:"required"`
+	Priority Priority `json:"priority" gozod:"required,enum_type"`
+}
+
+// Drawing exercises promoted, shadowed, interface and generic fields.
+type Drawing struct {
+	Audit
+	Revision int         `json:"revision" gozod:"gte=2"`
+	Title    string      `json:"title" gozod:"required"`
+	Shape    Shape       `json:"shape" gozod:"required"`
+	Layers   []Shape     `json:"layers" gozod:"max=3"`
+	History  Page[Audit] `json:"history" gozod:"required"`
+	Tasks    Page[Task]  `json:"tasks" gozod:"required"`
+}
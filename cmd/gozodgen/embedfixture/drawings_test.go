@@ -0,0 +1,65 @@
+package embedfixture
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod"
+)
+
+func init() {
+	shapes := gozod.MustDiscriminatedUnion("kind", []gozod.ZodSchema{
+		gozod.Struct[Circle](gozod.StructSchema{"kind": gozod.Literal("circle"), "radius": gozod.Float64().Positive()}),
+		gozod.Struct[Square](gozod.StructSchema{"kind": gozod.Literal("square"), "side": gozod.Float64().Positive()}),
+	})
+	if err := gozod.RegisterInterface[Shape](shapes); err != nil {
+		panic(err)
+	}
+	if err := gozod.RegisterEnum(PriorityLow, PriorityHigh); err != nil {
+		panic(err)
+	}
+}
+
+func TestGeneratedDrawingMatchesRuntimeSchema(t *testing.T) {
+	input := Drawing{
+		Audit:    Audit{CreatedBy: "ada", Revision: 1},
+		Revision: 3,
+		Title:    "plan",
+		Shape:    Circle{Kind: "circle", Radius: 2},
+		Layers:   []Shape{&Square{Kind: "square", Side: 1}},
+		History:  Page[Audit]{Items: []Audit{{CreatedBy: "bob", Revision: 2}}, Total: 1},
+		Tasks:    Page[Task]{Items: []Task{{Title: "ink", Priority: PriorityHigh}}, Total: 1},
+	}
+
+	want, err := gozod.MustFromStruct[Drawing]().Parse(input)
+	require.NoError(t, err)
+	got, err := Drawing{}.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, input.Validate())
+}
+
+func TestGeneratedDrawingReportsRuntimeIssues(t *testing.T) {
+	input := Drawing{
+		Audit:    Audit{CreatedBy: "a", Revision: 5},
+		Revision: 1,
+		Title:    "plan",
+		Shape:    Circle{Kind: "circle", Radius: -1},
+		Layers:   []Shape{&Square{Kind: "hexagon"}},
+		History:  Page[Audit]{Total: -1},
+		Tasks:    Page[Task]{Items: []Task{{Title: "ink", Priority: "urgent"}}, Total: 1},
+	}
+
+	_, wantErr := gozod.MustFromStruct[Drawing]().Parse(input)
+	_, gotErr := Drawing{}.Parse(input)
+	var want, got *gozod.ZodError
+	require.True(t, gozod.IsZodError(wantErr, &want))
+	require.True(t, gozod.IsZodError(gotErr, &got))
+	assert.ElementsMatch(t, want.Issues, got.Issues)
+	assert.Len(t, got.Issues, 7)
+	var validated *gozod.ZodError
+	require.True(t, gozod.IsZodError(input.Validate(), &validated))
+	assert.ElementsMatch(t, got.Issues, validated.Issues)
+}
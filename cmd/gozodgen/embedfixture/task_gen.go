@@ -0,0 +1,57 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package embedfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+// Schema returns a generated gozod schema for Task.
+// Package-local generated dependencies call their generated schema methods.
+func (t Task) Schema() *gozod.ZodStruct[Task, Task] {
+	return gozod.Struct[Task](gozod.StructSchema{
+		"title":    gozod.String(),
+		"priority": gozod.Enum("low", "high"),
+	})
+}
+
+// taskFields holds the Task field schemas used by Parse and Validate.
+var taskFields = struct {
+	Title    gozod.ZodSchema
+	Priority gozod.ZodSchema
+}{
+	Title:    gozod.String(),
+	Priority: gozod.Enum("low", "high"),
+}
+
+// Parse validates Task values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Task) Parse(input any, ctx ...*core.ParseContext) (Task, error) {
+	switch v := input.(type) {
+	case Task:
+		return v.parseFields(ctx...)
+	case *Task:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Task{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in t.
+func (t Task) Validate() error {
+	_, err := t.parseFields()
+	return err
+}
+
+func (t Task) parseFields(ctx ...*core.ParseContext) (Task, error) {
+	out := t
+	var errs gozod.StructFieldIssues
+	out.Title = gozod.ParseStructField(taskFields.Title, "title", t.Title, &errs, ctx...)
+	out.Priority = gozod.ParseStructFieldAs(taskFields.Priority, "priority", t.Priority, func(v string) Priority { return Priority(v) }, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Task{}, err
+	}
+	return out, nil
+}
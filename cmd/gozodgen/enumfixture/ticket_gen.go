@@ -0,0 +1,69 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package enumfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+// Schema returns a generated gozod schema for Ticket.
+// Package-local generated dependencies call their generated schema methods.
+func (t Ticket) Schema() *gozod.ZodStruct[Ticket, Ticket] {
+	return gozod.Struct[Ticket](gozod.StructSchema{
+		"status":     gozod.Enum("open", "pending", "closed"),
+		"priority":   gozod.Enum[int8](1, 2, 3),
+		"previous":   gozod.EnumPtr("open", "pending", "closed").Optional(),
+		"watched":    gozod.Slice[string](gozod.Enum("open", "pending", "closed")).Optional(),
+		"escalation": gozod.Record[string, int8](gozod.Enum("open", "pending", "closed"), gozod.Enum[int8](1, 2, 3)).Partial().Optional(),
+	})
+}
+
+// ticketFields holds the Ticket field schemas used by Parse and Validate.
+var ticketFields = struct {
+	Status     gozod.ZodSchema
+	Priority   gozod.ZodSchema
+	Previous   gozod.ZodSchema
+	Watched    gozod.ZodSchema
+	Escalation gozod.ZodSchema
+}{
+	Status:     gozod.Enum("open", "pending", "closed"),
+	Priority:   gozod.Enum[int8](1, 2, 3),
+	Previous:   gozod.EnumPtr("open", "pending", "closed").Optional(),
+	Watched:    gozod.Slice[string](gozod.Enum("open", "pending", "closed")).Optional(),
+	Escalation: gozod.Record[string, int8](gozod.Enum("open", "pending", "closed"), gozod.Enum[int8](1, 2, 3)).Partial().Optional(),
+}
+
+// Parse validates Ticket values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Ticket) Parse(input any, ctx ...*core.ParseContext) (Ticket, error) {
+	switch v := input.(type) {
+	case Ticket:
+		return v.parseFields(ctx...)
+	case *Ticket:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Ticket{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in t.
+func (t Ticket) Validate() error {
+	_, err := t.parseFields()
+	return err
+}
+
+func (t Ticket) parseFields(ctx ...*core.ParseContext) (Ticket, error) {
+	out := t
+	var errs gozod.StructFieldIssues
+	out.Status = gozod.ParseStructFieldAs(ticketFields.Status, "status", t.Status, func(v string) Status { return Status(v) }, &errs, ctx...)
+	out.Priority = gozod.ParseStructFieldAs(ticketFields.Priority, "priority", t.Priority, func(v int8) Priority { return Priority(v) }, &errs, ctx...)
+	out.Previous = gozod.ParseStructField(ticketFields.Previous, "previous", t.Previous, &errs, ctx...)
+	out.Watched = gozod.ParseStructField(ticketFields.Watched, "watched", t.Watched, &errs, ctx...)
+	out.Escalation = gozod.ParseStructField(ticketFields.Escalation, "escalation", t.Escalation, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Ticket{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,46 @@
+// Package enumfixture verifies enum_type schemas generated from declared
+// constants.
+package enumfixture
+
+// Status is a string enum.
+type Status string
+
+// Status values.
+const (
+	StatusOpen    Status = "open"
+	StatusPending Status = "pending"
+	StatusClosed  Status = "closed"
+)
+
+// Priority is an integer enum with a String method.
+type Priority int8
+
+// Priority values.
+const (
+	PriorityLow Priority = iota + 1
+	PriorityHigh
+	PriorityUrgent
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityHigh:
+		return "high"
+	case PriorityUrgent:
+		return "urgent"
+	default:
+		return "unknown"
+	}
+}
+
+// Ticket exercises enum_type on scalar, pointer, element, key and value
+// scopes.
+type Ticket struct {
+	Status     Status              `json:"status" gozod:"required,enum_type"`
+	Priority   Priority            `json:"priority" gozod:"required,enum_type"`
+	Previous   *Status             `json:"previous" gozod:"enum_type"`
+	Watched    []Status            `json:"watched" gozod:"dive,enum_type"`
+	Escalation map[Status]Priority `json:"escalation" gozod:"keys,enum_type,values,enum_type"`
+}
@@ -0,0 +1,57 @@
+package enumfixture
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod"
+)
+
+func init() {
+	if err := gozod.RegisterEnum(StatusOpen, StatusPending, StatusClosed); err != nil {
+		panic(err)
+	}
+	if err := gozod.RegisterEnum(PriorityLow, PriorityHigh, PriorityUrgent); err != nil {
+		panic(err)
+	}
+}
+
+func TestGeneratedEnumsMatchRuntimeSchema(t *testing.T) {
+	previous := StatusPending
+	input := Ticket{
+		Status:     StatusOpen,
+		Priority:   PriorityHigh,
+		Previous:   &previous,
+		Watched:    []Status{StatusClosed},
+		Escalation: map[Status]Priority{StatusOpen: PriorityUrgent},
+	}
+
+	want, err := gozod.MustFromStruct[Ticket]().Parse(input)
+	require.NoError(t, err)
+	got, err := Ticket{}.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, input.Validate())
+}
+
+func TestGeneratedEnumsReportRuntimeIssues(t *testing.T) {
+	previous := Status("reopened")
+	input := Ticket{
+		Status:     "archived",
+		Priority:   Priority(9),
+		Previous:   &previous,
+		Watched:    []Status{StatusOpen, "spam"},
+		Escalation: map[Status]Priority{StatusOpen: 0, "later": PriorityLow},
+	}
+
+	_, wantErr := gozod.MustFromStruct[Ticket]().Parse(input)
+	_, gotErr := Ticket{}.Parse(input)
+	var want, got *gozod.ZodError
+	require.True(t, gozod.IsZodError(wantErr, &want))
+	require.True(t, gozod.IsZodError(gotErr, &got))
+	assert.ElementsMatch(t, want.Issues, got.Issues)
+	assert.Len(t, got.Issues, 6)
+	assert.Equal(t, gotErr.Error(), input.Validate().Error())
+}
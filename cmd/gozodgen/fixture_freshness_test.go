@@ -16,6 +16,7 @@ func TestGeneratedFixturesAreFresh(t *testing.T) {
 		packageName string
 		sources     []string
 		outputs     []string
+		validators  bool
 	}{
 		{
 			name:        "simple testdata",
@@ -96,6 +97,30 @@ func TestGeneratedFixturesAreFresh(t *testing.T) {
 			sources:     []string{"scalars.go"},
 			outputs:     []string{"scalars_gen.go"},
 		},
+		{
+			name:        "enum type fixture",
+			directory:   "enumfixture",
+			packageName: "enumfixture",
+			sources:     []string{"tickets.go"},
+			outputs:     []string{"ticket_gen.go"},
+			validators:  true,
+		},
+		{
+			name:        "embed fixture",
+			directory:   "embedfixture",
+			packageName: "embedfixture",
+			sources:     []string{"drawings.go"},
+			outputs:     []string{"audit_gen.go", "drawing_gen.go", "task_gen.go"},
+			validators:  true,
+		},
+		{
+			name:        "validator methods fixture",
+			directory:   "validatorfixture",
+			packageName: "validatorfixture",
+			sources:     []string{"accounts.go"},
+			outputs:     []string{"account_gen.go", "address_gen.go", "lease_gen.go"},
+			validators:  true,
+		},
 	}
 
 	for _, fixture := range fixtures {
@@ -112,6 +137,7 @@ func TestGeneratedFixturesAreFresh(t *testing.T) {
 			require.NoError(t, err)
 			writer, err := NewFileWriter("", fixture.packageName, config.OutputSuffix, false, false)
 			require.NoError(t, err)
+			writer.validators = fixture.validators
 			generator.writer = writer
 			require.NoError(t, generator.ProcessPackage(helper.GetTempDir()))
 
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/pkg/tagparser"
 )
 
 func TestCodeGenerator_ProcessPackage(t *testing.T) {
@@ -639,3 +641,87 @@ func TestNewCodeGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeGenerator_RuleTemplates(t *testing.T) {
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("item.go", `package main
+type Item struct {
+	SKU    string `+"`json:\"sku\" gozod:\"required,gen_sku\"`"+`
+	Prefix string `+"`json:\"prefix\" gozod:\"gen_prefix=ab-\"`"+`
+}`)
+
+	config := &GeneratorConfig{
+		OutputSuffix: "_gen.go",
+		PackageName:  "main",
+		Rules: []RuleTemplate{
+			{
+				Name:     "gen_sku",
+				Family:   "string",
+				Operand:  "none",
+				Template: `.Regex(regexp.MustCompile("^SKU-[0-9]+$"))`,
+				Imports:  []string{"regexp"},
+			},
+			{
+				Name:     "gen_prefix",
+				Family:   "string",
+				Operand:  "required",
+				Template: `.StartsWith({{printf "%q" .Operand}})`,
+			},
+		},
+	}
+	generator, err := NewCodeGenerator(config)
+	require.NoError(t, err)
+
+	writer, err := NewFileWriter(helper.GetTempDir(), config.PackageName, config.OutputSuffix, config.DryRun, config.Verbose)
+	require.NoError(t, err)
+	generator.writer = writer
+
+	err = generator.ProcessPackage(helper.GetTempDir())
+	require.NoError(t, err)
+
+	content := helper.ReadGeneratedFile("item_gen.go")
+	helper.AssertValidGoCode(content)
+	helper.AssertCodeContains(content, `"regexp"`)
+	helper.AssertCodeContains(content, `"sku":    gozod.String().Regex(regexp.MustCompile("^SKU-[0-9]+$"))`)
+	helper.AssertCodeContains(content, `"prefix": gozod.String().StartsWith("ab-").Optional()`)
+}
+
+func TestCodeGenerator_RejectsInvalidRuleTemplates(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  RuleTemplate
+		error string
+	}{
+		{name: "unknown operand", rule: RuleTemplate{Name: "bad_operand", Family: "string", Operand: "many", Template: ".Trim()"}, error: `unknown operand "many"`},
+		{name: "empty template", rule: RuleTemplate{Name: "bad_empty", Family: "string"}, error: "empty template"},
+		{name: "unparsable template", rule: RuleTemplate{Name: "bad_parse", Family: "string", Template: ".Trim({{.Operand"}, error: "bad_parse"},
+		{name: "unknown family", rule: RuleTemplate{Name: "bad_family", Family: "complex", Template: ".Trim()"}, error: "unknown field family"},
+		{name: "built-in rule", rule: RuleTemplate{Name: "email", Family: "string", Template: ".Trim()"}, error: "built-in rule"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCodeGenerator(&GeneratorConfig{Rules: []RuleTemplate{tt.rule}})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.error)
+		})
+	}
+}
+
+func TestCodeGenerator_RejectsRulesWithoutTemplates(t *testing.T) {
+	require.NoError(t, tagparser.RegisterRule("gen_runtime_only", tagparser.FieldFamilyString, tagparser.OperandNone))
+	helper := NewTestHelper(t)
+	helper.CreateGoFile("item.go", `package main
+type Item struct {
+	Code string `+"`json:\"code\" gozod:\"gen_runtime_only\"`"+`
+}`)
+
+	generator, err := NewCodeGenerator(&GeneratorConfig{OutputSuffix: "_gen.go", PackageName: "main"})
+	require.NoError(t, err)
+	writer, err := NewFileWriter(helper.GetTempDir(), "main", "_gen.go", false, false)
+	require.NoError(t, err)
+	generator.writer = writer
+
+	err = generator.ProcessPackage(helper.GetTempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `rule "gen_runtime_only" has no registered code template`)
+}
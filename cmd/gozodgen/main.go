@@ -14,6 +14,8 @@
 //	-field-name-tag string
 //	                   Struct tag used for field names (default: "json")
 //	-method string     Name of the generated method (default: "Schema")
+//	-validators       Also generate reflection-free Parse and Validate methods
+//	-rules string      JSON file of custom tag rules and their code templates
 //	-verbose          Verbose output
 //	-dry-run          Preview generated code without writing files
 package main
@@ -42,6 +44,8 @@ var (
 	ruleTagFlag      = flag.String("tag-name", defaultRuleTag, "Struct tag used for validation rules (e.g. gozod, validate)")
 	fieldNameTagFlag = flag.String("field-name-tag", defaultFieldNameTag, "Struct tag used for field names (e.g. json, yaml, toml)")
 	method           = flag.String("method", defaultMethodName, "Name of the generated method")
+	validators       = flag.Bool("validators", false, "Also generate reflection-free Parse and Validate methods")
+	rulesFile        = flag.String("rules", "", "JSON file of custom tag rules and their code templates")
 	verbose          = flag.Bool("verbose", false, "Verbose output")
 	dryRun           = flag.Bool("dry-run", false, "Preview generated code without writing files")
 	help             = flag.Bool("help", false, "Show help message")
@@ -58,6 +62,9 @@ func main() {
 	if !isExportedIdent(*method) {
 		log.Fatalf("[ERROR] invalid -method %q: must be a valid exported Go identifier", *method)
 	}
+	if *validators && (*method == "Parse" || *method == "Validate") {
+		log.Fatalf("[ERROR] invalid -method %q: -validators generates Parse and Validate methods", *method)
+	}
 
 	// Get target packages from command line arguments
 	packages := flag.Args()
@@ -75,6 +82,15 @@ func main() {
 		}
 	}
 
+	var rules []RuleTemplate
+	if *rulesFile != "" {
+		loaded, err := loadRuleTemplates(*rulesFile)
+		if err != nil {
+			log.Fatalf("[ERROR] %v", err)
+		}
+		rules = loaded
+	}
+
 	// Create generator with configuration
 	config := &GeneratorConfig{
 		OutputSuffix: *outputSuffix,
@@ -82,6 +98,8 @@ func main() {
 		RuleTagName:  *ruleTagFlag,
 		FieldNameTag: *fieldNameTagFlag,
 		MethodName:   *method,
+		Validators:   *validators,
+		Rules:        rules,
 		Verbose:      *verbose,
 		DryRun:       *dryRun,
 	}
@@ -153,6 +171,14 @@ EXAMPLES:
 	    # Generate a method named Validate instead of Schema
 	    gozodgen -method=Validate
 
+	    # Also generate reflection-free Parse and Validate methods
+	    gozodgen -validators
+
+	    # Render custom tag rules, such as gozod:"sku", from code templates:
+	    # [{"name": "sku", "family": "string", "operand": "none",
+	    #   "template": ".StartsWith(\"SKU-\")"}]
+	    gozodgen -rules=rules.json
+
 DIRECTIVES:
     Add //go:generate gozodgen to your Go files to enable automatic
     code generation when running 'go generate'.
@@ -172,13 +198,15 @@ OUTPUT:
 
 // GeneratorConfig holds configuration for the code generator.
 type GeneratorConfig struct {
-	OutputSuffix string // File suffix for generated files
-	PackageName  string // Override package name
-	RuleTagName  string // Struct tag used for validation rules (default "gozod")
-	FieldNameTag string // Struct tag used for field names (default "json")
-	MethodName   string // Generated method name (default "Schema")
-	Verbose      bool   // Enable verbose logging
-	DryRun       bool   // Preview mode without writing files
+	OutputSuffix string         // File suffix for generated files
+	PackageName  string         // Override package name
+	RuleTagName  string         // Struct tag used for validation rules (default "gozod")
+	FieldNameTag string         // Struct tag used for field names (default "json")
+	MethodName   string         // Generated method name (default "Schema")
+	Validators   bool           // Also generate reflection-free Parse and Validate methods
+	Rules        []RuleTemplate // Custom tag rules and their code templates
+	Verbose      bool           // Enable verbose logging
+	DryRun       bool           // Preview mode without writing files
 }
 
 // isExportedIdent reports whether s is a valid exported Go identifier
@@ -214,6 +242,10 @@ func NewCodeGenerator(config *GeneratorConfig) (*CodeGenerator, error) {
 		return nil, errConfigNil
 	}
 
+	if err := registerRuleTemplates(config.Rules); err != nil {
+		return nil, fmt.Errorf("register rule templates: %w", err)
+	}
+
 	analyzer, err := NewStructAnalyzer()
 	if err != nil {
 		return nil, fmt.Errorf("create analyzer: %w", err)
@@ -235,6 +267,7 @@ func NewCodeGenerator(config *GeneratorConfig) (*CodeGenerator, error) {
 	if config.FieldNameTag != "" {
 		writer.fieldNameTag = config.FieldNameTag
 	}
+	writer.validators = config.Validators
 
 	return &CodeGenerator{
 		config:   config,
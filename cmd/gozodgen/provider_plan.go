@@ -64,11 +64,11 @@ func (p *generatedProviderPlan) referencedType(typeName string) (string, bool) {
 			}
 			typeName = typeName[end+1:]
 		case strings.HasPrefix(typeName, "map["):
-			end := strings.LastIndex(typeName, "]")
-			if end < 0 || end == len(typeName)-1 {
+			_, value, ok := splitMapType(typeName)
+			if !ok {
 				return "", false
 			}
-			typeName = typeName[end+1:]
+			typeName = value
 		default:
 			_, ok := p.types[typeName]
 			return typeName, ok
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/go-json-experiment/json"
+
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+var errInvalidRuleTemplate = errors.New("invalid rule template")
+
+// RuleTemplate registers a custom tag rule with the generator. Template
+// renders the method chain appended to the field schema for each use of the
+// rule; {{.Operand}} is the raw operand, so {{printf "%q" .Operand}} yields a
+// Go string literal.
+type RuleTemplate struct {
+	Name     string   `json:"name"`
+	Family   string   `json:"family"`
+	Operand  string   `json:"operand,omitzero"` // none, optional (default), or required
+	Template string   `json:"template"`
+	Imports  []string `json:"imports,omitzero"`
+}
+
+// ruleTemplateData is the data a rule template executes with.
+type ruleTemplateData struct {
+	Operand string
+}
+
+type compiledRuleTemplate struct {
+	template *template.Template
+	imports  []string
+}
+
+var ruleTemplates = struct {
+	sync.RWMutex
+	byName map[string]compiledRuleTemplate
+}{byName: make(map[string]compiledRuleTemplate)}
+
+// loadRuleTemplates reads a JSON array of rule templates from path.
+func loadRuleTemplates(path string) ([]RuleTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read rule templates: %w", err)
+	}
+	var rules []RuleTemplate
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("decode rule templates %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// registerRuleTemplates registers each rule with tagparser, so tags naming it
+// compile, and records the template that renders it.
+func registerRuleTemplates(rules []RuleTemplate) error {
+	for _, rule := range rules {
+		arity, ok := map[string]tagparser.OperandArity{
+			"":         tagparser.OperandOptional,
+			"none":     tagparser.OperandNone,
+			"optional": tagparser.OperandOptional,
+			"required": tagparser.OperandRequired,
+		}[rule.Operand]
+		if !ok {
+			return fmt.Errorf("%w: rule %q: unknown operand %q", errInvalidRuleTemplate, rule.Name, rule.Operand)
+		}
+		if strings.TrimSpace(rule.Template) == "" {
+			return fmt.Errorf("%w: rule %q: empty template", errInvalidRuleTemplate, rule.Name)
+		}
+		tmpl, err := template.New(rule.Name).Option("missingkey=error").Parse(rule.Template)
+		if err != nil {
+			return fmt.Errorf("%w: rule %q: %w", errInvalidRuleTemplate, rule.Name, err)
+		}
+		if err := tagparser.RegisterRule(rule.Name, tagparser.FieldFamily(rule.Family), arity); err != nil {
+			return err
+		}
+		ruleTemplates.Lock()
+		ruleTemplates.byName[rule.Name] = compiledRuleTemplate{template: tmpl, imports: rule.Imports}
+		ruleTemplates.Unlock()
+	}
+	return nil
+}
+
+func lookupRuleTemplate(name string) (compiledRuleTemplate, bool) {
+	ruleTemplates.RLock()
+	defer ruleTemplates.RUnlock()
+	compiled, ok := ruleTemplates.byName[name]
+	return compiled, ok
+}
+
+// renderRuleTemplate renders the method chain of a custom rule operation.
+func renderRuleTemplate(plan tagparser.RulePlan) (string, error) {
+	compiled, ok := lookupRuleTemplate(plan.Name)
+	if !ok {
+		return "", fmt.Errorf("rule %q has no registered code template", plan.Name)
+	}
+	operand, _ := plan.Operand.(string)
+	var b strings.Builder
+	if err := compiled.template.Execute(&b, ruleTemplateData{Operand: operand}); err != nil {
+		return "", fmt.Errorf("execute template for rule %q: %w", plan.Name, err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// ruleTemplateImports returns the imports declared by the custom rules a
+// field uses.
+func ruleTemplateImports(field tagparser.FieldInfo) []string {
+	var imports []string
+	for _, rule := range field.Rules {
+		if compiled, ok := lookupRuleTemplate(rule.Name); ok {
+			imports = append(imports, compiled.imports...)
+		}
+	}
+	return imports
+}
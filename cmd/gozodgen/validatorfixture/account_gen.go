@@ -0,0 +1,83 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package validatorfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+// Schema returns a generated gozod schema for Account.
+// Package-local generated dependencies call their generated schema methods.
+func (a Account) Schema() *gozod.ZodStruct[Account, Account] {
+	return gozod.Struct[Account](gozod.StructSchema{
+		"id":       gozod.String().Min(4),
+		"name":     gozod.String().Min(2).Max(50),
+		"email":    gozod.Email(),
+		"age":      gozod.Int().Min(18).Max(120).Optional(),
+		"nickname": gozod.StringPtr().Min(2).Optional(),
+		"tags":     gozod.Slice[string](gozod.String().Min(2)).Max(3).Optional(),
+		"limits":   gozod.Record[string, int](gozod.String().Min(2), gozod.Int().Gte(0)).Optional(),
+		"role":     gozod.String().Optional().Default("member"),
+		"address":  Address{}.Schema(),
+	})
+}
+
+// accountFields holds the Account field schemas used by Parse and Validate.
+var accountFields = struct {
+	ID       gozod.ZodSchema
+	Name     gozod.ZodSchema
+	Email    gozod.ZodSchema
+	Age      gozod.ZodSchema
+	Nickname gozod.ZodSchema
+	Tags     gozod.ZodSchema
+	Limits   gozod.ZodSchema
+	Role     gozod.ZodSchema
+}{
+	ID:       gozod.String().Min(4),
+	Name:     gozod.String().Min(2).Max(50),
+	Email:    gozod.Email(),
+	Age:      gozod.Int().Min(18).Max(120).Optional(),
+	Nickname: gozod.StringPtr().Min(2).Optional(),
+	Tags:     gozod.Slice[string](gozod.String().Min(2)).Max(3).Optional(),
+	Limits:   gozod.Record[string, int](gozod.String().Min(2), gozod.Int().Gte(0)).Optional(),
+	Role:     gozod.String().Optional().Default("member"),
+}
+
+// Parse validates Account values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Account) Parse(input any, ctx ...*core.ParseContext) (Account, error) {
+	switch v := input.(type) {
+	case Account:
+		return v.parseFields(ctx...)
+	case *Account:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Account{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in a.
+func (a Account) Validate() error {
+	_, err := a.parseFields()
+	return err
+}
+
+func (a Account) parseFields(ctx ...*core.ParseContext) (Account, error) {
+	out := a
+	var errs gozod.StructFieldIssues
+	out.ID = gozod.ParseStructFieldAs(accountFields.ID, "id", a.ID, func(v string) AccountID { return AccountID(v) }, &errs, ctx...)
+	out.Name = gozod.ParseStructField(accountFields.Name, "name", a.Name, &errs, ctx...)
+	out.Email = gozod.ParseStructField(accountFields.Email, "email", a.Email, &errs, ctx...)
+	out.Age = gozod.ParseStructField(accountFields.Age, "age", a.Age, &errs, ctx...)
+	out.Nickname = gozod.ParseStructField(accountFields.Nickname, "nickname", a.Nickname, &errs, ctx...)
+	out.Tags = gozod.ParseStructField(accountFields.Tags, "tags", a.Tags, &errs, ctx...)
+	out.Limits = gozod.ParseStructField(accountFields.Limits, "limits", a.Limits, &errs, ctx...)
+	out.Role = gozod.ParseStructField(accountFields.Role, "role", a.Role, &errs, ctx...)
+	out.Address = gozod.ParseNestedStructField(Address.parseFields, "address", a.Address, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Account{}, err
+	}
+	return out, nil
+}
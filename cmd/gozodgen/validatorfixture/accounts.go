@@ -0,0 +1,34 @@
+// Package validatorfixture verifies generated reflection-free Parse and
+// Validate methods.
+package validatorfixture
+
+import "time"
+
+// AccountID is a declared string type.
+type AccountID string
+
+// Address is a nested struct with its own generated methods.
+type Address struct {
+	Street string `json:"street" gozod:"required,min=3"`
+	City   string `json:"city" gozod:"required,min=2"`
+	Zip    string `json:"zip" gozod:"regex=^[0-9]{5}$"`
+}
+
+// Account exercises scalar, declared, pointer, slice, map and nested fields.
+type Account struct {
+	ID       AccountID      `json:"id" gozod:"required,min=4"`
+	Name     string         `json:"name" gozod:"required,min=2,max=50"`
+	Email    string         `json:"email" gozod:"required,email"`
+	Age      int            `json:"age" gozod:"min=18,max=120"`
+	Nickname *string        `json:"nickname" gozod:"min=2"`
+	Tags     []string       `json:"tags" gozod:"max=3,dive,min=2"`
+	Limits   map[string]int `json:"limits" gozod:"keys,min=2,values,gte=0"`
+	Role     string         `json:"role" gozod:"default=member"`
+	Address  Address        `json:"address" gozod:"required"`
+}
+
+// Lease exercises a declared scalar type from another package.
+type Lease struct {
+	Holder  AccountID     `json:"holder" gozod:"required,min=4"`
+	Timeout time.Duration `json:"timeout" gozod:"required,min=1000000000"`
+}
@@ -0,0 +1,130 @@
+package validatorfixture
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+)
+
+func validAccount() Account {
+	nickname := "ace"
+	return Account{
+		ID:       "acct-1",
+		Name:     "Ada Lovelace",
+		Email:    "ada@example.com",
+		Age:      36,
+		Nickname: &nickname,
+		Tags:     []string{"admin"},
+		Limits:   map[string]int{"seats": 5},
+		Address:  Address{Street: "1 Loop", City: "London", Zip: "12345"},
+	}
+}
+
+func TestGeneratedParseMatchesRuntimeSchema(t *testing.T) {
+	input := validAccount()
+
+	want, err := gozod.MustFromStruct[Account]().Parse(input)
+	require.NoError(t, err)
+	got, err := Account{}.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = Account{}.Parse(&input)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, input.Validate())
+}
+
+func TestGeneratedParseReportsRuntimeIssues(t *testing.T) {
+	nickname := "x"
+	input := Account{
+		ID:       "a",
+		Name:     "A",
+		Email:    "not-an-email",
+		Age:      7,
+		Nickname: &nickname,
+		Tags:     []string{"a", "b", "c", "d"},
+		Limits:   map[string]int{"a": 1, "seats": -1},
+		Address:  Address{Street: "1", Zip: "abc"},
+	}
+
+	_, wantErr := gozod.MustFromStruct[Account]().Parse(input)
+	_, gotErr := Account{}.Parse(input)
+	var want, got *gozod.ZodError
+	require.True(t, gozod.IsZodError(wantErr, &want))
+	require.True(t, gozod.IsZodError(gotErr, &got))
+	assert.ElementsMatch(t, want.Issues, got.Issues)
+	assert.Equal(t, gotErr.Error(), input.Validate().Error())
+}
+
+func TestGeneratedParsePassesParseContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, wantErr := gozod.MustFromStruct[Account]().Parse(validAccount(), core.WithContext(ctx))
+	_, gotErr := Account{}.Parse(validAccount(), core.WithContext(ctx))
+	var want, got *gozod.ZodError
+	require.True(t, gozod.IsZodError(wantErr, &want))
+	require.True(t, gozod.IsZodError(gotErr, &got))
+	require.Len(t, got.Issues, 1)
+	assert.Equal(t, gozod.IssueCanceled, got.Issues[0].Code)
+	assert.Equal(t, want.Issues, got.Issues)
+}
+
+func TestGeneratedParseConvertsQualifiedScalars(t *testing.T) {
+	valid := Lease{Holder: "acct-1", Timeout: 5 * time.Second}
+	want, err := gozod.MustFromStruct[Lease]().Parse(valid)
+	require.NoError(t, err)
+	got, err := Lease{}.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	invalid := Lease{Holder: "acct-1", Timeout: time.Millisecond}
+	_, wantErr := gozod.MustFromStruct[Lease]().Parse(invalid)
+	_, gotErr := Lease{}.Parse(invalid)
+	var wantIssues, gotIssues *gozod.ZodError
+	require.True(t, gozod.IsZodError(wantErr, &wantIssues))
+	require.True(t, gozod.IsZodError(gotErr, &gotIssues))
+	assert.Equal(t, wantIssues.Issues, gotIssues.Issues)
+}
+
+func TestGeneratedParseFallsBackToSchema(t *testing.T) {
+	input := map[string]any{"street": "1 Loop", "city": "London"}
+
+	want, wantErr := Address{}.Schema().Parse(input)
+	got, gotErr := Address{}.Parse(input)
+	assert.Equal(t, want, got)
+	assert.Equal(t, wantErr, gotErr)
+
+	_, err := Address{}.Parse((*Address)(nil))
+	require.Error(t, err)
+}
+
+func BenchmarkGeneratedParse(b *testing.B) {
+	input := validAccount()
+
+	b.Run("FromStruct", func(b *testing.B) {
+		schema := gozod.MustFromStruct[Account]()
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := schema.Parse(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := (Account{}).Parse(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,62 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package validatorfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+	"regexp"
+)
+
+// Schema returns a generated gozod schema for Address.
+// Package-local generated dependencies call their generated schema methods.
+func (a Address) Schema() *gozod.ZodStruct[Address, Address] {
+	return gozod.Struct[Address](gozod.StructSchema{
+		"street": gozod.String().Min(3),
+		"city":   gozod.String().Min(2),
+		"zip":    gozod.String().Regex(regexp.MustCompile("^[0-9]{5}$")).Optional(),
+	})
+}
+
+// addressFields holds the Address field schemas used by Parse and Validate.
+var addressFields = struct {
+	Street gozod.ZodSchema
+	City   gozod.ZodSchema
+	Zip    gozod.ZodSchema
+}{
+	Street: gozod.String().Min(3),
+	City:   gozod.String().Min(2),
+	Zip:    gozod.String().Regex(regexp.MustCompile("^[0-9]{5}$")).Optional(),
+}
+
+// Parse validates Address values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Address) Parse(input any, ctx ...*core.ParseContext) (Address, error) {
+	switch v := input.(type) {
+	case Address:
+		return v.parseFields(ctx...)
+	case *Address:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Address{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in a.
+func (a Address) Validate() error {
+	_, err := a.parseFields()
+	return err
+}
+
+func (a Address) parseFields(ctx ...*core.ParseContext) (Address, error) {
+	out := a
+	var errs gozod.StructFieldIssues
+	out.Street = gozod.ParseStructField(addressFields.Street, "street", a.Street, &errs, ctx...)
+	out.City = gozod.ParseStructField(addressFields.City, "city", a.City, &errs, ctx...)
+	out.Zip = gozod.ParseStructField(addressFields.Zip, "zip", a.Zip, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Address{}, err
+	}
+	return out, nil
+}
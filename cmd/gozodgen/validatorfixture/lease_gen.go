@@ -0,0 +1,58 @@
+// Code generated by gozodgen. DO NOT EDIT.
+
+package validatorfixture
+
+import (
+	"github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
+	"time"
+)
+
+// Schema returns a generated gozod schema for Lease.
+// Package-local generated dependencies call their generated schema methods.
+func (l Lease) Schema() *gozod.ZodStruct[Lease, Lease] {
+	return gozod.Struct[Lease](gozod.StructSchema{
+		"holder":  gozod.String().Min(4),
+		"timeout": gozod.Int64().Min(1000000000),
+	})
+}
+
+// leaseFields holds the Lease field schemas used by Parse and Validate.
+var leaseFields = struct {
+	Holder  gozod.ZodSchema
+	Timeout gozod.ZodSchema
+}{
+	Holder:  gozod.String().Min(4),
+	Timeout: gozod.Int64().Min(1000000000),
+}
+
+// Parse validates Lease values field by field without reflection and
+// reports the same issues as Schema. Other input is parsed by Schema.
+func (Lease) Parse(input any, ctx ...*core.ParseContext) (Lease, error) {
+	switch v := input.(type) {
+	case Lease:
+		return v.parseFields(ctx...)
+	case *Lease:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return Lease{}.Schema().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in l.
+func (l Lease) Validate() error {
+	_, err := l.parseFields()
+	return err
+}
+
+func (l Lease) parseFields(ctx ...*core.ParseContext) (Lease, error) {
+	out := l
+	var errs gozod.StructFieldIssues
+	out.Holder = gozod.ParseStructFieldAs(leaseFields.Holder, "holder", l.Holder, func(v string) AccountID { return AccountID(v) }, &errs, ctx...)
+	out.Timeout = gozod.ParseStructFieldAs(leaseFields.Timeout, "timeout", l.Timeout, func(v int64) time.Duration { return time.Duration(v) }, &errs, ctx...)
+	if err := errs.Err(); err != nil {
+		return Lease{}, err
+	}
+	return out, nil
+}
@@ -4,13 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
+	"unicode"
 
 	"github.com/kaptinlin/gozod/pkg/tagparser"
 )
@@ -42,6 +47,13 @@ var basicTypeConstructors = map[string]string{
 	"complex128": "gozod.Complex128()",
 }
 
+// FieldParserInfo contains the reflection-free parse call of a field.
+type FieldParserInfo struct {
+	GoName     string // Go field name
+	SchemaCode string // Field schema, empty when the call does not use one
+	ParseCall  string // Expression producing the parsed field value
+}
+
 // FileWriter handles the generation and writing of Go code files.
 type FileWriter struct {
 	outputDir    string
@@ -49,7 +61,11 @@ type FileWriter struct {
 	outputSuffix string
 	methodName   string
 	fieldNameTag string
+	validators   bool
 	providers    *generatedProviderPlan
+	enums        map[string][]any // declared constants of enum_type types, by type name
+	marshalers   map[string]bool  // enum_type types that marshal to text, by type name
+	interfaces   map[string]bool  // interface types validated by registered unions
 	templates    *template.Template
 	dryRun       bool
 	verbose      bool
@@ -148,11 +164,23 @@ func writeFileAtomic(path string, content []byte, mode os.FileMode) error {
 
 // generateCode generates the Go code for a struct.
 func (w *FileWriter) generateCode(info *GenerationInfo) (string, error) {
+	w.enums = info.Enums
+	w.marshalers = info.Marshalers
+	w.interfaces = info.Interfaces
 	fieldSchemas, err := w.generateFieldSchemas(info.Fields, info.Name)
 	if err != nil {
 		return "", fmt.Errorf("generate field schemas: %w", err)
 	}
 
+	deps, err := tagparser.DependentRequired(info.Fields)
+	if err != nil {
+		return "", fmt.Errorf("collect required_with rules: %w", err)
+	}
+	crossField, err := tagparser.CompileCrossFieldPlan(info.Fields)
+	if err != nil {
+		return "", fmt.Errorf("collect cross-field rules: %w", err)
+	}
+
 	pkgName := w.packageName
 	if pkgName == "" {
 		pkgName = info.Package
@@ -163,10 +191,20 @@ func (w *FileWriter) generateCode(info *GenerationInfo) (string, error) {
 		StructName:       info.Name,
 		MethodName:       w.methodName,
 		FieldNameTagCall: w.fieldNameTagCall(),
+		DependentCall:    dependentRequiredCall(deps) + crossFieldCalls(crossField),
 		Fields:           info.Fields,
 		FieldSchemas:     fieldSchemas,
 		Imports:          w.generateImports(info),
 	}
+	if w.validators {
+		data.Validators = true
+		data.Receiver = receiverName(info.Name)
+		data.FieldsVar = firstLowerCase(info.Name) + "Fields"
+		data.IssuesDecl = w.fieldIssuesDecl()
+		data.FieldParsers = w.generateFieldParsers(info, fieldSchemas, data.Receiver)
+		data.DependencyChecks = dependencyChecks(info.Fields, deps, data.Receiver)
+		data.DependencyChecks = append(data.DependencyChecks, crossFieldChecks(info.Fields, crossField, data.Receiver)...)
+	}
 
 	var buf strings.Builder
 	if err := w.templates.ExecuteTemplate(&buf, "main", data); err != nil {
@@ -185,13 +223,26 @@ func (w *FileWriter) generateImports(info *GenerationInfo) []string {
 
 	// Always include gozod core
 	imports["github.com/kaptinlin/gozod"] = true
+	if w.validators {
+		imports["github.com/kaptinlin/gozod/core"] = true
+	}
 
 	for _, field := range info.Fields {
-		if field.HasCoerceRule() {
-			imports["github.com/kaptinlin/gozod/coerce"] = true
+		for _, scope := range scopedFields(field) {
+			if scope.HasCoerceRule() {
+				imports["github.com/kaptinlin/gozod/coerce"] = true
+			}
+			for _, imp := range scope.RequiredImports() {
+				imports[imp] = true
+			}
+			for _, imp := range ruleTemplateImports(scope) {
+				imports[imp] = true
+			}
 		}
-		for _, imp := range field.RequiredImports() {
-			imports[imp] = true
+		if w.validators && isDeclaredScalar(&field) {
+			if imp := qualifierImport(field.TypeName, info.ImportPaths); imp != "" {
+				imports[imp] = true
+			}
 		}
 	}
 
@@ -204,6 +255,49 @@ func (w *FileWriter) generateImports(info *GenerationInfo) []string {
 	return result
 }
 
+// qualifierImport returns the import a package-qualified type name such as
+// time.Duration needs, as "name path" when name is an alias, or "" when
+// typeName is local or its qualifier is unknown.
+func qualifierImport(typeName string, paths map[string]string) string {
+	qualifier, _, ok := strings.Cut(typeName, ".")
+	if !ok {
+		return ""
+	}
+	importPath := paths[qualifier]
+	if importPath == "" {
+		return ""
+	}
+	if path.Base(importPath) != qualifier {
+		return qualifier + " " + importPath
+	}
+	return importPath
+}
+
+// importSpec renders an entry of generateImports as an import spec.
+func importSpec(imp string) string {
+	if name, importPath, ok := strings.Cut(imp, " "); ok {
+		return name + " " + strconv.Quote(importPath)
+	}
+	return strconv.Quote(imp)
+}
+
+// scopedFields returns field followed by the elements and keys its tag scopes
+// rules to, at every depth.
+func scopedFields(field tagparser.FieldInfo) []tagparser.FieldInfo {
+	scopes := []tagparser.FieldInfo{field}
+	if len(field.KeyRules) > 0 {
+		if key, err := field.KeyField(); err == nil {
+			scopes = append(scopes, key)
+		}
+	}
+	if len(field.ElemRules) > 0 {
+		if elem, err := field.ElementField(); err == nil {
+			scopes = append(scopes, scopedFields(elem)...)
+		}
+	}
+	return scopes
+}
+
 // generateFieldSchemas generates schema code for all fields.
 func (w *FileWriter) generateFieldSchemas(fields []tagparser.FieldInfo, structName string) ([]FieldSchemaInfo, error) {
 	schemas := make([]FieldSchemaInfo, 0, len(fields))
@@ -235,6 +329,17 @@ func (w *FileWriter) generateFieldSchemaCode(field *tagparser.FieldInfo, structN
 	if err != nil {
 		return "", err
 	}
+	// Scoped rules must name a collection even when the base schema ignores them.
+	if len(field.ElemRules) > 0 {
+		if _, err := field.ElementField(); err != nil {
+			return "", err
+		}
+	}
+	if len(field.KeyRules) > 0 {
+		if _, err := field.KeyField(); err != nil {
+			return "", err
+		}
+	}
 
 	// String-format constructors mirror runtime tag application: the first
 	// format rule replaces the base string schema, then later modifiers apply.
@@ -258,28 +363,18 @@ func (w *FileWriter) generateFieldSchemaCode(field *tagparser.FieldInfo, structN
 		return b.String(), nil
 	}
 
-	// Enum special case
-	if operand, ok := compiledOperand(fieldPlan, tagparser.RuleEnum); ok && isStringFieldType(field.Type) {
-		typed, ok := operand.([]any)
-		if !ok {
-			return "", fmt.Errorf("render enum operand for field %s", field.Name)
-		}
-		values := make([]string, 0, len(typed))
-		for _, value := range typed {
-			param, ok := value.(string)
-			if !ok {
-				return "", fmt.Errorf("render enum operand for field %s", field.Name)
-			}
-			values = append(values, fmt.Sprintf("%q", param))
-		}
+	// Enum special cases
+	constructor, consumedRule, err := w.enumConstructor(field, typeName, fieldPlan)
+	if err != nil {
+		return "", err
+	}
+	if constructor != "" {
 		var b strings.Builder
-		b.WriteString("gozod.Enum(")
-		b.WriteString(strings.Join(values, ", "))
-		b.WriteByte(')')
+		b.WriteString(constructor)
 		if fieldPlan.Optional == tagparser.OptionalPlacementBeforeOperations {
 			b.WriteString(".Optional()")
 		}
-		for _, operation := range fieldPlan.OperationsExcept("enum") {
+		for _, operation := range fieldPlan.OperationsExcept(consumedRule) {
 			code, err := renderValidatorChain(operation, field.Type)
 			if err != nil {
 				return "", fmt.Errorf("render %s for field %s: %w", operation.Name, field.Name, err)
@@ -298,7 +393,7 @@ func (w *FileWriter) generateFieldSchemaCode(field *tagparser.FieldInfo, structN
 	if _, coerce := compiledOperand(fieldPlan, tagparser.RuleCoerce); coerce {
 		base, err = coercionConstructor(typeName)
 	} else {
-		base, err = w.schemaConstructor(field.Type, typeName, structName)
+		base, err = w.schemaConstructor(field.Type, typeName, structName, field)
 	}
 	if err != nil {
 		return "", fmt.Errorf("render type %s for field %s: %w", typeName, field.Name, err)
@@ -320,11 +415,123 @@ func (w *FileWriter) generateFieldSchemaCode(field *tagparser.FieldInfo, structN
 	return b.String(), nil
 }
 
-func (w *FileWriter) schemaConstructor(goType reflect.Type, typeName, structName string) (string, error) {
+// enumConstructor renders the enum schema of a string field tagged enum, or
+// of a field tagged enum_type from the constants declared for its type, and
+// names the rule it consumed. It returns "" for other fields.
+func (w *FileWriter) enumConstructor(field *tagparser.FieldInfo, typeName string, fieldPlan tagparser.FieldPlan) (string, string, error) {
+	if _, ok := compiledOperand(fieldPlan, tagparser.RuleEnumType); ok {
+		declared := strings.TrimPrefix(typeName, "*")
+		if w.marshalers[declared] {
+			return "", "", &tagparser.CompileError{
+				Field: field.Name,
+				Rule:  string(tagparser.RuleEnumType),
+				Err:   fmt.Errorf("%w: type %s marshals to text", tagparser.ErrInapplicableRule, declared),
+			}
+		}
+		constants := w.enums[declared]
+		if len(constants) == 0 {
+			return "", "", &tagparser.CompileError{
+				Field: field.Name,
+				Rule:  string(tagparser.RuleEnumType),
+				Err:   fmt.Errorf("%w: type %s declares no constants", tagparser.ErrInapplicableRule, declared),
+			}
+		}
+		values := make([]string, len(constants))
+		for i, value := range constants {
+			if text, ok := value.(string); ok {
+				values[i] = strconv.Quote(text)
+			} else {
+				values[i] = formatCompiledOperand(value)
+			}
+		}
+		constructor := "Enum"
+		if field.IsPointerType() {
+			constructor = "EnumPtr"
+		}
+		kind := field.Type.Kind()
+		if field.IsPointerType() {
+			kind = field.Type.Elem().Kind()
+		}
+		if kind != reflect.String {
+			constructor += "[" + primitiveTypeName(kind) + "]"
+		}
+		return fmt.Sprintf("gozod.%s(%s)", constructor, strings.Join(values, ", ")), string(tagparser.RuleEnumType), nil
+	}
+	operand, ok := compiledOperand(fieldPlan, tagparser.RuleEnum)
+	if !ok || !isStringFieldType(field.Type) {
+		return "", "", nil
+	}
+	typed, ok := operand.([]any)
+	if !ok {
+		return "", "", fmt.Errorf("render enum operand for field %s", field.Name)
+	}
+	values := make([]string, 0, len(typed))
+	for _, value := range typed {
+		param, ok := value.(string)
+		if !ok {
+			return "", "", fmt.Errorf("render enum operand for field %s", field.Name)
+		}
+		values = append(values, fmt.Sprintf("%q", param))
+	}
+	return "gozod.Enum(" + strings.Join(values, ", ") + ")", string(tagparser.RuleEnum), nil
+}
+
+// generateFieldParsers renders the reflection-free parse call of every field.
+// A struct field whose schema is exactly its generated provider delegates to
+// the provider's field parser; a field of a declared scalar type converts the
+// schema output statically.
+func (w *FileWriter) generateFieldParsers(info *GenerationInfo, schemas []FieldSchemaInfo, receiver string) []FieldParserInfo {
+	parsers := make([]FieldParserInfo, 0, len(info.Fields))
+	for i := range info.Fields {
+		field := &info.Fields[i]
+		value := receiver + "." + field.Name
+		schemaCode := schemas[i].SchemaCode
+		schema := firstLowerCase(info.Name) + "Fields." + field.Name
+
+		parser := FieldParserInfo{GoName: field.Name, SchemaCode: schemaCode}
+		switch {
+		case w.providers.has(field.TypeName) && schemaCode == fmt.Sprintf("%s{}.%s()", field.TypeName, w.methodName):
+			parser.SchemaCode = ""
+			parser.ParseCall = fmt.Sprintf("gozod.ParseNestedStructField(%s.parseFields, %q, %s, &errs, ctx...)",
+				field.TypeName, field.FieldKey, value)
+		case isDeclaredScalar(field):
+			primitive := primitiveTypeName(field.Type.Kind())
+			parser.ParseCall = fmt.Sprintf("gozod.ParseStructFieldAs(%s, %q, %s, func(v %s) %s { return %s(v) }, &errs, ctx...)",
+				schema, field.FieldKey, value, primitive, field.TypeName, field.TypeName)
+		default:
+			parser.ParseCall = fmt.Sprintf("gozod.ParseStructField(%s, %q, %s, &errs, ctx...)", schema, field.FieldKey, value)
+		}
+		parsers = append(parsers, parser)
+	}
+	return parsers
+}
+
+// isDeclaredScalar reports whether field has a declared type, such as
+// type UserID string, over a primitive kind.
+func isDeclaredScalar(field *tagparser.FieldInfo) bool {
+	primitive := primitiveTypeName(field.Type.Kind())
+	if primitive == "" || field.TypeName == primitive {
+		return false
+	}
+	return strings.IndexFunc(field.TypeName, func(r rune) bool {
+		return r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) < 0
+}
+
+func (w *FileWriter) fieldIssuesDecl() string {
+	if w.fieldNameTag == "" || w.fieldNameTag == defaultFieldNameTag {
+		return "var errs gozod.StructFieldIssues"
+	}
+	return fmt.Sprintf("errs := gozod.StructFieldIssues{FieldNameTag: %q}", w.fieldNameTag)
+}
+
+// schemaConstructor renders the base schema of goType. field, when not nil,
+// is the field of that type, whose tag may scope rules to its elements or keys.
+func (w *FileWriter) schemaConstructor(goType reflect.Type, typeName, structName string, field *tagparser.FieldInfo) (string, error) {
 	if goType.Kind() == reflect.Pointer {
 		elementType := strings.TrimPrefix(typeName, "*")
 		if goType.Elem().Kind() == reflect.Array {
-			return w.fixedArrayConstructor(goType.Elem(), elementType, structName, true)
+			return w.fixedArrayConstructor(goType.Elem(), elementType, structName, true, field)
 		}
 		if primitive := primitiveTypeName(goType.Elem().Kind()); primitive != "" {
 			constructor := strings.TrimSuffix(basicTypeConstructor(primitive), "()")
@@ -332,14 +539,14 @@ func (w *FileWriter) schemaConstructor(goType reflect.Type, typeName, structName
 		}
 	}
 	if goType.Kind() == reflect.Array {
-		return w.fixedArrayConstructor(goType, typeName, structName, false)
+		return w.fixedArrayConstructor(goType, typeName, structName, false, field)
 	}
 	if goType.Kind() == reflect.Slice {
 		elementType, ok := strings.CutPrefix(typeName, "[]")
 		if !ok || elementType == "" {
 			return "", fmt.Errorf("malformed slice type %q", typeName)
 		}
-		element, err := w.schemaConstructor(goType.Elem(), elementType, structName)
+		element, err := w.elementConstructor(field, goType.Elem(), elementType, structName)
 		if err != nil {
 			return "", err
 		}
@@ -354,16 +561,26 @@ func (w *FileWriter) schemaConstructor(goType reflect.Type, typeName, structName
 		if !ok {
 			return "", fmt.Errorf("malformed map type %q", typeName)
 		}
-		value, err := w.schemaConstructor(goType.Elem(), valueType, structName)
+		key, err := w.keyConstructor(field, keyType, structName)
 		if err != nil {
 			return "", err
 		}
-		return fmt.Sprintf(
-			"gozod.Record[%s, %s](gozod.String(), %s)",
+		value, err := w.elementConstructor(field, goType.Elem(), valueType, structName)
+		if err != nil {
+			return "", err
+		}
+		record := fmt.Sprintf(
+			"gozod.Record[%s, %s](%s, %s)",
 			schemaTypeArgument(goType.Key(), keyType),
 			schemaTypeArgument(goType.Elem(), valueType),
+			key,
 			value,
-		), nil
+		)
+		// A map holds any subset of the keys its key rules allow.
+		if field != nil && field.HasEnumKeys() {
+			record += ".Partial()"
+		}
+		return record, nil
 	}
 	if primitive := primitiveTypeName(goType.Kind()); primitive != "" {
 		return basicTypeConstructor(primitive), nil
@@ -371,6 +588,35 @@ func (w *FileWriter) schemaConstructor(goType reflect.Type, typeName, structName
 	return w.baseConstructor(typeName, structName)
 }
 
+// elementConstructor renders the schema of a collection's elements or map
+// values: the generated schema of the rules field scopes to them, or the
+// plain constructor of their type when it scopes none.
+func (w *FileWriter) elementConstructor(field *tagparser.FieldInfo, goType reflect.Type, typeName, structName string) (string, error) {
+	if field == nil || len(field.ElemRules) == 0 {
+		return w.schemaConstructor(goType, typeName, structName, nil)
+	}
+	elem, err := field.ElementField()
+	if err != nil {
+		return "", err
+	}
+	elem.TypeName = typeName
+	return w.generateFieldSchemaCode(&elem, structName)
+}
+
+// keyConstructor renders the schema of a map's keys, applying the rules
+// field scopes to them.
+func (w *FileWriter) keyConstructor(field *tagparser.FieldInfo, typeName, structName string) (string, error) {
+	if field == nil || len(field.KeyRules) == 0 {
+		return "gozod.String()", nil
+	}
+	key, err := field.KeyField()
+	if err != nil {
+		return "", err
+	}
+	key.TypeName = typeName
+	return w.generateFieldSchemaCode(&key, structName)
+}
+
 func schemaTypeArgument(goType reflect.Type, declared string) string {
 	if primitive := primitiveTypeName(goType.Kind()); primitive != "" {
 		return primitive
@@ -421,13 +667,13 @@ func primitiveTypeName(kind reflect.Kind) string {
 	}[kind]
 }
 
-func (w *FileWriter) fixedArrayConstructor(arrayType reflect.Type, typeName, structName string, pointer bool) (string, error) {
+func (w *FileWriter) fixedArrayConstructor(arrayType reflect.Type, typeName, structName string, pointer bool, field *tagparser.FieldInfo) (string, error) {
 	closing := strings.IndexByte(typeName, ']')
 	if !strings.HasPrefix(typeName, "[") || closing < 2 || closing == len(typeName)-1 {
 		return "", fmt.Errorf("malformed array type %q", typeName)
 	}
 	elementType := typeName[closing+1:]
-	element, err := w.schemaConstructor(arrayType.Elem(), elementType, structName)
+	element, err := w.elementConstructor(field, arrayType.Elem(), elementType, structName)
 	if err != nil {
 		return "", err
 	}
@@ -502,9 +748,16 @@ func renderValidatorChain(plan tagparser.RulePlan, fieldType reflect.Type) (stri
 			return "", fmt.Errorf("%s operand has type %T", plan.Name, plan.Operand)
 		}
 		return fmt.Sprintf(".%s(%q)", methodForOperation(plan.Op), value), nil
+	case tagparser.RuleBefore, tagparser.RuleAfter:
+		value, ok := plan.Operand.(time.Time)
+		if !ok {
+			return "", fmt.Errorf("%s operand has type %T", plan.Name, plan.Operand)
+		}
+		return fmt.Sprintf(".%s(%s)", methodForOperation(plan.Op), formatTimeOperand(value)), nil
 	case tagparser.RuleNilable, tagparser.RuleTrim, tagparser.RuleLowercase,
 		tagparser.RuleUppercase, tagparser.RulePositive, tagparser.RuleNegative,
-		tagparser.RuleFinite, tagparser.RuleNonEmpty:
+		tagparser.RuleFinite, tagparser.RuleNonEmpty, tagparser.RulePast, tagparser.RuleFuture,
+		tagparser.RuleUnique:
 		return fmt.Sprintf(".%s()", methodForOperation(plan.Op)), nil
 	case tagparser.RuleEmail, tagparser.RuleURL, tagparser.RuleUUID,
 		tagparser.RuleIPv4, tagparser.RuleIPv6, tagparser.RuleCIDRv4,
@@ -512,8 +765,12 @@ func renderValidatorChain(plan tagparser.RulePlan, fieldType reflect.Type) (stri
 		tagparser.RuleJWT, tagparser.RuleISODateTime, tagparser.RuleISODate,
 		tagparser.RuleISOTime, tagparser.RuleISODuration:
 		return fmt.Sprintf(".%s()", stringFormatConstructorName(plan.Op)), nil
+	case tagparser.RuleCustom:
+		return renderRuleTemplate(plan)
 	case tagparser.RuleRequired, tagparser.RuleOptional, tagparser.RuleCoerce, tagparser.RuleTime,
-		tagparser.RuleEnum, tagparser.RuleLiteral:
+		tagparser.RuleEnum, tagparser.RuleEnumType, tagparser.RuleLiteral, tagparser.RuleRequiredWith,
+		tagparser.RuleEqField, tagparser.RuleNeField, tagparser.RuleGTField, tagparser.RuleGTEField,
+		tagparser.RuleLTField, tagparser.RuleLTEField, tagparser.RuleRequiredIf:
 		return "", nil
 	default:
 		return "", fmt.Errorf("unsupported operation %q", plan.Op)
@@ -540,6 +797,14 @@ func formatCompiledOperand(value any) string {
 	return fmt.Sprint(value)
 }
 
+// formatTimeOperand renders an instant as a time.Date literal in UTC.
+func formatTimeOperand(value time.Time) string {
+	value = value.UTC()
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)",
+		value.Year(), value.Month(), value.Day(),
+		value.Hour(), value.Minute(), value.Second(), value.Nanosecond())
+}
+
 func methodForOperation(op tagparser.RuleOp) string {
 	return map[tagparser.RuleOp]string{
 		tagparser.RuleNilable: "Nilable", tagparser.RuleMin: "Min", tagparser.RuleMax: "Max",
@@ -550,6 +815,9 @@ func methodForOperation(op tagparser.RuleOp) string {
 		tagparser.RuleLowercase: "ToLowerCase", tagparser.RuleUppercase: "ToUpperCase",
 		tagparser.RulePositive: "Positive", tagparser.RuleNegative: "Negative",
 		tagparser.RuleFinite: "Finite", tagparser.RuleNonEmpty: "NonEmpty",
+		tagparser.RuleBefore: "Before", tagparser.RuleAfter: "After",
+		tagparser.RulePast: "Past", tagparser.RuleFuture: "Future",
+		tagparser.RuleUnique: "Unique",
 	}[op]
 }
 
@@ -591,6 +859,9 @@ func baseConstructor(typeName, structName, fieldNameTag string) (string, error)
 }
 
 func (w *FileWriter) baseConstructor(typeName, structName string) (string, error) {
+	if w.interfaces[typeName] {
+		return interfaceConstructor(typeName), nil
+	}
 	return baseConstructorWithProviders(typeName, structName, w.fieldNameTag, w.methodName, w.providers)
 }
 
@@ -620,16 +891,15 @@ func baseConstructorWithProviders(
 	}
 
 	if strings.HasPrefix(typeName, "map[") {
-		if idx := strings.LastIndex(typeName, "]"); idx != -1 && idx < len(typeName)-1 {
-			keyType := typeName[len("map["):idx]
-			valType := typeName[idx+1:]
-			inner, err := baseConstructorWithProviders(valType, structName, fieldNameTag, methodName, providers)
-			if err != nil {
-				return "", err
-			}
-			return fmt.Sprintf("gozod.Record[%s, %s](gozod.String(), %s)", keyType, valType, inner), nil
+		keyType, valType, ok := splitMapType(typeName)
+		if !ok {
+			return "", fmt.Errorf("malformed map type %q", typeName)
 		}
-		return "", fmt.Errorf("malformed map type %q", typeName)
+		inner, err := baseConstructorWithProviders(valType, structName, fieldNameTag, methodName, providers)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("gozod.Record[%s, %s](gozod.String(), %s)", keyType, valType, inner), nil
 	}
 
 	if basicTypes[typeName] {
@@ -659,6 +929,12 @@ func namedTypeConstructor(typeName, structName, fieldNameTag, methodName string,
 	if structName != "" && typeName == structName {
 		return lazyStructConstructor(typeName, fallback)
 	}
+	if strings.Contains(typeName, "[") {
+		// A generic instantiation is compiled from its type arguments,
+		// whose enum types and unions init functions register after
+		// generated field schemas initialize.
+		return lazyAnyConstructor(fallback)
+	}
 	return fallback
 }
 
@@ -670,6 +946,18 @@ func lazyStructConstructor(typeName, provider string) string {
 	)
 }
 
+// interfaceConstructor renders the union registered for an interface type.
+// It resolves lazily because generated field schemas initialize before the
+// init functions that register unions.
+func interfaceConstructor(typeName string) string {
+	return lazyAnyConstructor(fmt.Sprintf("gozod.MustInterfaceSchema[%s]()", typeName))
+}
+
+// lazyAnyConstructor defers building schema until its first parse.
+func lazyAnyConstructor(schema string) string {
+	return fmt.Sprintf("gozod.LazyAny(func() any { return %s })", schema)
+}
+
 func fromStructConstructor(typeName, fieldNameTag string) string {
 	if fieldNameTag == "" || fieldNameTag == defaultFieldNameTag {
 		return fmt.Sprintf("gozod.MustFromStruct[%s]()", typeName)
@@ -695,6 +983,106 @@ func coercionConstructor(typeName string) (string, error) {
 	return "coerce." + constructor + "()", nil
 }
 
+// dependentRequiredCall renders the DependentRequired call for the
+// required_with rules of a struct, or "" when it has none.
+func dependentRequiredCall(deps map[string][]string) string {
+	if len(deps) == 0 {
+		return ""
+	}
+	entries := make([]string, 0, len(deps))
+	for _, key := range slices.Sorted(maps.Keys(deps)) {
+		dependents := make([]string, len(deps[key]))
+		for i, dependent := range deps[key] {
+			dependents[i] = strconv.Quote(dependent)
+		}
+		entries = append(entries, fmt.Sprintf("%q: {%s}", key, strings.Join(dependents, ", ")))
+	}
+	return fmt.Sprintf(".DependentRequired(map[string][]string{%s})", strings.Join(entries, ", "))
+}
+
+// dependencyChecks renders the RequireWith calls that check the
+// required_with rules of a struct in the order ZodStruct checks them.
+func dependencyChecks(fields []tagparser.FieldInfo, deps map[string][]string, receiver string) []string {
+	goNames := make(map[string]string, len(fields))
+	for _, field := range fields {
+		goNames[field.FieldKey] = field.Name
+	}
+	var checks []string
+	for _, key := range slices.Sorted(maps.Keys(deps)) {
+		for _, dependent := range deps[key] {
+			checks = append(checks, fmt.Sprintf("errs.RequireWith(%q, %s.%s, %q, %s.%s)",
+				key, receiver, goNames[key], dependent, receiver, goNames[dependent]))
+		}
+	}
+	return checks
+}
+
+// crossFieldCalls renders the CompareFields and RequiredIf calls for the
+// cross-field rules of a struct, or "" when it has none.
+func crossFieldCalls(plan tagparser.CrossFieldPlan) string {
+	var b strings.Builder
+	if len(plan.Comparisons) > 0 {
+		comparisons := make([]string, len(plan.Comparisons))
+		for i, comparison := range plan.Comparisons {
+			comparisons[i] = fmt.Sprintf("gozod.FieldComparison{Field: %q, Relation: gozod.%s, Other: %q}",
+				comparison.Field, fieldRelationConstants[comparison.Op], comparison.Other)
+		}
+		fmt.Fprintf(&b, ".CompareFields(%s)", strings.Join(comparisons, ", "))
+	}
+	for _, field := range slices.Sorted(maps.Keys(plan.RequiredIf)) {
+		fmt.Fprintf(&b, ".RequiredIf(%q, %s)", field, strings.Join(fieldConditionLiterals(plan.RequiredIf[field], "gozod.FieldCondition"), ", "))
+	}
+	return b.String()
+}
+
+// crossFieldChecks renders the CompareField and RequireIf calls that check
+// the cross-field rules of a struct in the order ZodStruct checks them.
+func crossFieldChecks(fields []tagparser.FieldInfo, plan tagparser.CrossFieldPlan, receiver string) []string {
+	goNames := make(map[string]string, len(fields))
+	for _, field := range fields {
+		goNames[field.FieldKey] = field.Name
+	}
+	checks := make([]string, 0, len(plan.Comparisons)+len(plan.RequiredIf))
+	for _, comparison := range plan.Comparisons {
+		checks = append(checks, fmt.Sprintf("errs.CompareField(%q, %s.%s, gozod.%s, %q, %s.%s)",
+			comparison.Field, receiver, goNames[comparison.Field], fieldRelationConstants[comparison.Op],
+			comparison.Other, receiver, goNames[comparison.Other]))
+	}
+	for _, field := range slices.Sorted(maps.Keys(plan.RequiredIf)) {
+		conditions := plan.RequiredIf[field]
+		values := make([]string, len(conditions))
+		for i, condition := range conditions {
+			values[i] = receiver + "." + goNames[condition.Field]
+		}
+		checks = append(checks, fmt.Sprintf("errs.RequireIf(%q, %s.%s, []gozod.FieldCondition{%s}, %s)",
+			field, receiver, goNames[field], strings.Join(fieldConditionLiterals(conditions, ""), ", "), strings.Join(values, ", ")))
+	}
+	return checks
+}
+
+// fieldConditionLiterals renders required_if conditions as composite
+// literals of typeName, which is elided inside a slice literal when empty.
+func fieldConditionLiterals(conditions []tagparser.FieldCondition, typeName string) []string {
+	literals := make([]string, len(conditions))
+	for i, condition := range conditions {
+		value := formatCompiledOperand(condition.Value)
+		if text, ok := condition.Value.(string); ok {
+			value = strconv.Quote(text)
+		}
+		literals[i] = fmt.Sprintf("%s{Field: %q, Value: %s}", typeName, condition.Field, value)
+	}
+	return literals
+}
+
+var fieldRelationConstants = map[tagparser.RuleOp]string{
+	tagparser.RuleEqField:  "FieldEqual",
+	tagparser.RuleNeField:  "FieldNotEqual",
+	tagparser.RuleGTField:  "FieldGreater",
+	tagparser.RuleGTEField: "FieldGreaterEqual",
+	tagparser.RuleLTField:  "FieldLess",
+	tagparser.RuleLTEField: "FieldLessEqual",
+}
+
 func (w *FileWriter) fieldNameTagCall() string {
 	if w.fieldNameTag == "" || w.fieldNameTag == defaultFieldNameTag {
 		return ""
@@ -725,9 +1113,18 @@ type TemplateData struct {
 	StructName       string
 	MethodName       string
 	FieldNameTagCall string
+	DependentCall    string
 	Fields           []tagparser.FieldInfo
 	FieldSchemas     []FieldSchemaInfo
 	Imports          []string
+
+	// Validators enables the reflection-free Parse and Validate methods.
+	Validators       bool
+	Receiver         string
+	FieldsVar        string
+	IssuesDecl       string
+	FieldParsers     []FieldParserInfo
+	DependencyChecks []string
 }
 
 // loadTemplates loads the code generation templates.
@@ -739,7 +1136,7 @@ package {{.PackageName}}
 
 import (
 {{- range .Imports}}
-	"{{.}}"
+	{{importSpec .}}
 {{- end}}
 )
 
@@ -750,13 +1147,62 @@ func ({{.StructName | receiverName}} {{.StructName}}) {{.MethodName}}() *gozod.Z
 {{- range .FieldSchemas}}
 		"{{.FieldName}}": {{.SchemaCode}},
 {{- end}}
-		}){{.FieldNameTagCall}}
+		}){{.FieldNameTagCall}}{{.DependentCall}}
+	}
+{{- if .Validators}}
+
+// {{.FieldsVar}} holds the {{.StructName}} field schemas used by Parse and Validate.
+var {{.FieldsVar}} = struct {
+{{- range .FieldParsers}}{{if .SchemaCode}}
+	{{.GoName}} gozod.ZodSchema
+{{- end}}{{end}}
+}{
+{{- range .FieldParsers}}{{if .SchemaCode}}
+	{{.GoName}}: {{.SchemaCode}},
+{{- end}}{{end}}
+}
+
+// Parse validates {{.StructName}} values field by field without reflection and
+// reports the same issues as {{.MethodName}}. Other input is parsed by {{.MethodName}}.
+func ({{.StructName}}) Parse(input any, ctx ...*core.ParseContext) ({{.StructName}}, error) {
+	switch v := input.(type) {
+	case {{.StructName}}:
+		return v.parseFields(ctx...)
+	case *{{.StructName}}:
+		if v != nil {
+			return v.parseFields(ctx...)
+		}
+	}
+	return {{.StructName}}{}.{{.MethodName}}().Parse(input, ctx...)
+}
+
+// Validate reports the issues Parse finds in {{.Receiver}}.
+func ({{.Receiver}} {{.StructName}}) Validate() error {
+	_, err := {{.Receiver}}.parseFields()
+	return err
+}
+
+func ({{.Receiver}} {{.StructName}}) parseFields(ctx ...*core.ParseContext) ({{.StructName}}, error) {
+	out := {{.Receiver}}
+	{{.IssuesDecl}}
+{{- range .FieldParsers}}
+	out.{{.GoName}} = {{.ParseCall}}
+{{- end}}
+{{- range .DependencyChecks}}
+	{{.}}
+{{- end}}
+	if err := errs.Err(); err != nil {
+		return {{.StructName}}{}, err
 	}
+	return out, nil
+}
+{{- end}}
 	`
 
 	// Create template with custom functions
 	tmpl := template.New("main").Funcs(template.FuncMap{
 		"firstLower":   firstLowerCase,
+		"importSpec":   importSpec,
 		"receiverName": receiverName,
 	})
 
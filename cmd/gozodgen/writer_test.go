@@ -205,6 +205,39 @@ func TestFileWriterUsesUnderlyingFamilyForDeclaredScalars(t *testing.T) {
 	}
 }
 
+func TestFileWriterGeneratesFieldParsers(t *testing.T) {
+	t.Parallel()
+
+	writer, err := NewFileWriter("", "fixture", "_gen.go", true, false)
+	require.NoError(t, err)
+	address := &GenerationInfo{Name: "Address"}
+	account := &GenerationInfo{Name: "Account", Fields: []tagparser.FieldInfo{
+		{Name: "ID", FieldKey: "id", Type: reflect.TypeFor[string](), TypeName: "AccountID"},
+		{Name: "Name", FieldKey: "name", Type: reflect.TypeFor[string](), TypeName: "string"},
+		{Name: "Owner", FieldKey: "owner", Type: reflect.TypeFor[*string](), TypeName: "*AccountID"},
+		{Name: "Address", FieldKey: "address", Type: reflect.TypeFor[any](), TypeName: "Address"},
+	}}
+	writer.providers = newGeneratedProviderPlan([]*GenerationInfo{address, account})
+	schemas := []FieldSchemaInfo{
+		{FieldName: "id", SchemaCode: "gozod.String()"},
+		{FieldName: "name", SchemaCode: "gozod.String()"},
+		{FieldName: "owner", SchemaCode: "gozod.StringPtr()"},
+		{FieldName: "address", SchemaCode: "Address{}.Schema()"},
+	}
+
+	parsers := writer.generateFieldParsers(account, schemas, "a")
+	require.Len(t, parsers, 4)
+	assert.Equal(t, `gozod.ParseStructFieldAs(accountFields.ID, "id", a.ID, func(v string) AccountID { return AccountID(v) }, &errs, ctx...)`, parsers[0].ParseCall)
+	assert.Equal(t, `gozod.ParseStructField(accountFields.Name, "name", a.Name, &errs, ctx...)`, parsers[1].ParseCall)
+	assert.Equal(t, `gozod.ParseStructField(accountFields.Owner, "owner", a.Owner, &errs, ctx...)`, parsers[2].ParseCall)
+	assert.Equal(t, `gozod.ParseNestedStructField(Address.parseFields, "address", a.Address, &errs, ctx...)`, parsers[3].ParseCall)
+	assert.Empty(t, parsers[3].SchemaCode)
+
+	assert.Equal(t, "var errs gozod.StructFieldIssues", writer.fieldIssuesDecl())
+	writer.fieldNameTag = "yaml"
+	assert.Equal(t, `errs := gozod.StructFieldIssues{FieldNameTag: "yaml"}`, writer.fieldIssuesDecl())
+}
+
 func TestWriteGeneratedCodePreservesExistingFileWhenAtomicReplaceCannotStart(t *testing.T) {
 	dir := t.TempDir()
 	sourcePath := filepath.Join(dir, "user.go")
@@ -322,6 +355,20 @@ func TestFileWriter_GenerateImports(t *testing.T) {
 			expectedImports:   []string{"github.com/kaptinlin/gozod"},
 			unexpectedImports: []string{"github.com/kaptinlin/gozod/core"},
 		},
+		{
+			name: "after rule imports the package for its literal",
+			fields: []tagparser.FieldInfo{
+				{
+					Name: "StartsAt",
+					Type: reflect.TypeFor[time.Time](),
+					Rules: []tagparser.TagRule{
+						{Name: "after", Params: []string{"2020-01-01T00:00:00Z"}},
+					},
+				},
+			},
+			expectedImports:   []string{"github.com/kaptinlin/gozod", "time"},
+			unexpectedImports: []string{"github.com/kaptinlin/gozod/core"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -364,6 +411,30 @@ func TestFileWriter_GenerateImports(t *testing.T) {
 	}
 }
 
+func TestFileWriter_GenerateImportsForQualifiedScalars(t *testing.T) {
+	writer, err := NewFileWriter("", "main", "_gen.go", true, false)
+	require.NoError(t, err)
+	info := &GenerationInfo{
+		Name:    "Lease",
+		Package: "main",
+		Fields: []tagparser.FieldInfo{
+			{Name: "Timeout", TypeName: "time.Duration", Type: reflect.TypeFor[time.Duration]()},
+			{Name: "Level", TypeName: "lvl.Level", Type: reflect.TypeFor[int]()},
+			{Name: "Owner", TypeName: "Owner", Type: reflect.TypeFor[string]()},
+		},
+		ImportPaths: map[string]string{"time": "time", "lvl": "example.com/levels"},
+	}
+
+	assert.NotContains(t, writer.generateImports(info), "time")
+
+	writer.validators = true
+	imports := writer.generateImports(info)
+	assert.Contains(t, imports, "time")
+	assert.Contains(t, imports, "lvl example.com/levels")
+	assert.Equal(t, `lvl "example.com/levels"`, importSpec("lvl example.com/levels"))
+	assert.Equal(t, `"time"`, importSpec("time"))
+}
+
 func TestFileWriter_GenerateFieldSchema(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -616,6 +687,49 @@ func TestFileWriter_GenerateCodeReturnsTemplateError(t *testing.T) {
 	assert.ErrorContains(t, err, "execute template")
 }
 
+func TestDependencyChecks(t *testing.T) {
+	t.Parallel()
+
+	fields := []tagparser.FieldInfo{
+		{Name: "BillingAddress", FieldKey: "billing_address"},
+		{Name: "BillingName", FieldKey: "billing_name"},
+		{Name: "BillingZip", FieldKey: "billing_zip"},
+	}
+	deps := map[string][]string{"billing_address": {"billing_name", "billing_zip"}}
+
+	assert.Equal(t, []string{
+		`errs.RequireWith("billing_address", p.BillingAddress, "billing_name", p.BillingName)`,
+		`errs.RequireWith("billing_address", p.BillingAddress, "billing_zip", p.BillingZip)`,
+	}, dependencyChecks(fields, deps, "p"))
+	assert.Empty(t, dependencyChecks(fields, nil, "p"))
+}
+
+func TestCrossFieldChecks(t *testing.T) {
+	t.Parallel()
+
+	fields := []tagparser.FieldInfo{
+		{Name: "Start", FieldKey: "start", Type: reflect.TypeFor[int]()},
+		{Name: "End", FieldKey: "end", Type: reflect.TypeFor[int](), Rules: []tagparser.TagRule{{Name: "gtfield", Params: []string{"Start"}}}},
+		{Name: "Status", FieldKey: "status", Type: reflect.TypeFor[string]()},
+		{Name: "Seats", FieldKey: "seats", Type: reflect.TypeFor[*int]()},
+		{Name: "Reason", FieldKey: "reason", Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{
+			{Name: "required_if", Params: []string{"Status", "cancelled", "Seats", "2"}},
+		}},
+	}
+	plan, err := tagparser.CompileCrossFieldPlan(fields)
+	require.NoError(t, err)
+
+	assert.Equal(t, `.CompareFields(gozod.FieldComparison{Field: "end", Relation: gozod.FieldGreater, Other: "start"})`+
+		`.RequiredIf("reason", gozod.FieldCondition{Field: "status", Value: "cancelled"}, gozod.FieldCondition{Field: "seats", Value: 2})`,
+		crossFieldCalls(plan))
+	assert.Equal(t, []string{
+		`errs.CompareField("end", b.End, gozod.FieldGreater, "start", b.Start)`,
+		`errs.RequireIf("reason", b.Reason, []gozod.FieldCondition{{Field: "status", Value: "cancelled"}, {Field: "seats", Value: 2}}, b.Status, b.Seats)`,
+	}, crossFieldChecks(fields, plan, "b"))
+	assert.Empty(t, crossFieldCalls(tagparser.CrossFieldPlan{}))
+	assert.Empty(t, crossFieldChecks(fields, tagparser.CrossFieldPlan{}, "b"))
+}
+
 func TestFileWriter_GenerateCode(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -661,6 +775,64 @@ func TestFileWriter_GenerateCode(t *testing.T) {
 				"Generated at:",
 			},
 		},
+		{
+			name: "required_with dependencies",
+			info: &GenerationInfo{
+				Name:     "Payment",
+				FilePath: "test.go",
+				Fields: []tagparser.FieldInfo{
+					{
+						Name:     "BillingAddress",
+						FieldKey: "billing_address",
+						Type:     reflect.TypeFor[string](),
+					},
+					{
+						Name:     "BillingName",
+						FieldKey: "billing_name",
+						Type:     reflect.TypeFor[string](),
+						Rules: []tagparser.TagRule{
+							{Name: "required_with", Params: []string{"BillingAddress"}},
+						},
+					},
+				},
+			},
+			expectedContent: []string{
+				`}).DependentRequired(map[string][]string{"billing_address": {"billing_name"}})`,
+			},
+			unexpectedContent: []string{
+				"required_with",
+			},
+		},
+		{
+			name: "cross-field rules",
+			info: &GenerationInfo{
+				Name:     "Signup",
+				FilePath: "test.go",
+				Fields: []tagparser.FieldInfo{
+					{
+						Name:     "Password",
+						FieldKey: "password",
+						Type:     reflect.TypeFor[string](),
+					},
+					{
+						Name:     "Confirm",
+						FieldKey: "confirm",
+						Type:     reflect.TypeFor[string](),
+						Rules: []tagparser.TagRule{
+							{Name: "eqfield", Params: []string{"Password"}},
+							{Name: "required_if", Params: []string{"Password", "reset"}},
+						},
+					},
+				},
+			},
+			expectedContent: []string{
+				`}).CompareFields(gozod.FieldComparison{Field: "confirm", Relation: gozod.FieldEqual, Other: "password"}).RequiredIf("confirm", gozod.FieldCondition{Field: "password", Value: "reset"})`,
+			},
+			unexpectedContent: []string{
+				"eqfield",
+				"required_if",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -759,6 +931,139 @@ func TestFileWriter_GenerateFieldSchemaCodeForStruct(t *testing.T) {
 	}
 }
 
+func TestFileWriter_GenerateFieldSchemaCodeForElementRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		typeName string
+		rules    []tagparser.TagRule
+		expected string
+	}{
+		{
+			name:     "slice elements",
+			typ:      reflect.TypeFor[[]string](),
+			typeName: "[]string",
+			rules:    []tagparser.TagRule{{Name: "required"}, {Name: "dive"}, {Name: "min", Params: []string{"2"}}},
+			expected: "gozod.Slice[string](gozod.String().Min(2))",
+		},
+		{
+			name:     "nested slice elements",
+			typ:      reflect.TypeFor[[][]int](),
+			typeName: "[][]int",
+			rules: []tagparser.TagRule{
+				{Name: "required"}, {Name: "dive"}, {Name: "min", Params: []string{"1"}}, {Name: "dive"}, {Name: "positive"},
+			},
+			expected: "gozod.Slice[[]int](gozod.Slice[int](gozod.Int().Positive()).Min(1))",
+		},
+		{
+			name:     "map keys and values",
+			typ:      reflect.TypeFor[map[string]string](),
+			typeName: "map[string]string",
+			rules: []tagparser.TagRule{
+				{Name: "required"}, {Name: "keys"}, {Name: "min", Params: []string{"2"}}, {Name: "values"}, {Name: "email"},
+			},
+			expected: "gozod.Record[string, string](gozod.String().Min(2), gozod.Email())",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer, err := NewFileWriter("", "main", "_gen.go", true, false)
+			require.NoError(t, err)
+			field := tagparser.FieldInfo{Name: "Field", FieldKey: "field", Type: tt.typ, TypeName: tt.typeName}
+			require.NoError(t, field.SetRules(tt.rules))
+
+			result, err := writer.generateFieldSchemaCode(&field, "TestStruct")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("rules of the wrong element family", func(t *testing.T) {
+		writer, err := NewFileWriter("", "main", "_gen.go", true, false)
+		require.NoError(t, err)
+		field := tagparser.FieldInfo{Name: "Counts", FieldKey: "counts", Type: reflect.TypeFor[[]int](), TypeName: "[]int"}
+		require.NoError(t, field.SetRules([]tagparser.TagRule{{Name: "dive"}, {Name: "email"}}))
+
+		_, err = writer.generateFieldSchemaCode(&field, "TestStruct")
+
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		assert.ErrorContains(t, err, "Counts[]")
+	})
+}
+
+func TestFileWriter_GenerateFieldSchemaCodeForEnumType(t *testing.T) {
+	writer, err := NewFileWriter("", "main", "_gen.go", true, false)
+	require.NoError(t, err)
+	writer.enums = map[string][]any{
+		"Status":     {"open", "closed"},
+		"pkg.Level":  {int64(-1), int64(1)},
+		"Unassigned": nil,
+	}
+
+	tests := []struct {
+		name     string
+		field    tagparser.FieldInfo
+		rules    []tagparser.TagRule
+		expected string
+	}{
+		{
+			name:     "string type",
+			field:    tagparser.FieldInfo{Type: reflect.TypeFor[string](), TypeName: "Status"},
+			rules:    []tagparser.TagRule{{Name: "required"}, {Name: "enum_type"}},
+			expected: `gozod.Enum("open", "closed")`,
+		},
+		{
+			name:     "pointer to imported integer type",
+			field:    tagparser.FieldInfo{Type: reflect.TypeFor[*int16](), TypeName: "*pkg.Level"},
+			rules:    []tagparser.TagRule{{Name: "enum_type"}},
+			expected: "gozod.EnumPtr[int16](-1, 1).Optional()",
+		},
+		{
+			name:     "map keys",
+			field:    tagparser.FieldInfo{Type: reflect.TypeFor[map[string]int16](), TypeName: "map[Status]pkg.Level"},
+			rules:    []tagparser.TagRule{{Name: "required"}, {Name: "keys"}, {Name: "enum_type"}, {Name: "values"}, {Name: "enum_type"}},
+			expected: `gozod.Record[string, int16](gozod.Enum("open", "closed"), gozod.Enum[int16](-1, 1)).Partial()`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := tt.field
+			field.Name, field.FieldKey = "Field", "field"
+			require.NoError(t, field.SetRules(tt.rules))
+
+			result, err := writer.generateFieldSchemaCode(&field, "TestStruct")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("type without constants", func(t *testing.T) {
+		field := tagparser.FieldInfo{Name: "Field", FieldKey: "field", Type: reflect.TypeFor[string](), TypeName: "Unassigned"}
+		require.NoError(t, field.SetRules([]tagparser.TagRule{{Name: "enum_type"}}))
+
+		_, err := writer.generateFieldSchemaCode(&field, "TestStruct")
+
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		assert.ErrorContains(t, err, "Unassigned")
+	})
+
+	t.Run("type that marshals to text", func(t *testing.T) {
+		writer.marshalers = map[string]bool{"Status": true}
+		defer func() { writer.marshalers = nil }()
+		field := tagparser.FieldInfo{Name: "Field", FieldKey: "field", Type: reflect.TypeFor[string](), TypeName: "Status"}
+		require.NoError(t, field.SetRules([]tagparser.TagRule{{Name: "enum_type"}}))
+
+		_, err := writer.generateFieldSchemaCode(&field, "TestStruct")
+
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		assert.ErrorContains(t, err, "marshals to text")
+	})
+}
+
 func TestBasicTypeConstructor(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -837,6 +1142,18 @@ func TestCircularReferenceHandling(t *testing.T) {
 			structName: "Node",
 			expected:   "gozod.Record[string, *Node](gozod.String(), gozod.LazyTyped[*Node](func() any { return gozod.MustFromStruct[Node]() }))",
 		},
+		{
+			name:       "generic instantiation",
+			typeName:   "Page[User]",
+			structName: "Node",
+			expected:   "gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[User]]() })",
+		},
+		{
+			name:       "map of generic instantiation",
+			typeName:   "map[string]Page[User]",
+			structName: "Node",
+			expected:   "gozod.Record[string, Page[User]](gozod.String(), gozod.LazyAny(func() any { return gozod.MustFromStruct[Page[User]]() }))",
+		},
 	}
 
 	for _, tt := range tests {
@@ -854,6 +1171,39 @@ func TestCircularReferenceHandling(t *testing.T) {
 	}
 }
 
+func TestFileWriter_GenerateFieldSchemaCodeForInterfaces(t *testing.T) {
+	writer, err := NewFileWriter("", "main", "_gen.go", true, false)
+	require.NoError(t, err)
+	writer.interfaces = map[string]bool{"Shape": true}
+
+	field := tagparser.FieldInfo{
+		Name:     "Shape",
+		Type:     reflect.TypeFor[any](),
+		TypeName: "Shape",
+		Rules:    []tagparser.TagRule{{Name: "required"}},
+		Required: true,
+	}
+	code, err := writer.generateFieldSchemaCode(&field, "Drawing")
+	require.NoError(t, err)
+	assert.Equal(t, "gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() })", code)
+
+	layers := tagparser.FieldInfo{
+		Name:     "Layers",
+		Type:     reflect.TypeFor[[]any](),
+		TypeName: "[]Shape",
+		Rules:    []tagparser.TagRule{{Name: "max", Params: []string{"3"}}},
+	}
+	code, err = writer.generateFieldSchemaCode(&layers, "Drawing")
+	require.NoError(t, err)
+	assert.Equal(t, "gozod.Slice[Shape](gozod.LazyAny(func() any { return gozod.MustInterfaceSchema[Shape]() })).Max(3).Optional()", code)
+
+	// A struct type of the same name still uses FromStruct.
+	writer.interfaces = nil
+	code, err = writer.generateFieldSchemaCode(&field, "Drawing")
+	require.NoError(t, err)
+	assert.Equal(t, "gozod.MustFromStruct[Shape]()", code)
+}
+
 func TestBaseConstructor_FieldNameTag(t *testing.T) {
 	result, err := baseConstructor("Profile", "User", "yaml")
 	require.NoError(t, err)
@@ -899,6 +1249,15 @@ func TestGenerateValidatorChain(t *testing.T) {
 		// Required (returns empty)
 		{name: "required", rule: tagparser.TagRule{Name: "required"}, fieldType: reflect.TypeFor[string](), expected: ""},
 
+		// Time range validators
+		{name: "after", rule: tagparser.TagRule{Name: "after", Params: []string{"2020-01-01T00:00:00Z"}}, fieldType: reflect.TypeFor[time.Time](), expected: ".After(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))"},
+		{name: "before with offset", rule: tagparser.TagRule{Name: "before", Params: []string{"2030-06-01T02:30:00.5+02:00"}}, fieldType: reflect.TypeFor[*time.Time](), expected: ".Before(time.Date(2030, time.June, 1, 0, 30, 0, 500000000, time.UTC))"},
+		{name: "past", rule: tagparser.TagRule{Name: "past"}, fieldType: reflect.TypeFor[time.Time](), expected: ".Past()"},
+		{name: "future", rule: tagparser.TagRule{Name: "future"}, fieldType: reflect.TypeFor[time.Time](), expected: ".Future()"},
+		{name: "unique", rule: tagparser.TagRule{Name: "unique"}, fieldType: reflect.TypeFor[[]string](), expected: ".Unique()"},
+		{name: "unique on string fails", rule: tagparser.TagRule{Name: "unique"}, fieldType: reflect.TypeFor[string](), wantErr: true},
+		{name: "past on string fails", rule: tagparser.TagRule{Name: "past"}, fieldType: reflect.TypeFor[string](), wantErr: true},
+
 		// Time (returns empty)
 		{name: "time on string fails", rule: tagparser.TagRule{Name: "time"}, fieldType: reflect.TypeFor[string](), wantErr: true},
 		{name: "enum method returns empty", rule: tagparser.TagRule{Name: "enum", Params: []string{"active"}}, fieldType: reflect.TypeFor[string](), expected: ""},
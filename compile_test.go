@@ -0,0 +1,110 @@
+package gozod_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/kaptinlin/gozod"
+)
+
+type benchGeo struct {
+	Lat float64 `json:"lat" gozod:"min=-90,max=90"`
+	Lng float64 `json:"lng" gozod:"min=-180,max=180"`
+}
+
+type benchAddress struct {
+	Street string   `json:"street" gozod:"required,min=3"`
+	City   string   `json:"city" gozod:"required,min=2"`
+	Zip    string   `json:"zip" gozod:"required,regex=^[0-9]{5}$"`
+	Geo    benchGeo `json:"geo" gozod:"required"`
+}
+
+type benchLineItem struct {
+	SKU      string  `json:"sku" gozod:"required,min=4"`
+	Quantity int     `json:"quantity" gozod:"required,min=1"`
+	Price    float64 `json:"price" gozod:"min=0"`
+}
+
+type benchOrder struct {
+	ID       string          `json:"id" gozod:"required,uuid"`
+	Items    []benchLineItem `json:"items" gozod:"required,min=1"`
+	Shipping benchAddress    `json:"shipping" gozod:"required"`
+	Note     *string         `json:"note"`
+}
+
+type benchCustomer struct {
+	Name    string       `json:"name" gozod:"required,min=2,max=50"`
+	Email   string       `json:"email" gozod:"required,email"`
+	Billing benchAddress `json:"billing" gozod:"required"`
+	Orders  []benchOrder `json:"orders" gozod:"required"`
+}
+
+func newBenchCustomer() benchCustomer {
+	address := benchAddress{
+		Street: "1 Infinite Loop",
+		City:   "Cupertino",
+		Zip:    "95014",
+		Geo:    benchGeo{Lat: 37.33, Lng: -122.03},
+	}
+	orders := make([]benchOrder, 4)
+	for i := range orders {
+		orders[i] = benchOrder{
+			ID: "123e4567-e89b-12d3-a456-426614174000",
+			Items: []benchLineItem{
+				{SKU: "SKU-1", Quantity: 1, Price: 9.5},
+				{SKU: "SKU-2", Quantity: 3, Price: 1.25},
+			},
+			Shipping: address,
+		}
+	}
+	return benchCustomer{Name: "Ada Lovelace", Email: "ada@example.com", Billing: address, Orders: orders}
+}
+
+func TestCompile_DeepFromStruct(t *testing.T) {
+	schema := MustFromStruct[benchCustomer]()
+	compiled := Compile(schema)
+	customer := newBenchCustomer()
+
+	want, err := schema.Parse(customer)
+	require.NoError(t, err)
+	got, err := compiled.Parse(customer)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func BenchmarkCompile_DeepFromStruct(b *testing.B) {
+	schema := MustFromStruct[benchCustomer]()
+	customer := newBenchCustomer()
+
+	b.Run("Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := schema.Parse(customer); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Compiled", func(b *testing.B) {
+		compiled := Compile(schema)
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := compiled.Parse(customer); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("CompiledParallel", func(b *testing.B) {
+		compiled := Compile(schema)
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := compiled.Parse(customer); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
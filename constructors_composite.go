@@ -85,6 +85,22 @@ func XorOf(schemas ...any) *types.ZodXor[any, any] {
 	return types.XorOf(schemas...)
 }
 
+func When(condition, then, otherwise any, args ...any) *ZodWhen[any, any] {
+	return types.When(condition, then, otherwise, args...)
+}
+
+func WhenPtr(condition, then, otherwise any, args ...any) *ZodWhen[any, *any] {
+	return types.WhenPtr(condition, then, otherwise, args...)
+}
+
+func Not(schema any, args ...any) *ZodNot[any, any] {
+	return types.Not(schema, args...)
+}
+
+func NotPtr(schema any, args ...any) *ZodNot[any, *any] {
+	return types.NotPtr(schema, args...)
+}
+
 func Intersection(left, right any, args ...any) *ZodIntersection[any, any] {
 	return types.Intersection(left, right, args...)
 }
@@ -140,3 +156,14 @@ func Struct[T any](params ...any) *ZodStruct[T, T] {
 func StructPtr[T any](params ...any) *ZodStruct[T, *T] {
 	return types.StructPtr[T](params...)
 }
+
+// Codec creates a bidirectional schema: Parse and Decode convert values
+// accepted by in into out, and Encode converts out values back into in.
+func Codec[In, Out any](
+	in ZodType[In],
+	out ZodType[Out],
+	decode func(In, *core.RefinementContext) (Out, error),
+	encode func(Out, *core.RefinementContext) (In, error),
+) *ZodCodec[In, Out] {
+	return core.NewZodCodec(in, out, decode, encode)
+}
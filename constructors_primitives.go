@@ -363,6 +363,14 @@ func ULIDPtr(params ...any) *ZodULID[*string] {
 	return types.ULIDPtr(params...)
 }
 
+func TemplateLiteral(parts ...any) *ZodTemplateLiteral[string] {
+	return types.TemplateLiteral(parts...)
+}
+
+func TemplateLiteralPtr(parts ...any) *ZodTemplateLiteral[*string] {
+	return types.TemplateLiteralPtr(parts...)
+}
+
 func XID(params ...any) *ZodXID[string] {
 	return types.XID(params...)
 }
@@ -113,3 +113,11 @@ func Lazy[S types.ZodSchemaType](getter func() S, params ...any) *types.ZodLazyT
 func LazyTyped[T types.LazyConstraint](getter func() any, params ...any) *types.ZodLazyOutput[T] {
 	return types.LazyTyped[T](getter, params...)
 }
+
+func Brand[B, T any](schema ZodType[T]) *ZodBrand[T, B] {
+	return types.Brand[B](schema)
+}
+
+func Compile[S ZodSchema](schema S) S {
+	return types.Compile(schema)
+}
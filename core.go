@@ -8,6 +8,9 @@ type ZodType[T any] = core.ZodType[T]
 // ZodSchema is the non-generic runtime schema interface.
 type ZodSchema = core.ZodSchema
 
+// ZodCodec is a bidirectional schema created by Codec.
+type ZodCodec[In, Out any] = core.ZodCodec[In, Out]
+
 // Unwrapper allows wrapper types to expose their underlying value for validation.
 type Unwrapper = core.Unwrapper
 
@@ -43,15 +46,18 @@ type (
 )
 
 const (
-	IssueInvalidType      = core.InvalidType
-	IssueInvalidValue     = core.InvalidValue
-	IssueInvalidFormat    = core.InvalidFormat
-	IssueInvalidUnion     = core.InvalidUnion
-	IssueInvalidKey       = core.InvalidKey
-	IssueInvalidElement   = core.InvalidElement
-	IssueTooBig           = core.TooBig
-	IssueTooSmall         = core.TooSmall
-	IssueNotMultipleOf    = core.NotMultipleOf
-	IssueUnrecognizedKeys = core.UnrecognizedKeys
-	IssueCustom           = core.Custom
+	IssueInvalidType            = core.InvalidType
+	IssueInvalidValue           = core.InvalidValue
+	IssueInvalidFormat          = core.InvalidFormat
+	IssueInvalidUnion           = core.InvalidUnion
+	IssueInvalidKey             = core.InvalidKey
+	IssueInvalidElement         = core.InvalidElement
+	IssueTooBig                 = core.TooBig
+	IssueTooSmall               = core.TooSmall
+	IssueNotMultipleOf          = core.NotMultipleOf
+	IssueUnrecognizedKeys       = core.UnrecognizedKeys
+	IssueCustom                 = core.Custom
+	IssueCanceled               = core.Canceled
+	IssueInvalidNot             = core.InvalidNot
+	IssueInvalidFieldComparison = core.InvalidFieldComparison
 )
@@ -0,0 +1,138 @@
+package core
+
+import "context"
+
+// ZodCodec pairs an input schema with an output schema and converts between
+// them in both directions. In is the wire-side type validated by the input
+// schema. Out is the Go-side type validated by the output schema. Parsing
+// decodes; Encode runs the conversion in reverse.
+type ZodCodec[In, Out any] struct {
+	in        ZodType[In]
+	out       ZodType[Out]
+	decode    func(In, *RefinementContext) (Out, error)
+	encode    func(Out, *RefinementContext) (In, error)
+	internals *ZodTypeInternals
+}
+
+var _ ZodSchema = (*ZodCodec[any, any])(nil)
+
+// Parse decodes input. It is an alias for Decode so codecs compose with
+// every API that accepts a schema.
+func (c *ZodCodec[In, Out]) Parse(input any, ctx ...*ParseContext) (Out, error) {
+	return c.Decode(input, ctx...)
+}
+
+// Decode validates input with the input schema, converts it with the decode
+// function, and validates the result with the output schema.
+func (c *ZodCodec[In, Out]) Decode(input any, ctx ...*ParseContext) (out Out, _ error) {
+	validated, err := c.in.Parse(input, ctx...)
+	if err != nil {
+		return out, err
+	}
+	refinementCtx := NewRefinementContext(getOrCreateContext(ctx...), validated)
+	decoded, err := c.decode(validated, refinementCtx)
+	if err != nil {
+		return out, err
+	}
+	if ctxErr := refinementCtx.Err(); ctxErr != nil {
+		return out, ctxErr
+	}
+	return c.out.Parse(decoded, ctx...)
+}
+
+// Encode validates value with the output schema, converts it with the encode
+// function, and validates the result with the input schema, so only values
+// the codec could have decoded are serialized.
+func (c *ZodCodec[In, Out]) Encode(value Out, ctx ...*ParseContext) (in In, _ error) {
+	validated, err := c.out.Parse(value, ctx...)
+	if err != nil {
+		return in, err
+	}
+	refinementCtx := NewRefinementContext(getOrCreateContext(ctx...), validated)
+	encoded, err := c.encode(validated, refinementCtx)
+	if err != nil {
+		return in, err
+	}
+	if ctxErr := refinementCtx.Err(); ctxErr != nil {
+		return in, ctxErr
+	}
+	return c.in.Parse(encoded, ctx...)
+}
+
+// MustParse decodes input, panicking on error.
+func (c *ZodCodec[In, Out]) MustParse(input any, ctx ...*ParseContext) Out {
+	result, err := c.Parse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseAny decodes input and returns an untyped result.
+func (c *ZodCodec[In, Out]) ParseAny(input any, ctx ...*ParseContext) (any, error) {
+	return c.Parse(input, ctx...)
+}
+
+// ParseWithContext decodes input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (c *ZodCodec[In, Out]) ParseWithContext(ctx context.Context, input any, parseCtx ...*ParseContext) (Out, error) {
+	return c.Parse(input, WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext decodes input under the caller context and returns an
+// untyped result.
+func (c *ZodCodec[In, Out]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error) {
+	return c.ParseAny(input, WithContext(ctx, parseCtx...))
+}
+
+// EncodeWithContext encodes value under the caller context.
+func (c *ZodCodec[In, Out]) EncodeWithContext(ctx context.Context, value Out, parseCtx ...*ParseContext) (In, error) {
+	return c.Encode(value, WithContext(ctx, parseCtx...))
+}
+
+// Internals returns the schema's internal configuration.
+func (c *ZodCodec[In, Out]) Internals() *ZodTypeInternals {
+	return c.internals
+}
+
+// IsOptional reports whether this schema accepts missing values.
+func (c *ZodCodec[In, Out]) IsOptional() bool {
+	return c.internals.IsOptional()
+}
+
+// IsNilable reports whether this schema accepts nil values.
+func (c *ZodCodec[In, Out]) IsNilable() bool {
+	return c.internals.IsNilable()
+}
+
+// Inner returns the input schema of this codec.
+func (c *ZodCodec[In, Out]) Inner() ZodSchema {
+	s, _ := c.in.(ZodSchema)
+	return s
+}
+
+// Output returns the output schema of this codec.
+func (c *ZodCodec[In, Out]) Output() ZodSchema {
+	s, _ := c.out.(ZodSchema)
+	return s
+}
+
+// NewZodCodec creates a bidirectional schema. decode converts values accepted
+// by in into values for out; encode converts values accepted by out back into
+// values for in.
+func NewZodCodec[In, Out any](
+	in ZodType[In],
+	out ZodType[Out],
+	decode func(In, *RefinementContext) (Out, error),
+	encode func(Out, *RefinementContext) (In, error),
+) *ZodCodec[In, Out] {
+	internals := in.Internals().Clone()
+	internals.Type = ZodTypeCodec
+	return &ZodCodec[In, Out]{
+		in:        in,
+		out:       out,
+		decode:    decode,
+		encode:    encode,
+		internals: internals,
+	}
+}
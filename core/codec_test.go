@@ -0,0 +1,98 @@
+package core
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNegative = errors.New("negative")
+
+func newTestCodec(t *testing.T, outCalls *int) *ZodCodec[string, int] {
+	t.Helper()
+
+	in := newTestZodType(ZodTypeString, func(input any, ctx *ParseContext) (string, error) {
+		value, ok := input.(string)
+		if !ok {
+			return "", ErrInvalidTransformType
+		}
+		return value, nil
+	})
+	out := newTestZodType(ZodTypeInteger, func(input any, ctx *ParseContext) (int, error) {
+		*outCalls++
+		value, ok := input.(int)
+		if !ok {
+			return 0, ErrInvalidTransformType
+		}
+		if value < 0 {
+			return 0, errNegative
+		}
+		return value, nil
+	})
+	return NewZodCodec(in, out,
+		func(s string, _ *RefinementContext) (int, error) { return strconv.Atoi(s) },
+		func(n int, _ *RefinementContext) (string, error) { return strconv.Itoa(n), nil },
+	)
+}
+
+func TestZodCodec_DecodesThroughBothSchemas(t *testing.T) {
+	t.Parallel()
+
+	var outCalls int
+	codec := newTestCodec(t, &outCalls)
+
+	got, err := codec.Parse("42")
+	require.NoError(t, err)
+	assert.Equal(t, 42, got)
+	assert.Equal(t, 1, outCalls)
+	assert.Equal(t, ZodTypeCodec, codec.Internals().Type)
+	assert.Equal(t, ZodTypeString, codec.Inner().Internals().Type)
+	assert.Equal(t, ZodTypeInteger, codec.Output().Internals().Type)
+
+	_, err = codec.Decode(42)
+	require.ErrorIs(t, err, ErrInvalidTransformType)
+
+	_, err = codec.Decode("-1")
+	require.ErrorIs(t, err, errNegative)
+}
+
+func TestZodCodec_EncodeValidatesOutputFirst(t *testing.T) {
+	t.Parallel()
+
+	var outCalls int
+	codec := newTestCodec(t, &outCalls)
+
+	got, err := codec.Encode(7)
+	require.NoError(t, err)
+	assert.Equal(t, "7", got)
+	assert.Equal(t, 1, outCalls)
+
+	_, err = codec.Encode(-7)
+	require.ErrorIs(t, err, errNegative)
+}
+
+func TestZodCodec_ReturnsRefinementIssues(t *testing.T) {
+	t.Parallel()
+
+	in := newTestZodType(ZodTypeString, func(input any, ctx *ParseContext) (string, error) {
+		return input.(string), nil
+	})
+	codec := NewZodCodec(in, in,
+		func(s string, ctx *RefinementContext) (string, error) {
+			ctx.AddIssue(ZodIssue{ZodIssueBase: ZodIssueBase{Message: "decode"}})
+			return s, nil
+		},
+		func(s string, ctx *RefinementContext) (string, error) {
+			ctx.AddIssue(ZodIssue{ZodIssueBase: ZodIssueBase{Message: "encode"}})
+			return s, nil
+		},
+	)
+
+	_, err := codec.Decode("gozod")
+	require.Error(t, err)
+	_, err = codec.Encode("gozod")
+	require.Error(t, err)
+}
@@ -33,10 +33,19 @@ const (
 	// Intersection validation issues
 	IncompatibleTypes IssueCode = "incompatible_types"
 
+	// Negation validation issues
+	InvalidNot IssueCode = "invalid_not"
+
+	// Cross-field validation issues
+	InvalidFieldComparison IssueCode = "invalid_field_comparison"
+
 	// New validation issues
 	MissingRequired IssueCode = "missing_required"
 	TypeConversion  IssueCode = "type_conversion"
 	NilPointer      IssueCode = "nil_pointer"
+
+	// Cancellation issues
+	Canceled IssueCode = "canceled"
 )
 
 // ZodTypeCode represents a type-safe wrapper for schema type identifiers.
@@ -76,6 +85,8 @@ const (
 	ZodTypeXor           ZodTypeCode = "xor"
 	ZodTypeDiscriminated ZodTypeCode = "discriminated_union"
 	ZodTypeIntersection  ZodTypeCode = "intersection"
+	ZodTypeNot           ZodTypeCode = "not"
+	ZodTypeWhen          ZodTypeCode = "when"
 
 	// Special string types
 	ZodTypeStringBool ZodTypeCode = "stringbool"
@@ -93,11 +104,13 @@ const (
 	ZodTypeNilable  ZodTypeCode = "nilable"
 	ZodTypeDefault  ZodTypeCode = "default"
 	ZodTypePrefault ZodTypeCode = "prefault"
+	ZodTypeBrand    ZodTypeCode = "brand"
 
 	// Processing types
 	ZodTypePipeline  ZodTypeCode = "pipeline"
 	ZodTypeTransform ZodTypeCode = "transform"
 	ZodTypePipe      ZodTypeCode = "pipe"
+	ZodTypeCodec     ZodTypeCode = "codec"
 	ZodTypeCustom    ZodTypeCode = "custom"
 	ZodTypeCheck     ZodTypeCode = "check"
 	ZodTypeRefine    ZodTypeCode = "refine"
@@ -1,8 +1,10 @@
 package core
 
 import (
+	"context"
 	"maps"
 	"slices"
+	"time"
 )
 
 // NewParseContext creates a new parse context with default values.
@@ -114,11 +116,25 @@ func (p *ParsePayload) WithCleanIssues() *ParsePayload {
 	}
 }
 
+// WithContext returns a parse context carrying the caller context. Settings
+// are copied from the first base context when one is provided.
+func WithContext(c context.Context, base ...*ParseContext) *ParseContext {
+	pc := &ParseContext{}
+	if len(base) > 0 && base[0] != nil {
+		*pc = *base[0]
+	}
+	pc.Context = c
+	return pc
+}
+
 // WithCustomError creates a new context with a custom error mapping function.
 func (ctx *ParseContext) WithCustomError(errorMap ZodErrorMap) *ParseContext {
 	return &ParseContext{
 		Error:       errorMap,
 		ReportInput: ctx.ReportInput,
+		Now:         ctx.Now,
+		Context:     ctx.Context,
+		Workers:     ctx.Workers,
 	}
 }
 
@@ -127,6 +143,9 @@ func (ctx *ParseContext) WithReportInput(report bool) *ParseContext {
 	return &ParseContext{
 		Error:       ctx.Error,
 		ReportInput: report,
+		Now:         ctx.Now,
+		Context:     ctx.Context,
+		Workers:     ctx.Workers,
 	}
 }
 
@@ -135,7 +154,34 @@ func (ctx *ParseContext) Clone() *ParseContext {
 	return &ParseContext{
 		Error:       ctx.Error,
 		ReportInput: ctx.ReportInput,
+		Now:         ctx.Now,
+		Context:     ctx.Context,
+		Workers:     ctx.Workers,
+	}
+}
+
+// CurrentTime returns the context clock reading, falling back to time.Now.
+func (ctx *ParseContext) CurrentTime() time.Time {
+	if ctx != nil && ctx.Now != nil {
+		return ctx.Now()
+	}
+	return time.Now()
+}
+
+// ContextOrBackground returns the caller context, falling back to context.Background.
+func (ctx *ParseContext) ContextOrBackground() context.Context {
+	if ctx != nil && ctx.Context != nil {
+		return ctx.Context
+	}
+	return context.Background()
+}
+
+// ContextErr reports why the caller context is done, or nil while parsing may continue.
+func (ctx *ParseContext) ContextErr() error {
+	if ctx == nil || ctx.Context == nil {
+		return nil
 	}
+	return ctx.Context.Err()
 }
 
 // SetValue assigns a new value to the payload.
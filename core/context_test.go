@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -102,6 +103,30 @@ func TestParseContext_CopyModifiersPreserveExistingFields(t *testing.T) {
 	assert.False(t, cloned.ReportInput)
 }
 
+func TestParseContext_WithContextCarriesCallerContext(t *testing.T) {
+	t.Parallel()
+
+	callerCtx, cancel := context.WithCancel(context.Background())
+	base := &ParseContext{ReportInput: true}
+
+	pc := WithContext(callerCtx, base)
+	require.NotSame(t, base, pc)
+	assert.Same(t, callerCtx, pc.Context)
+	assert.True(t, pc.ReportInput)
+	assert.Nil(t, base.Context)
+	assert.Same(t, callerCtx, pc.Clone().Context)
+	assert.Same(t, callerCtx, pc.WithReportInput(false).Context)
+	require.NoError(t, pc.ContextErr())
+
+	cancel()
+	require.ErrorIs(t, pc.ContextErr(), context.Canceled)
+
+	var nilCtx *ParseContext
+	require.NoError(t, nilCtx.ContextErr())
+	assert.Equal(t, context.Background(), nilCtx.ContextOrBackground())
+	assert.Same(t, callerCtx, NewRefinementContext(pc, "value").ContextOrBackground())
+}
+
 func TestParsePayload_AddIssuesAppendsIssues(t *testing.T) {
 	t.Parallel()
 
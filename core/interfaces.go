@@ -3,6 +3,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"maps"
@@ -32,14 +33,19 @@ const (
 	ZodModifierDefault ZodModifierKind = "default"
 	// ZodModifierPrefault records a Prefault modifier.
 	ZodModifierPrefault ZodModifierKind = "prefault"
+	// ZodModifierCatch records a Catch modifier.
+	ZodModifierCatch ZodModifierKind = "catch"
+	// ZodModifierReadonly records a Readonly modifier.
+	ZodModifierReadonly ZodModifierKind = "readonly"
 )
 
 // ZodModifier records one fluent modifier application in call order.
 type ZodModifier struct {
-	Kind     ZodModifierKind
-	Value    any
-	HasValue bool
-	Func     func() any
+	Kind      ZodModifierKind
+	Value     any
+	HasValue  bool
+	Func      func() any
+	CatchFunc func(CatchContext) any
 }
 
 // ZodSchema is the minimal non-generic runtime contract shared by all schemas.
@@ -57,6 +63,9 @@ type ZodSchema interface {
 	// ParseAny validates input and returns an untyped result.
 	ParseAny(input any, ctx ...*ParseContext) (any, error)
 
+	// ParseAnyContext validates input under a caller context.Context.
+	ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error)
+
 	// Internals provides access to the internal state of this schema.
 	Internals() *ZodTypeInternals
 
@@ -212,7 +221,8 @@ func (z *ZodTypeInternals) IsOptional() bool {
 			return true
 		case ZodModifierNonOptional:
 			return false
-		case ZodModifierNilable, ZodModifierDefault, ZodModifierPrefault:
+		case ZodModifierNilable, ZodModifierDefault, ZodModifierPrefault, ZodModifierCatch,
+			ZodModifierReadonly:
 		}
 	}
 	return false
@@ -226,7 +236,8 @@ func (z *ZodTypeInternals) IsNilable() bool {
 			return true
 		case ZodModifierNonOptional, ZodModifierExactOptional:
 			return false
-		case ZodModifierOptional, ZodModifierDefault, ZodModifierPrefault:
+		case ZodModifierOptional, ZodModifierDefault, ZodModifierPrefault, ZodModifierCatch,
+			ZodModifierReadonly:
 		}
 	}
 	return false
@@ -245,7 +256,7 @@ func (z *ZodTypeInternals) IsNonOptional() bool {
 			return true
 		case ZodModifierOptional, ZodModifierNilable, ZodModifierExactOptional:
 			return false
-		case ZodModifierDefault, ZodModifierPrefault:
+		case ZodModifierDefault, ZodModifierPrefault, ZodModifierCatch, ZodModifierReadonly:
 		}
 	}
 	return false
@@ -260,6 +271,7 @@ func (z *ZodTypeInternals) IsExactOptional() bool {
 		case ZodModifierOptional, ZodModifierNilable, ZodModifierNonOptional,
 			ZodModifierDefault, ZodModifierPrefault:
 			return false
+		case ZodModifierCatch, ZodModifierReadonly:
 		}
 	}
 	return false
@@ -274,6 +286,7 @@ func (z *ZodTypeInternals) NilInputUsesDefault() bool {
 		case ZodModifierPrefault, ZodModifierOptional, ZodModifierNilable,
 			ZodModifierNonOptional, ZodModifierExactOptional:
 			return false
+		case ZodModifierCatch, ZodModifierReadonly:
 		}
 	}
 	return false
@@ -288,6 +301,7 @@ func (z *ZodTypeInternals) NilInputUsesFallback() bool {
 		case ZodModifierOptional, ZodModifierNilable, ZodModifierNonOptional,
 			ZodModifierExactOptional:
 			return false
+		case ZodModifierCatch, ZodModifierReadonly:
 		}
 	}
 	return false
@@ -360,6 +374,56 @@ func (z *ZodTypeInternals) SetPrefaultFunc(fn func() any) {
 	})
 }
 
+// SetCatchValue sets a fallback value returned when parsing fails.
+func (z *ZodTypeInternals) SetCatchValue(value any) {
+	z.Modifiers = append(z.Modifiers, ZodModifier{
+		Kind:     ZodModifierCatch,
+		Value:    cloneutil.Clone(value),
+		HasValue: true,
+	})
+}
+
+// SetCatchFunc sets a fallback function called when parsing fails.
+func (z *ZodTypeInternals) SetCatchFunc(fn func(CatchContext) any) {
+	z.Modifiers = append(z.Modifiers, ZodModifier{
+		Kind:      ZodModifierCatch,
+		CatchFunc: fn,
+	})
+}
+
+// CatchFallback resolves the outermost Catch modifier for a failed parse.
+// It reports false when the schema has no Catch modifier.
+func (z *ZodTypeInternals) CatchFallback(ctx CatchContext) (any, bool) {
+	for i := len(z.Modifiers) - 1; i >= 0; i-- {
+		modifier := z.Modifiers[i]
+		if modifier.Kind != ZodModifierCatch {
+			continue
+		}
+		if modifier.CatchFunc != nil {
+			return modifier.CatchFunc(ctx), true
+		}
+		return cloneutil.Clone(modifier.Value), true
+	}
+	return nil, false
+}
+
+// SetReadonly marks parse results as deep-copied, read-only snapshots.
+func (z *ZodTypeInternals) SetReadonly(value bool) {
+	if value {
+		z.Modifiers = append(z.Modifiers, ZodModifier{Kind: ZodModifierReadonly})
+	}
+}
+
+// IsReadonly reports whether a Readonly modifier has been applied.
+func (z *ZodTypeInternals) IsReadonly() bool {
+	for _, modifier := range z.Modifiers {
+		if modifier.Kind == ZodModifierReadonly {
+			return true
+		}
+	}
+	return false
+}
+
 // SetTransform sets a transform function.
 func (z *ZodTypeInternals) SetTransform(fn func(any, *RefinementContext) (any, error)) {
 	z.Transform = fn
@@ -29,25 +29,26 @@ type ZodRawIssue struct {
 // ZodIssue represents a finalized validation issue.
 type ZodIssue struct {
 	ZodIssueBase
-	Expected  ZodTypeCode    `json:"expected,omitempty"`  // Expected type or value
-	Received  ZodTypeCode    `json:"received,omitempty"`  // Actual type or value
-	Minimum   any            `json:"minimum,omitempty"`   // Minimum value for range checks
-	Maximum   any            `json:"maximum,omitempty"`   // Maximum value for range checks
-	Inclusive bool           `json:"inclusive,omitempty"` // Whether range bounds are inclusive
-	Keys      []string       `json:"keys,omitempty"`      // Keys for unrecognized_keys errors
-	Errors    [][]ZodIssue   `json:"errors,omitempty"`    // Nested errors for union types
-	Issues    []ZodIssue     `json:"issues,omitempty"`    // Sub-issues for complex validations
-	Format    string         `json:"format,omitempty"`    // Expected format for format validation
-	Divisor   any            `json:"divisor,omitempty"`   // Divisor for multiple_of validation
-	Pattern   string         `json:"pattern,omitempty"`   // Regex pattern for string validation
-	Includes  string         `json:"includes,omitempty"`  // Substring for includes validation
-	Prefix    string         `json:"prefix,omitempty"`    // Prefix for starts_with validation
-	Suffix    string         `json:"suffix,omitempty"`    // Suffix for ends_with validation
-	Values    []any          `json:"values,omitempty"`    // Valid values for enum validation
-	Algorithm string         `json:"algorithm,omitempty"` // Algorithm for JWT validation
-	Origin    string         `json:"origin,omitempty"`    // Origin type for size validation
-	Key       any            `json:"key,omitempty"`       // Key for invalid element errors
-	Params    map[string]any `json:"params,omitempty"`    // Custom parameters for validation
+	Expected    ZodTypeCode    `json:"expected,omitempty"`    // Expected type or value
+	Received    ZodTypeCode    `json:"received,omitempty"`    // Actual type or value
+	Minimum     any            `json:"minimum,omitempty"`     // Minimum value for range checks
+	Maximum     any            `json:"maximum,omitempty"`     // Maximum value for range checks
+	Inclusive   bool           `json:"inclusive,omitempty"`   // Whether range bounds are inclusive
+	Keys        []string       `json:"keys,omitempty"`        // Keys for unrecognized_keys errors
+	Errors      [][]ZodIssue   `json:"errors,omitempty"`      // Nested errors for union types
+	Issues      []ZodIssue     `json:"issues,omitempty"`      // Sub-issues for complex validations
+	Format      string         `json:"format,omitempty"`      // Expected format for format validation
+	Divisor     any            `json:"divisor,omitempty"`     // Divisor for multiple_of validation
+	Pattern     string         `json:"pattern,omitempty"`     // Regex pattern for string validation
+	Includes    string         `json:"includes,omitempty"`    // Substring for includes validation
+	Prefix      string         `json:"prefix,omitempty"`      // Prefix for starts_with validation
+	Suffix      string         `json:"suffix,omitempty"`      // Suffix for ends_with validation
+	Values      []any          `json:"values,omitempty"`      // Valid values for enum validation
+	Algorithm   string         `json:"algorithm,omitempty"`   // Algorithm for JWT validation
+	Description string         `json:"description,omitempty"` // Negated schema description for not validation
+	Origin      string         `json:"origin,omitempty"`      // Origin type for size validation
+	Key         any            `json:"key,omitempty"`         // Key for invalid element errors
+	Params      map[string]any `json:"params,omitempty"`      // Custom parameters for validation
 }
 
 // ZodIssueInvalidType represents an invalid type error.
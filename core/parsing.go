@@ -1,8 +1,10 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"slices"
+	"time"
 )
 
 // ParseParams represents parameters for a single parse-time configuration.
@@ -13,9 +15,12 @@ type ParseParams struct {
 
 // ParseContext contains the configuration and state for a validation run.
 type ParseContext struct {
-	Error             ZodErrorMap // Custom error message generator
-	ReportInput       bool        // Include original input in issues
-	IsPrefaultContext bool        // Whether parsing a prefault value
+	Error             ZodErrorMap      // Custom error message generator
+	ReportInput       bool             // Include original input in issues
+	IsPrefaultContext bool             // Whether parsing a prefault value
+	Now               func() time.Time // Clock for time-relative checks; nil uses time.Now
+	Context           context.Context  // Caller context for cancellation and deadlines; nil means none
+	Workers           int              // Worker pool size for parallel refinements; below 2 runs serially
 }
 
 // RefinementContext provides context for refinement and transformation operations.
@@ -26,6 +31,13 @@ type RefinementContext struct {
 	issues   []ZodIssue
 }
 
+// CatchContext describes the failed parse handed to a CatchFunc fallback.
+type CatchContext struct {
+	Input  any        // The input that failed validation
+	Issues []ZodIssue // Issues swallowed by the fallback
+	Error  error      // The original parse error
+}
+
 // ParsePayload contains the value and validation issues during parsing.
 type ParsePayload struct {
 	value  any
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -20,6 +21,10 @@ func (s *registrySchema) ParseAny(input any, ctx ...*ParseContext) (any, error)
 	return input, nil
 }
 
+func (s *registrySchema) ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error) {
+	return s.ParseAny(input, parseCtx...)
+}
+
 func (s *registrySchema) Internals() *ZodTypeInternals {
 	return s.internals
 }
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -110,6 +111,18 @@ func (t *ZodTransform[In, Out]) ParseAny(input any, ctx ...*ParseContext) (any,
 	return t.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (t *ZodTransform[In, Out]) ParseWithContext(ctx context.Context, input any, parseCtx ...*ParseContext) (Out, error) {
+	return t.Parse(input, WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (t *ZodTransform[In, Out]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error) {
+	return t.ParseAny(input, WithContext(ctx, parseCtx...))
+}
+
 // Internals returns the schema's internal configuration.
 func (t *ZodTransform[In, Out]) Internals() *ZodTypeInternals {
 	return t.internals
@@ -183,6 +196,18 @@ func (p *ZodPipe[In, Out]) ParseAny(input any, ctx ...*ParseContext) (any, error
 	return p.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (p *ZodPipe[In, Out]) ParseWithContext(ctx context.Context, input any, parseCtx ...*ParseContext) (Out, error) {
+	return p.Parse(input, WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (p *ZodPipe[In, Out]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error) {
+	return p.ParseAny(input, WithContext(ctx, parseCtx...))
+}
+
 // Internals returns the schema's internal configuration.
 func (p *ZodPipe[In, Out]) Internals() *ZodTypeInternals {
 	return p.internals
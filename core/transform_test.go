@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,6 +37,10 @@ func (s *testZodType[T]) ParseAny(input any, ctx ...*ParseContext) (any, error)
 	return s.Parse(input, ctx...)
 }
 
+func (s *testZodType[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*ParseContext) (any, error) {
+	return s.ParseAny(input, WithContext(ctx, parseCtx...))
+}
+
 func (s *testZodType[T]) Internals() *ZodTypeInternals {
 	return s.internals
 }
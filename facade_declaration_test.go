@@ -51,6 +51,8 @@ func TestJSONSchemaFacadeHasNoRebindableCallables(t *testing.T) {
 		"ErrUnionInvalid":                  {},
 		"ErrUnionNoMembers":                {},
 		"ErrIntersectionInvalid":           {},
+		"ErrWhenInvalid":                   {},
+		"ErrNotInvalid":                    {},
 		"ErrInvalidEnumSchema":             {},
 		"ErrEnumExtractValues":             {},
 		"ErrLiteralNoValuesMethod":         {},
@@ -68,13 +70,14 @@ func TestJSONSchemaFacadeHasNoRebindableCallables(t *testing.T) {
 		"ErrInvalidJSONSchema":             {},
 		"ErrJSONSchemaCircularRef":         {},
 		"ErrJSONSchemaPatternCompile":      {},
-		"ErrJSONSchemaIfThenElse":          {},
-		"ErrJSONSchemaPatternProperties":   {},
 		"ErrJSONSchemaDynamicRef":          {},
-		"ErrJSONSchemaUnevaluatedProps":    {},
+		"ErrJSONSchemaRefNotFound":         {},
+		"ErrJSONSchemaIfThenElse":          {},
 		"ErrJSONSchemaUnevaluatedItems":    {},
+		"ErrJSONSchemaUnevaluatedProps":    {},
 		"ErrJSONSchemaDependentSchemas":    {},
 		"ErrJSONSchemaPropertyNames":       {},
+		"ErrJSONSchemaPatternProperties":   {},
 		"ErrJSONSchemaContains":            {},
 	})
 }
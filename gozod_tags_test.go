@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	. "github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
 )
 
 // =============================================================================
@@ -56,6 +57,113 @@ func TestMainFromStruct_ConstructionError(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid.Age")
 }
 
+func TestMainRegisterTagRule(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterTagRule("main_country", FieldFamilyString, func(schema ZodSchema, _ string) (ZodSchema, error) {
+		return schema.(*ZodString[string]).Length(2).ToUpperCase(), nil
+	}, WithTagRuleOperand(TagRuleOperandNone))
+	require.NoError(t, err)
+	require.ErrorIs(t, RegisterTagRule("min", FieldFamilyString, func(schema ZodSchema, _ string) (ZodSchema, error) {
+		return schema, nil
+	}), ErrInvalidTagRule)
+
+	type address struct {
+		Country string `json:"country" gozod:"required,main_country"`
+	}
+	schema, err := FromStruct[address]()
+	require.NoError(t, err)
+
+	result, err := schema.Parse(address{Country: "de"})
+	require.NoError(t, err)
+	assert.Equal(t, "DE", result.Country)
+	_, err = schema.Parse(address{Country: "deu"})
+	require.Error(t, err)
+}
+
+type mainOrderState string
+
+const (
+	mainOrderNew     mainOrderState = "new"
+	mainOrderShipped mainOrderState = "shipped"
+)
+
+func TestMainRegisterEnum(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, RegisterEnum(mainOrderNew, mainOrderShipped))
+	require.ErrorIs(t, RegisterEnum(mainOrderNew), ErrInvalidEnumRegistration)
+
+	type order struct {
+		State mainOrderState `json:"state" gozod:"required,enum_type"`
+	}
+	schema, err := FromStruct[order]()
+	require.NoError(t, err)
+
+	_, err = schema.Parse(order{State: mainOrderShipped})
+	require.NoError(t, err)
+	_, err = schema.Parse(order{State: "lost"})
+	require.Error(t, err)
+
+	js, err := ToJSONSchema(schema)
+	require.NoError(t, err)
+	require.NotNil(t, js.Properties)
+	assert.Equal(t, []any{"new", "shipped"}, (*js.Properties)["state"].Enum)
+}
+
+type mainShape interface{ Sides() int }
+
+type mainTriangle struct {
+	Kind string `json:"kind"`
+	Base int    `json:"base"`
+}
+
+func (mainTriangle) Sides() int { return 3 }
+
+type mainAudited struct {
+	CreatedBy string `json:"created_by" gozod:"required,min=2"`
+}
+
+type mainPage[T any] struct {
+	Items []T `json:"items" gozod:"required,min=1"`
+}
+
+func TestMainRegisterInterface(t *testing.T) {
+	t.Parallel()
+
+	shapes := MustDiscriminatedUnion("kind", []ZodSchema{
+		Struct[mainTriangle](StructSchema{"kind": Literal("triangle"), "base": Int().Positive()}),
+	})
+	require.NoError(t, RegisterInterface[mainShape](shapes))
+	require.ErrorIs(t, RegisterInterface[mainShape](shapes), ErrInvalidInterfaceRegistration)
+	assert.Same(t, shapes, MustInterfaceSchema[mainShape]())
+
+	type drawing struct {
+		mainAudited
+		Shape mainShape             `json:"shape" gozod:"required"`
+		Pages mainPage[mainAudited] `json:"pages" gozod:"required"`
+	}
+	schema, err := FromStruct[drawing]()
+	require.NoError(t, err)
+
+	valid := drawing{
+		mainAudited: mainAudited{CreatedBy: "ada"},
+		Shape:       mainTriangle{Kind: "triangle", Base: 2},
+		Pages:       mainPage[mainAudited]{Items: []mainAudited{{CreatedBy: "bob"}}},
+	}
+	result, err := schema.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, valid, result)
+
+	_, err = schema.Parse(drawing{
+		mainAudited: mainAudited{CreatedBy: "a"},
+		Shape:       mainTriangle{Kind: "triangle"},
+	})
+	var zodErr *ZodError
+	require.True(t, IsZodError(err, &zodErr))
+	assert.Len(t, zodErr.Issues, 3)
+}
+
 func TestMainFromStructPtr_BasicUsage(t *testing.T) {
 	// Test main package FromStructPtr function
 	schema := MustFromStructPtr[MainPackageUser]()
@@ -473,6 +581,45 @@ func TestTagValidation_TimeFormats(t *testing.T) {
 	})
 }
 
+func TestTagValidation_TimeRanges(t *testing.T) {
+	type Event struct {
+		StartsAt  time.Time  `gozod:"after=2020-01-01T00:00:00Z,before=2030-01-01T00:00:00Z"`
+		CreatedAt *time.Time `gozod:"past"`
+		ExpiresAt time.Time  `gozod:"future"`
+	}
+
+	schema := MustFromStruct[Event]()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := &core.ParseContext{Now: func() time.Time { return now }}
+	created := now.Add(-time.Hour)
+
+	t.Run("valid ranges", func(t *testing.T) {
+		_, err := schema.Parse(Event{
+			StartsAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			CreatedAt: &created,
+			ExpiresAt: now.Add(time.Hour),
+		}, ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("out of range values", func(t *testing.T) {
+		_, err := schema.Parse(Event{
+			StartsAt:  time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			CreatedAt: &created,
+			ExpiresAt: now.Add(-time.Hour),
+		}, ctx)
+		require.Error(t, err)
+
+		var zodErr *ZodError
+		require.True(t, IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 2)
+		for _, issue := range zodErr.Issues {
+			assert.Equal(t, IssueTooSmall, issue.Code)
+			assert.Equal(t, "date", issue.Origin)
+		}
+	})
+}
+
 // =============================================================================
 // COLLECTION VALIDATION TESTS
 // =============================================================================
@@ -518,6 +665,37 @@ func TestTagValidation_ArrayValidation(t *testing.T) {
 	})
 }
 
+func TestTagValidation_UniqueItems(t *testing.T) {
+	type TestStruct struct {
+		Tags  []string `gozod:"unique"`
+		Codes [3]int   `gozod:"unique"`
+	}
+
+	schema := MustFromStruct[TestStruct]()
+
+	_, err := schema.Parse(TestStruct{Tags: []string{"a", "b"}, Codes: [3]int{1, 2, 3}})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(TestStruct{Tags: []string{"a", "b", "a"}, Codes: [3]int{1, 2, 3}})
+	var zodErr *ZodError
+	require.True(t, IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"Tags", 2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+
+	_, err = schema.Parse(TestStruct{Tags: []string{"a"}, Codes: [3]int{1, 2, 2}})
+	require.True(t, IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"Codes", 2}, zodErr.Issues[0].Path)
+
+	type Invalid struct {
+		Name string `gozod:"unique"`
+	}
+	_, err = FromStruct[Invalid]()
+	require.Error(t, err)
+}
+
 func TestTagValidation_MapValidation(t *testing.T) {
 	type TestStruct struct {
 		Metadata map[string]string `gozod:"max=5"`
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	. "github.com/kaptinlin/gozod"
+	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/locales"
 )
 
@@ -265,6 +266,24 @@ func TestCompositeTypeExports(t *testing.T) {
 		_, _ = DiscriminatedUnion("type", []ZodSchema{String(), Int()})
 		_, _ = DiscriminatedUnionPtr("type", []ZodSchema{String(), Int()})
 	})
+
+	t.Run("codec", func(t *testing.T) {
+		isoTime := Codec(IsoDateTime(), Time(),
+			func(s string, _ *core.RefinementContext) (time.Time, error) { return time.Parse(time.RFC3339, s) },
+			func(v time.Time, _ *core.RefinementContext) (string, error) { return v.UTC().Format(time.RFC3339), nil },
+		)
+
+		decoded, err := isoTime.Decode("2024-05-01T10:00:00Z")
+		require.NoError(t, err)
+		assert.True(t, decoded.Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+
+		encoded, err := isoTime.Encode(decoded)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-05-01T10:00:00Z", encoded)
+
+		_, err = isoTime.Decode("yesterday")
+		require.Error(t, err)
+	})
 }
 
 // =============================================================================
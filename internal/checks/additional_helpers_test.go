@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,10 @@ func (s *checkAttachSchema) ParseAny(input any, _ ...*core.ParseContext) (any, e
 	return input, nil
 }
 
+func (s *checkAttachSchema) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return s.ParseAny(input, parseCtx...)
+}
+
 func (s *checkAttachSchema) IsOptional() bool {
 	return s.internals.IsOptional()
 }
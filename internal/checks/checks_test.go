@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -160,6 +161,10 @@ func (s propertyTestSchema) ParseAny(input any, ctx ...*core.ParseContext) (any,
 	return s.parse(input, parseCtx)
 }
 
+func (s propertyTestSchema) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return s.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 func (s propertyTestSchema) Internals() *core.ZodTypeInternals {
 	return &core.ZodTypeInternals{Type: core.ZodTypeAny}
 }
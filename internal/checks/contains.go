@@ -0,0 +1,62 @@
+package checks
+
+import (
+	"reflect"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// Contains creates a check requiring between minimum and maximum elements of
+// a slice or array to match schema. A negative maximum leaves the count
+// unbounded. Failures carry the matching indices in Params["matched"].
+func Contains(schema core.ZodSchema, minimum, maximum int, params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	defParams := map[string]any{"schema": schema, "minimum": minimum}
+	if maximum >= 0 {
+		defParams["maximum"] = maximum
+	}
+	def := newCheckDef("contains", defParams, cp)
+
+	return &core.ZodCheckInternals{
+		Def: def,
+		Check: func(payload *core.ParsePayload) {
+			matched := containsMatches(schema, payload.Value(), payload.Context())
+			var issue core.ZodRawIssue
+			switch {
+			case len(matched) < minimum:
+				issue = issues.CreateTooSmallIssue(minimum, true, "contains", payload.Value())
+			case maximum >= 0 && len(matched) > maximum:
+				issue = issues.CreateTooBigIssue(maximum, true, "contains", payload.Value())
+			default:
+				return
+			}
+			issue.Properties["params"] = map[string]any{"matched": matched}
+			payload.AddIssue(issue)
+		},
+	}
+}
+
+// containsMatches returns the indices of the elements of value that schema
+// accepts.
+func containsMatches(schema core.ZodSchema, value any, ctx *core.ParseContext) []int {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+
+	var parseCtx []*core.ParseContext
+	if ctx != nil {
+		parseCtx = append(parseCtx, ctx)
+	}
+	matched := []int{}
+	for i := range rv.Len() {
+		if _, err := schema.ParseAny(rv.Index(i).Interface(), parseCtx...); err == nil {
+			matched = append(matched, i)
+		}
+	}
+	return matched
+}
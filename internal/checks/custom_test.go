@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -504,6 +505,26 @@ func TestCustomUtilities(t *testing.T) {
 
 // Benchmark tests
 
+func TestCustomValidation_ContextRefine(t *testing.T) {
+	type ctxKey struct{}
+
+	check := NewCustom[any](func(ctx context.Context, v any) bool {
+		return ctx.Value(ctxKey{}) == v
+	})
+
+	payload := core.NewParsePayload("tenant")
+	payload.SetContext(&core.ParseContext{Context: context.WithValue(context.Background(), ctxKey{}, "tenant")})
+	check.Zod().Check(payload)
+	assert.Empty(t, payload.Issues())
+
+	payload = core.NewParsePayload("tenant")
+	check.Zod().Check(payload)
+	require.Len(t, payload.Issues(), 1, "nil parse context falls back to context.Background")
+
+	assert.False(t, HasParallelRefine([]core.ZodCheck{check}))
+	assert.True(t, HasParallelRefine([]core.ZodCheck{MinLength(1), WithParallel(check, true)}))
+}
+
 func BenchmarkCustomCheck_StringRefine(b *testing.B) {
 	refineFn := func(s string) bool {
 		return len(s) > 3 && s[0] >= 'A' && s[0] <= 'Z'
@@ -4,6 +4,7 @@
 package checks
 
 import (
+	"context"
 	"maps"
 	"slices"
 
@@ -18,10 +19,11 @@ import (
 // ZodCheckCustomDef defines a custom validation constraint.
 type ZodCheckCustomDef struct {
 	core.ZodCheckDef
-	Type   string         // check type identifier
-	Params map[string]any // additional parameters
-	Fn     any            // RefineFn or CheckFn
-	FnType string         // "refine" or "check"
+	Type     string         // check type identifier
+	Params   map[string]any // additional parameters
+	Fn       any            // RefineFn or CheckFn
+	FnType   string         // "refine" or "check"
+	Parallel bool           // safe to run concurrently with sibling values
 }
 
 // ZodCheckCustomInternals contains custom check internal state.
@@ -192,6 +194,25 @@ func NewCustom[T any](fn any, args ...any) *ZodCheckCustom {
 	return &ZodCheckCustom{Internals: internals}
 }
 
+// WithParallel marks a custom check as safe to run concurrently with checks on
+// sibling values, letting collection and field walks fan it out when
+// core.ParseContext.Workers allows.
+func WithParallel(check *ZodCheckCustom, parallel bool) *ZodCheckCustom {
+	check.Internals.Def.Parallel = parallel
+	return check
+}
+
+// HasParallelRefine reports whether chks contain a custom check marked by
+// WithParallel.
+func HasParallelRefine(chks []core.ZodCheck) bool {
+	for _, chk := range chks {
+		if custom, ok := chk.(*ZodCheckCustom); ok && custom.Internals.Def.Parallel {
+			return true
+		}
+	}
+	return false
+}
+
 // NewZodCheckOverwrite creates a check that overwrites input with a transformed value.
 func NewZodCheckOverwrite(transform func(any) any, args ...any) *ZodCheckOverwrite {
 	sp := utils.NormalizeParams(utils.FirstParam(args...))
@@ -394,6 +415,8 @@ func executeRefine(payload *core.ParsePayload, ci *ZodCheckCustomInternals) {
 		handleRefineResult(fn(m), payload, v, ci)
 	case func(any) bool:
 		handleRefineResult(fn(v), payload, v, ci)
+	case func(context.Context, any) bool:
+		handleRefineResult(fn(payload.Context().ContextOrBackground(), v), payload, v, ci)
 	case core.ZodRefineFn[string]:
 		s, ok := v.(string)
 		if !ok {
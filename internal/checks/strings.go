@@ -3,6 +3,7 @@ package checks
 import (
 	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/issues"
@@ -31,6 +32,64 @@ func Regex(pattern *regexp.Regexp, params ...any) core.ZodCheck {
 	}
 }
 
+// TemplateLiteral creates a check that matches the concatenation of segments,
+// each an unanchored regex source. On mismatch the issue names the first
+// segment that cannot match: its index in params["segment"] and its source in
+// the pattern field.
+func TemplateLiteral(segments []string, params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	pattern := regexp.MustCompile(templatePattern(segments) + `$`)
+	prefixes := make([]*regexp.Regexp, len(segments))
+	for i := range segments {
+		prefixes[i] = regexp.MustCompile(templatePattern(segments[:i+1]))
+	}
+	def := newCheckDef("template_literal", map[string]any{"pattern": pattern.String()}, cp)
+
+	return &core.ZodCheckInternals{
+		Def: def,
+		Check: func(payload *core.ParsePayload) {
+			str, ok := payload.Value().(string)
+			if !ok {
+				payload.AddIssue(issues.CreateInvalidTypeIssue(core.ZodTypeString, payload.Value()))
+				return
+			}
+			if pattern.MatchString(str) {
+				return
+			}
+			segment := len(segments) - 1
+			for i, prefix := range prefixes {
+				if !prefix.MatchString(str) {
+					segment = i
+					break
+				}
+			}
+			props := map[string]any{
+				"pattern": segments[segment],
+				"params":  map[string]any{"segment": segment},
+			}
+			payload.AddIssue(issues.CreateInvalidFormatIssue("template_literal", payload.Value(), props))
+		},
+		OnAttach: []func(any){
+			func(schema any) {
+				addPatternToSchema(schema, pattern.String())
+				SetBagProperty(schema, "type", "string")
+			},
+		},
+	}
+}
+
+// templatePattern anchors the concatenated segments at the start of input.
+func templatePattern(segments []string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, segment := range segments {
+		b.WriteString("(?:")
+		b.WriteString(segment)
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
 // Includes creates a substring inclusion check.
 func Includes(substring string, params ...any) core.ZodCheck {
 	cp := NormalizeCheckParams(params...)
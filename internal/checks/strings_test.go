@@ -101,6 +101,36 @@ func TestRegexCheck(t *testing.T) {
 	})
 }
 
+func TestTemplateLiteralCheck(t *testing.T) {
+	check := TemplateLiteral([]string{`user-`, `-?\d+`, `@`, `a|b`})
+
+	payload := core.NewParsePayload("user-42@b")
+	executeCheck(check, payload)
+	assert.Empty(t, payload.Issues())
+
+	tests := []struct {
+		input   string
+		segment int
+	}{
+		{"admin-42@a", 0},
+		{"user-x@a", 1},
+		{"user-42#a", 2},
+		{"user-42@c", 3},
+		{"user-42@ab", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			payload := core.NewParsePayload(tt.input)
+			executeCheck(check, payload)
+			require.Len(t, payload.Issues(), 1)
+			issue := payload.Issues()[0]
+			assert.Equal(t, core.InvalidFormat, issue.Code)
+			assert.Equal(t, "template_literal", issue.Properties["format"])
+			assert.Equal(t, map[string]any{"segment": tt.segment}, issue.Properties["params"])
+		})
+	}
+}
+
 func TestStringCustomMessages(t *testing.T) {
 	t.Run("Custom error messages work for string checks", func(t *testing.T) {
 		check := Includes("test", "Must include the word 'test'")
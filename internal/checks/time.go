@@ -0,0 +1,177 @@
+package checks
+
+import (
+	"time"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	"github.com/kaptinlin/gozod/internal/utils"
+)
+
+// timeOrigin is the issue origin reported by time range checks.
+const timeOrigin = "date"
+
+// Before creates a check that the time is strictly before limit.
+func Before(limit time.Time, params ...any) core.ZodCheck {
+	return timeUpperBound("less_than", limit, false, params...)
+}
+
+// NotAfter creates a check that the time is before or equal to limit.
+func NotAfter(limit time.Time, params ...any) core.ZodCheck {
+	return timeUpperBound("less_than_or_equal", limit, true, params...)
+}
+
+// After creates a check that the time is strictly after limit.
+func After(limit time.Time, params ...any) core.ZodCheck {
+	return timeLowerBound("greater_than", limit, false, params...)
+}
+
+// NotBefore creates a check that the time is after or equal to limit.
+func NotBefore(limit time.Time, params ...any) core.ZodCheck {
+	return timeLowerBound("greater_than_or_equal", limit, true, params...)
+}
+
+// Past creates a check that the time is strictly before the parse clock.
+// The clock is read from core.ParseContext.Now on every run, so the bound is
+// not materialized into the schema bag.
+func Past(params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	def := newCheckDef("past", nil, cp)
+
+	internals := &core.ZodCheckInternals{Def: def}
+	internals.Check = func(payload *core.ParsePayload) {
+		t, ok := timeValue(payload.Value())
+		if !ok {
+			return
+		}
+		now := payload.Context().CurrentTime()
+		if !t.Before(now) {
+			raw := issues.CreateTooBigIssue(now, false, timeOrigin, payload.Value())
+			raw.Inst = internals
+			payload.AddIssue(raw)
+		}
+	}
+	return internals
+}
+
+// Future creates a check that the time is strictly after the parse clock.
+// The clock is read from core.ParseContext.Now on every run, so the bound is
+// not materialized into the schema bag.
+func Future(params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	def := newCheckDef("future", nil, cp)
+
+	internals := &core.ZodCheckInternals{Def: def}
+	internals.Check = func(payload *core.ParsePayload) {
+		t, ok := timeValue(payload.Value())
+		if !ok {
+			return
+		}
+		now := payload.Context().CurrentTime()
+		if !t.After(now) {
+			raw := issues.CreateTooSmallIssue(now, false, timeOrigin, payload.Value())
+			raw.Inst = internals
+			payload.AddIssue(raw)
+		}
+	}
+	return internals
+}
+
+func timeUpperBound(name string, limit time.Time, inclusive bool, params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	def := newCheckDef(name, map[string]any{"maximum": limit, "inclusive": inclusive}, cp)
+
+	internals := &core.ZodCheckInternals{Def: def}
+	internals.Check = func(payload *core.ParsePayload) {
+		t, ok := timeValue(payload.Value())
+		if !ok {
+			return
+		}
+		if c := t.Compare(limit); c > 0 || (c == 0 && !inclusive) {
+			raw := issues.CreateTooBigIssue(limit, inclusive, timeOrigin, payload.Value())
+			raw.Inst = internals
+			payload.AddIssue(raw)
+		}
+	}
+	internals.OnAttach = []func(any){
+		func(schema any) { mergeFormatMaximumConstraint(schema, limit, inclusive) },
+	}
+	return internals
+}
+
+func timeLowerBound(name string, limit time.Time, inclusive bool, params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	def := newCheckDef(name, map[string]any{"minimum": limit, "inclusive": inclusive}, cp)
+
+	internals := &core.ZodCheckInternals{Def: def}
+	internals.Check = func(payload *core.ParsePayload) {
+		t, ok := timeValue(payload.Value())
+		if !ok {
+			return
+		}
+		if c := t.Compare(limit); c < 0 || (c == 0 && !inclusive) {
+			raw := issues.CreateTooSmallIssue(limit, inclusive, timeOrigin, payload.Value())
+			raw.Inst = internals
+			payload.AddIssue(raw)
+		}
+	}
+	internals.OnAttach = []func(any){
+		func(schema any) { mergeFormatMinimumConstraint(schema, limit, inclusive) },
+	}
+	return internals
+}
+
+// mergeFormatMinimumConstraint merges a time lower bound into the
+// formatMinimum family of bag keys, choosing the stricter value.
+func mergeFormatMinimumConstraint(schema any, value time.Time, inclusive bool) {
+	key := "formatMinimum"
+	conflict := "formatExclusiveMinimum"
+	if !inclusive {
+		key = "formatExclusiveMinimum"
+		conflict = "formatMinimum"
+	}
+
+	mergeConstraint(schema, key, value, func(old, new any) any {
+		if utils.CompareValues(new, old) > 0 {
+			return new
+		}
+		return old
+	})
+
+	removeConflictingBound(schema, conflict, value, func(cmp int) bool { return cmp >= 0 })
+}
+
+// mergeFormatMaximumConstraint merges a time upper bound into the
+// formatMaximum family of bag keys, choosing the stricter value.
+func mergeFormatMaximumConstraint(schema any, value time.Time, inclusive bool) {
+	key := "formatMaximum"
+	conflict := "formatExclusiveMaximum"
+	if !inclusive {
+		key = "formatExclusiveMaximum"
+		conflict = "formatMaximum"
+	}
+
+	mergeConstraint(schema, key, value, func(old, new any) any {
+		if utils.CompareValues(new, old) < 0 {
+			return new
+		}
+		return old
+	})
+
+	removeConflictingBound(schema, conflict, value, func(cmp int) bool { return cmp <= 0 })
+}
+
+// timeValue extracts a time.Time from a time or non-nil time pointer value.
+func timeValue(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, false
+		}
+		return *t, true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,84 @@
+package checks
+
+import (
+	"reflect"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// Unique creates a check requiring the elements of a slice or array to be
+// distinct. A non-nil key compares elements by key(element) instead of by
+// value. Each duplicate is reported as an invalid_element issue at its index,
+// carrying the index of the first occurrence in Params["firstIndex"].
+func Unique(key func(any) any, params ...any) core.ZodCheck {
+	cp := NormalizeCheckParams(params...)
+	var defParams map[string]any
+	if key != nil {
+		defParams = map[string]any{"key": key}
+	}
+	def := newCheckDef("unique", defParams, cp)
+
+	return &core.ZodCheckInternals{
+		Def: def,
+		Check: func(payload *core.ParsePayload) {
+			rv := reflect.ValueOf(payload.Value())
+			for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+				rv = rv.Elem()
+			}
+			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+				return
+			}
+
+			var seen uniqueIndex
+			for i := range rv.Len() {
+				element := rv.Index(i).Interface()
+				identity := element
+				if key != nil {
+					identity = key(element)
+				}
+				first, duplicate := seen.add(identity, i)
+				if !duplicate {
+					continue
+				}
+				issue := issues.CreateInvalidElementIssue(i, "unique", element, nil)
+				issue.Properties["params"] = map[string]any{"firstIndex": first}
+				payload.AddIssue(issue)
+			}
+		},
+	}
+}
+
+// uniqueIndex records the first index of each distinct value. Comparable
+// values are hashed; others fall back to a reflect.DeepEqual scan.
+type uniqueIndex struct {
+	hashed map[any]int
+	other  []uniqueEntry
+}
+
+type uniqueEntry struct {
+	value any
+	index int
+}
+
+// add records value at index unless an equal value was seen before, in which
+// case it returns the earlier index and true.
+func (u *uniqueIndex) add(value any, index int) (int, bool) {
+	if value == nil || reflect.ValueOf(value).Comparable() {
+		if first, ok := u.hashed[value]; ok {
+			return first, true
+		}
+		if u.hashed == nil {
+			u.hashed = make(map[any]int)
+		}
+		u.hashed[value] = index
+		return index, false
+	}
+	for _, entry := range u.other {
+		if reflect.DeepEqual(entry.value, value) {
+			return entry.index, true
+		}
+	}
+	u.other = append(u.other, uniqueEntry{value: value, index: index})
+	return index, false
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"reflect"
+	"slices"
 
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/issues"
@@ -83,6 +84,10 @@ func processModifier[T any](
 		return processOptionalModifier(internals, ctx)
 	case core.ZodModifierExactOptional:
 		return nil, true, issues.CreateNonOptionalError(ctx)
+	case core.ZodModifierCatch:
+		// Catch only substitutes failed parses; see CatchFallback.
+	case core.ZodModifierReadonly:
+		// Readonly only copies successful results; see FreezeResult.
 	}
 	return nil, false, nil
 }
@@ -101,6 +106,9 @@ func processNilFallback[T any](
 	if expectedType == core.ZodTypeAny || expectedType == core.ZodTypeUnknown {
 		return nil, true, nil
 	}
+	if validatesNil(expectedType) {
+		return nil, false, nil
+	}
 	return nil, true, issues.CreateInvalidTypeError(expectedType, nil, ctx)
 }
 
@@ -148,6 +156,40 @@ func processOptionalModifier(internals *core.ZodTypeInternals, ctx *core.ParseCo
 	return nil, true, nil
 }
 
+// CatchFallback returns the outermost Catch fallback for a failed parse of
+// input. It reports false when err is nil, the schema has no Catch modifier,
+// or the caller context is done, since cancellation is not a validation
+// failure a fallback should mask.
+func CatchFallback(
+	input any,
+	internals *core.ZodTypeInternals,
+	err error,
+	ctx *core.ParseContext,
+) (any, bool) {
+	if err == nil || internals == nil || ctx.ContextErr() != nil {
+		return nil, false
+	}
+	catchCtx := core.CatchContext{Input: input, Error: err}
+	var zodErr *issues.ZodError
+	if issues.IsZodError(err, &zodErr) {
+		catchCtx.Issues = slices.Clone(zodErr.Issues)
+	}
+	return internals.CatchFallback(catchCtx)
+}
+
+// FreezeResult deep-copies a successful parse result when the schema carries a
+// Readonly modifier, so the caller never shares backing storage with the
+// input, a default value, or another parse result.
+func FreezeResult[R any](r R, internals *core.ZodTypeInternals) R {
+	if internals == nil || !internals.IsReadonly() {
+		return r
+	}
+	if frozen, ok := cloneutil.Clone(r).(R); ok {
+		return frozen
+	}
+	return r
+}
+
 // ----------------------------------------------------------------------------
 // Transform and utility helpers
 // ----------------------------------------------------------------------------
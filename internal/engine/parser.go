@@ -19,7 +19,7 @@ var ErrUnableToConvert = errors.New("unable to convert value to expected type")
 // ----------------------------------------------------------------------------
 
 // ParsePrimitive provides unified, type-safe parsing for primitive types.
-// It handles optional/nilable/default/prefault/transform modifiers automatically.
+// It handles optional/nilable/default/prefault/catch/transform modifiers automatically.
 func ParsePrimitive[T any, R any](
 	input any,
 	internals *core.ZodTypeInternals,
@@ -29,6 +29,21 @@ func ParsePrimitive[T any, R any](
 	ctx ...*core.ParseContext,
 ) (R, error) {
 	pc := getOrCreateContext(ctx...)
+	r, err := parsePrimitive(input, internals, expectedType, validator, converter, pc)
+	if fallback, ok := CatchFallback(input, internals, err, pc); ok {
+		return converter(fallback, pc, expectedType)
+	}
+	return r, err
+}
+
+func parsePrimitive[T any, R any](
+	input any,
+	internals *core.ZodTypeInternals,
+	expectedType core.ZodTypeCode,
+	validator func(T, []core.ZodCheck, *core.ParseContext) (T, error),
+	converter func(any, *core.ParseContext, core.ZodTypeCode) (R, error),
+	pc *core.ParseContext,
+) (R, error) {
 
 	r, handled, err := processModifiers[T](
 		input, internals, expectedType,
@@ -81,6 +96,20 @@ func ParsePrimitiveStrict[T any, R any](
 	ctx ...*core.ParseContext,
 ) (R, error) {
 	pc := getOrCreateContext(ctx...)
+	r, err := parsePrimitiveStrict(input, internals, expectedType, validator, pc)
+	if fallback, ok := CatchFallback(input, internals, err, pc); ok {
+		return ConvertToConstraintType[T, R](fallback, pc, expectedType)
+	}
+	return r, err
+}
+
+func parsePrimitiveStrict[T any, R any](
+	input R,
+	internals *core.ZodTypeInternals,
+	expectedType core.ZodTypeCode,
+	validator func(T, []core.ZodCheck, *core.ParseContext) (T, error),
+	pc *core.ParseContext,
+) (R, error) {
 
 	// Fast path: no modifiers, return input directly.
 	if !isNilInput(input) && len(internals.Checks) == 0 &&
@@ -102,7 +131,7 @@ func ParsePrimitiveStrict[T any, R any](
 }
 
 // ParseComplex provides unified parsing for complex types (struct, slice, map, etc.).
-// It handles optional/nilable/default/prefault/transform modifiers automatically.
+// It handles optional/nilable/default/prefault/catch/readonly/transform modifiers automatically.
 func ParseComplex[T any](
 	input any,
 	internals *core.ZodTypeInternals,
@@ -113,6 +142,25 @@ func ParseComplex[T any](
 	ctx ...*core.ParseContext,
 ) (any, error) {
 	pc := getOrCreateContext(ctx...)
+	r, err := parseComplex(input, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
+	if fallback, ok := CatchFallback(input, internals, err, pc); ok {
+		return fallback, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return FreezeResult(r, internals), nil
+}
+
+func parseComplex[T any](
+	input any,
+	internals *core.ZodTypeInternals,
+	expectedType core.ZodTypeCode,
+	typeExtractor func(any) (T, bool),
+	ptrExtractor func(any) (*T, bool),
+	validator func(T, []core.ZodCheck, *core.ParseContext) (T, error),
+	pc *core.ParseContext,
+) (any, error) {
 
 	r, handled, err := processModifiers[T](
 		input, internals, expectedType,
@@ -152,8 +200,27 @@ func ParseComplexStrict[T any, R any](
 	validator func(T, []core.ZodCheck, *core.ParseContext) (T, error),
 	ctx ...*core.ParseContext,
 ) (R, error) {
-	var zero R
 	pc := getOrCreateContext(ctx...)
+	r, err := parseComplexStrict(input, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
+	if fallback, ok := CatchFallback(input, internals, err, pc); ok {
+		return convertComplexResultToConstraint[T, R](fallback, typeExtractor, ptrExtractor, expectedType, pc)
+	}
+	if err != nil {
+		return r, err
+	}
+	return FreezeResult(r, internals), nil
+}
+
+func parseComplexStrict[T any, R any](
+	input R,
+	internals *core.ZodTypeInternals,
+	expectedType core.ZodTypeCode,
+	typeExtractor func(any) (T, bool),
+	ptrExtractor func(any) (*T, bool),
+	validator func(T, []core.ZodCheck, *core.ParseContext) (T, error),
+	pc *core.ParseContext,
+) (R, error) {
+	var zero R
 
 	// Fast path: no modifiers, return input directly.
 	// Struct types always need field validation.
@@ -184,7 +251,7 @@ func ParseComplexStrict[T any, R any](
 	}
 
 	// Fallback to regular complex parsing.
-	r, err := ParseComplex[T](input, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
+	r, err := parseComplex(input, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
 	if err != nil {
 		return zero, err
 	}
@@ -902,6 +969,9 @@ func parseComplexValue[T any](
 	ctx *core.ParseContext,
 ) (any, error) {
 	if input == nil {
+		if v, ok := typeExtractor(nil); ok && validatesNil(expectedType) {
+			return validateValue(v, internals.Checks, validator, ctx, expectedType)
+		}
 		return handleNilComplex[T](internals, expectedType, ctx)
 	}
 
@@ -919,6 +989,13 @@ func parseComplexValue[T any](
 	return nil, issues.CreateInvalidTypeError(expectedType, input, ctx)
 }
 
+// validatesNil reports whether schemas of expectedType judge nil input in
+// their validator once no modifier handles it, as Not negates its inner
+// schema's verdict on nil and When passes nil to its condition and branch.
+func validatesNil(expectedType core.ZodTypeCode) bool {
+	return expectedType == core.ZodTypeNot || expectedType == core.ZodTypeWhen
+}
+
 // parseComplexStrictNil handles nil input for ParseComplexStrict.
 func parseComplexStrictNil[T any, R any](
 	input R,
@@ -945,7 +1022,7 @@ func parseComplexStrictNil[T any, R any](
 	}
 
 	if r != nil {
-		parsed, err := ParseComplex[T](r, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
+		parsed, err := parseComplex(r, internals, expectedType, typeExtractor, ptrExtractor, validator, pc)
 		if err != nil {
 			return zero, err
 		}
@@ -193,6 +193,31 @@ func CreateInvalidSchemaIssue(reason string, input any, additionalProps ...map[s
 	return CreateIssue(core.InvalidSchema, "", properties, input)
 }
 
+// CreateCanceledIssue creates an issue for a parse stopped by context cancellation.
+func CreateCanceledIssue(cause error, input any) core.ZodRawIssue {
+	properties := map[string]any{"reason": cause.Error()}
+	return CreateIssue(core.Canceled, "", properties, input)
+}
+
+// CreateInvalidNotIssue creates an issue for input matching a negated schema.
+func CreateInvalidNotIssue(description string, input any) core.ZodRawIssue {
+	properties := map[string]any{}
+	if description != "" {
+		properties["description"] = description
+	}
+	return CreateIssue(core.InvalidNot, "", properties, input)
+}
+
+// CreateInvalidFieldComparisonIssue creates an issue for field not standing
+// in relation to other. The relation and other field are carried in params.
+func CreateInvalidFieldComparisonIssue(field, relation, other string, input any) core.ZodRawIssue {
+	properties := map[string]any{
+		"field_name": field,
+		"params":     map[string]any{"relation": relation, "field": other},
+	}
+	return CreateIssue(core.InvalidFieldComparison, "", properties, input)
+}
+
 // CreateIncompatibleTypesIssue creates an incompatible types issue.
 func CreateIncompatibleTypesIssue(conflictType string, value1, value2 any, input any) core.ZodRawIssue {
 	properties := map[string]any{"conflict_type": conflictType, "value1": value1, "value2": value2}
@@ -304,6 +329,7 @@ func zodIssueProperties(issue core.ZodIssue) map[string]any {
 	for key, value := range map[string]string{
 		"pattern": issue.Pattern, "includes": issue.Includes, "prefix": issue.Prefix,
 		"suffix": issue.Suffix, "algorithm": issue.Algorithm, "origin": issue.Origin,
+		"description": issue.Description,
 	} {
 		if value != "" {
 			properties[key] = value
@@ -549,6 +575,20 @@ func CreateTypeConversionError(fromType, toType string, input any, ctx *core.Par
 	return NewZodError([]core.ZodIssue{final})
 }
 
+// CreateCanceledError creates a cancellation error with proper context.
+func CreateCanceledError(cause error, input any, ctx *core.ParseContext) error {
+	raw := CreateCanceledIssue(cause, input)
+	final := FinalizeIssue(raw, ctx, nil)
+	return NewZodError([]core.ZodIssue{final})
+}
+
+// CreateInvalidNotError creates a negation error with proper context.
+func CreateInvalidNotError(description string, input any, ctx *core.ParseContext) error {
+	raw := CreateInvalidNotIssue(description, input)
+	final := FinalizeIssue(raw, ctx, nil)
+	return NewZodError([]core.ZodIssue{final})
+}
+
 // CreateIncompatibleTypesError creates an incompatible types error with proper context.
 func CreateIncompatibleTypesError(conflictType string, value1, value2 any, input any, ctx *core.ParseContext) error {
 	raw := CreateIncompatibleTypesIssue(conflictType, value1, value2, input)
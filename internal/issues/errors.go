@@ -124,7 +124,8 @@ func FormatErrorWithMapper(zodErr *ZodError, mapper func(ZodIssue) string) ZodFo
 				core.TooBig, core.TooSmall, core.NotMultipleOf,
 				core.UnrecognizedKeys, core.Custom, core.InvalidSchema,
 				core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired,
-				core.TypeConversion, core.NilPointer:
+				core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot,
+				core.InvalidFieldComparison:
 				if slicex.IsEmpty(issue.Path) {
 					if errors, ok := fieldErrors["_errors"].([]string); ok {
 						fieldErrors["_errors"] = append(errors, mapper(issue))
@@ -397,6 +398,9 @@ func convertZodIssueToProperties(issue ZodIssue) map[string]any {
 	if issue.Algorithm != "" {
 		properties["algorithm"] = issue.Algorithm
 	}
+	if issue.Description != "" {
+		properties["description"] = issue.Description
+	}
 	if issue.Divisor != nil {
 		properties["divisor"] = issue.Divisor
 	}
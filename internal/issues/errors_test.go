@@ -343,7 +343,7 @@ func TestErrorIntegration(t *testing.T) {
 			case core.InvalidValue, core.InvalidFormat, core.InvalidUnion, core.InvalidKey,
 				core.InvalidElement, core.TooBig, core.NotMultipleOf, core.UnrecognizedKeys, core.Custom,
 				core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired,
-				core.TypeConversion, core.NilPointer:
+				core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot, core.InvalidFieldComparison:
 				return fmt.Sprintf("ERROR: %s at %s",
 					issue.Message, ToDotPath(issue.Path))
 			default:
@@ -174,6 +174,7 @@ func MapPropertiesToIssue(issue *core.ZodIssue, properties map[string]any) {
 	issue.Suffix = mapx.StringOr(properties, "suffix", "")
 	issue.Includes = mapx.StringOr(properties, "includes", "")
 	issue.Algorithm = mapx.StringOr(properties, "algorithm", "")
+	issue.Description = mapx.StringOr(properties, "description", "")
 
 	issue.Minimum = mapx.AnyOr(properties, "minimum", nil)
 	issue.Maximum = mapx.AnyOr(properties, "maximum", nil)
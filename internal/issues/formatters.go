@@ -5,6 +5,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/pkg/coerce"
@@ -203,6 +204,8 @@ func FormatThreshold(threshold any) string {
 			return strconv.Itoa(int(f))
 		}
 		return fmt.Sprintf("%.1f", f)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
 	default:
 		return fmt.Sprintf("%v", v)
 	}
@@ -286,6 +289,23 @@ var FormatNouns = map[string]string{
 	"complex128": "128-bit complex number",
 }
 
+// FieldRelations maps cross-field relations to English comparison text.
+var FieldRelations = map[string]string{
+	"eq":  "equal to",
+	"ne":  "different from",
+	"gt":  "greater than",
+	"gte": "greater than or equal to",
+	"lt":  "less than",
+	"lte": "less than or equal to",
+}
+
+// FieldComparisonParams returns the relation and the other field of an
+// invalid_field_comparison issue.
+func FieldComparisonParams(raw core.ZodRawIssue) (relation, other string) {
+	params, _ := raw.Properties["params"].(map[string]any)
+	return mapx.StringOr(params, "relation", ""), mapx.StringOr(params, "field", "")
+}
+
 // FormatNoun returns the human-readable noun for a format name.
 func FormatNoun(format string) string {
 	if noun, exists := FormatNouns[format]; exists {
@@ -385,6 +405,10 @@ func (f *DefaultMessageFormatter) FormatMessage(raw core.ZodRawIssue) string {
 			return fmt.Sprintf("%s (element at index %v)", elementMessage, index)
 		}
 
+		if origin == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Duplicate element at index %v (first seen at index %v)", index, params["firstIndex"])
+		}
 		if elementIssues, ok := raw.Properties["issues"].([]core.ZodRawIssue); ok && len(elementIssues) > 0 {
 			return formatElement(elementIssues[0])
 		}
@@ -436,6 +460,20 @@ func (f *DefaultMessageFormatter) FormatMessage(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Nil pointer encountered"
 
+	case core.Canceled:
+		return "Validation canceled"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Input matches a forbidden schema: " + description
+		}
+		return "Input matches a forbidden schema"
+
+	case core.InvalidFieldComparison:
+		relation, other := FieldComparisonParams(raw)
+		return fmt.Sprintf("Invalid input: expected %s to be %s %s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelations[relation], other)
+
 	case core.Custom:
 		// Prefer explicit message field if provided
 		if raw.Message != "" {
@@ -510,6 +548,11 @@ func (f *DefaultMessageFormatter) formatSizeConstraint(raw core.ZodRawIssue, isT
 		prefix = "Too small"
 	}
 
+	// Contains bounds the count of matching elements, not the array length
+	if origin == "contains" {
+		return fmt.Sprintf("%s: expected array to contain %s%s matching items", prefix, adj, thresholdStr)
+	}
+
 	// For sized types (strings, arrays, etc.), use "have" with sizing info
 	if sizing != nil {
 		return fmt.Sprintf("%s: expected %s to have %s%s %s", prefix, origin, adj, thresholdStr, sizing.Unit)
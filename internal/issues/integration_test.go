@@ -232,7 +232,7 @@ func TestErrorProcessingIntegration(t *testing.T) {
 			case core.InvalidValue, core.InvalidFormat, core.InvalidUnion, core.InvalidKey,
 				core.InvalidElement, core.TooBig, core.NotMultipleOf, core.UnrecognizedKeys, core.Custom,
 				core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired,
-				core.TypeConversion, core.NilPointer:
+				core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot, core.InvalidFieldComparison:
 				return fmt.Sprintf("ERROR: %s at %s",
 					issue.Message, ToDotPath(issue.Path))
 			default:
@@ -349,7 +349,7 @@ func TestPerformanceIntegration(t *testing.T) {
 			case core.InvalidValue, core.InvalidUnion, core.InvalidKey,
 				core.InvalidElement, core.TooBig, core.NotMultipleOf, core.UnrecognizedKeys, core.Custom,
 				core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired,
-				core.TypeConversion, core.NilPointer:
+				core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot, core.InvalidFieldComparison:
 				return issue.Message
 			default:
 				return issue.Message
@@ -647,7 +647,7 @@ func TestRealWorldScenarios(t *testing.T) {
 			case core.InvalidValue, core.InvalidUnion, core.InvalidKey,
 				core.InvalidElement, core.TooBig, core.NotMultipleOf, core.UnrecognizedKeys, core.Custom,
 				core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired,
-				core.TypeConversion, core.NilPointer:
+				core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot, core.InvalidFieldComparison:
 				return issue.Message
 			default:
 				return issue.Message
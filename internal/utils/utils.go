@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/pkg/reflectx"
@@ -246,6 +247,10 @@ func CompareValues(a, b any) int {
 		if vb, ok := db.(string); ok {
 			return cmp.Compare(va, vb)
 		}
+	case time.Time:
+		if vb, ok := db.(time.Time); ok {
+			return va.Compare(vb)
+		}
 	}
 
 	return 0
@@ -1,6 +1,8 @@
 package gozod
 
 import (
+	"io/fs"
+
 	lib "github.com/kaptinlin/jsonschema"
 
 	"github.com/kaptinlin/gozod/jsonschema"
@@ -15,6 +17,10 @@ type JSONSchemaUnrepresentableMode = jsonschema.UnrepresentableMode
 type JSONSchemaCyclesMode = jsonschema.CyclesMode
 type JSONSchemaReusedMode = jsonschema.ReusedMode
 type JSONSchemaIOMode = jsonschema.IOMode
+type JSONSchemaTarget = jsonschema.Target
+type JSONSchemaResolver = jsonschema.Resolver
+type JSONSchemaResolverFunc = jsonschema.ResolverFunc
+type JSONSchemaMapResolver = jsonschema.MapResolver
 
 // ToJSONSchema converts a GoZod schema into JSON Schema.
 func ToJSONSchema(schema ZodSchema, opts ...JSONSchemaOptions) (*lib.Schema, error) {
@@ -29,6 +35,11 @@ func ToJSONSchemaRegistry(
 	return jsonschema.ToJSONSchemaRegistry(registry, opts...)
 }
 
+// NewJSONSchemaFSResolver returns a JSON Schema resolver that reads documents from fsys.
+func NewJSONSchemaFSResolver(fsys fs.FS, baseURI string) JSONSchemaResolver {
+	return jsonschema.NewFSResolver(fsys, baseURI)
+}
+
 // FromJSONSchema converts JSON Schema into a GoZod schema.
 func FromJSONSchema(schema *lib.Schema, opts ...FromJSONSchemaOptions) (ZodSchema, error) {
 	return jsonschema.FromJSONSchema(schema, opts...)
@@ -51,6 +62,10 @@ const (
 	JSONSchemaReusedRef            = jsonschema.ReusedRef
 	JSONSchemaIOOutput             = jsonschema.IOOutput
 	JSONSchemaIOInput              = jsonschema.IOInput
+	JSONSchemaTargetDraft202012    = jsonschema.TargetDraft202012
+	JSONSchemaTargetDraft07        = jsonschema.TargetDraft07
+	JSONSchemaTargetOpenAPI30      = jsonschema.TargetOpenAPI30
+	JSONSchemaTargetOpenAPI31      = jsonschema.TargetOpenAPI31
 )
 
 var (
@@ -63,6 +78,8 @@ var (
 	ErrUnionInvalid                  = jsonschema.ErrUnionInvalid
 	ErrUnionNoMembers                = jsonschema.ErrUnionNoMembers
 	ErrIntersectionInvalid           = jsonschema.ErrIntersectionInvalid
+	ErrWhenInvalid                   = jsonschema.ErrWhenInvalid
+	ErrNotInvalid                    = jsonschema.ErrNotInvalid
 	ErrInvalidEnumSchema             = jsonschema.ErrInvalidEnumSchema
 	ErrEnumExtractValues             = jsonschema.ErrEnumExtractValues
 	ErrLiteralNoValuesMethod         = jsonschema.ErrLiteralNoValuesMethod
@@ -80,12 +97,23 @@ var (
 	ErrInvalidJSONSchema             = jsonschema.ErrInvalidJSONSchema
 	ErrJSONSchemaCircularRef         = jsonschema.ErrJSONSchemaCircularRef
 	ErrJSONSchemaPatternCompile      = jsonschema.ErrJSONSchemaPatternCompile
-	ErrJSONSchemaIfThenElse          = jsonschema.ErrJSONSchemaIfThenElse
-	ErrJSONSchemaPatternProperties   = jsonschema.ErrJSONSchemaPatternProperties
 	ErrJSONSchemaDynamicRef          = jsonschema.ErrJSONSchemaDynamicRef
-	ErrJSONSchemaUnevaluatedProps    = jsonschema.ErrJSONSchemaUnevaluatedProps
-	ErrJSONSchemaUnevaluatedItems    = jsonschema.ErrJSONSchemaUnevaluatedItems
-	ErrJSONSchemaDependentSchemas    = jsonschema.ErrJSONSchemaDependentSchemas
-	ErrJSONSchemaPropertyNames       = jsonschema.ErrJSONSchemaPropertyNames
-	ErrJSONSchemaContains            = jsonschema.ErrJSONSchemaContains
+	ErrJSONSchemaRefNotFound         = jsonschema.ErrJSONSchemaRefNotFound
+)
+
+var (
+	// Deprecated: FromJSONSchema imports if/then/else as a When schema.
+	ErrJSONSchemaIfThenElse = jsonschema.ErrJSONSchemaIfThenElse
+	// Deprecated: FromJSONSchema imports contains, minContains and maxContains as Contains checks.
+	ErrJSONSchemaContains = jsonschema.ErrJSONSchemaContains
+	// Deprecated: FromJSONSchema imports patternProperties as PatternProperties.
+	ErrJSONSchemaPatternProperties = jsonschema.ErrJSONSchemaPatternProperties
+	// Deprecated: FromJSONSchema imports propertyNames as PropertyNames.
+	ErrJSONSchemaPropertyNames = jsonschema.ErrJSONSchemaPropertyNames
+	// Deprecated: FromJSONSchema imports dependentSchemas as DependentSchemas.
+	ErrJSONSchemaDependentSchemas = jsonschema.ErrJSONSchemaDependentSchemas
+	// Deprecated: FromJSONSchema imports unevaluatedProperties as UnevaluatedProperties.
+	ErrJSONSchemaUnevaluatedProps = jsonschema.ErrJSONSchemaUnevaluatedProps
+	// Deprecated: FromJSONSchema imports unevaluatedItems as UnevaluatedItems.
+	ErrJSONSchemaUnevaluatedItems = jsonschema.ErrJSONSchemaUnevaluatedItems
 )
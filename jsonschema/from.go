@@ -28,14 +28,27 @@ var (
 	ErrInvalidJSONSchema            = errors.New("invalid JSON Schema")
 	ErrJSONSchemaCircularRef        = errors.New("circular reference detected in JSON Schema")
 	ErrJSONSchemaPatternCompile     = errors.New("failed to compile JSON Schema pattern")
-	ErrJSONSchemaIfThenElse         = errors.New("if/then/else is not supported")
-	ErrJSONSchemaPatternProperties  = errors.New("patternProperties is not supported")
 	ErrJSONSchemaDynamicRef         = errors.New("$dynamicRef is not supported")
-	ErrJSONSchemaUnevaluatedProps   = errors.New("unevaluatedProperties is not supported")
-	ErrJSONSchemaUnevaluatedItems   = errors.New("unevaluatedItems is not supported")
-	ErrJSONSchemaDependentSchemas   = errors.New("dependentSchemas is not supported")
-	ErrJSONSchemaPropertyNames      = errors.New("propertyNames is not supported")
-	ErrJSONSchemaContains           = errors.New("contains/minContains/maxContains is not supported")
+	ErrJSONSchemaRefNotFound        = errors.New("JSON Schema $ref target not found")
+)
+
+// Errors FromJSONSchema returned for keywords it now imports. They are kept so
+// existing errors.Is checks still compile.
+var (
+	// Deprecated: FromJSONSchema imports if/then/else as a When schema.
+	ErrJSONSchemaIfThenElse = errors.New("if/then/else is not supported")
+	// Deprecated: FromJSONSchema imports contains, minContains and maxContains as Contains checks.
+	ErrJSONSchemaContains = errors.New("contains/minContains/maxContains is not supported")
+	// Deprecated: FromJSONSchema imports patternProperties as PatternProperties.
+	ErrJSONSchemaPatternProperties = errors.New("patternProperties is not supported")
+	// Deprecated: FromJSONSchema imports propertyNames as PropertyNames.
+	ErrJSONSchemaPropertyNames = errors.New("propertyNames is not supported")
+	// Deprecated: FromJSONSchema imports dependentSchemas as DependentSchemas.
+	ErrJSONSchemaDependentSchemas = errors.New("dependentSchemas is not supported")
+	// Deprecated: FromJSONSchema imports unevaluatedProperties as UnevaluatedProperties.
+	ErrJSONSchemaUnevaluatedProps = errors.New("unevaluatedProperties is not supported")
+	// Deprecated: FromJSONSchema imports unevaluatedItems as UnevaluatedItems.
+	ErrJSONSchemaUnevaluatedItems = errors.New("unevaluatedItems is not supported")
 )
 
 // ImportError identifies the JSON Schema keyword and RFC 6901 location that failed to import.
@@ -79,6 +92,10 @@ type FromJSONSchemaOptions struct {
 	// Metadata receives imported JSON Schema metadata. Nil stores metadata on
 	// the returned schema.
 	Metadata *core.Registry[core.GlobalMeta]
+
+	// Resolver loads the documents named by $ref values that kaptinlin/jsonschema
+	// left unresolved. Nil rejects unresolved references.
+	Resolver Resolver
 }
 
 // FromJSONSchema converts a kaptinlin/jsonschema Schema to a GoZod schema.
@@ -93,6 +110,7 @@ func FromJSONSchema(schema *lib.Schema, opts ...FromJSONSchemaOptions) (core.Zod
 		seen:    make(map[*lib.Schema]*fromSchemaCell),
 		options: options,
 	}
+	ctx.startRefs(schema)
 
 	return ctx.convert(schema)
 }
@@ -113,6 +131,7 @@ func FromJSONSchemaLossy(
 		lossy:   true,
 		losses:  &losses,
 	}
+	ctx.startRefs(schema)
 	imported, err := ctx.convert(schema)
 	return imported, normalizeImportLosses(losses), err
 }
@@ -141,6 +160,17 @@ type fromJSONSchemaContext struct {
 	path    []string
 	lossy   bool
 	losses  *[]ImportLossError
+	refs    *refResolver
+	scope   refScope
+}
+
+// startRefs enables $ref resolution through the configured Resolver.
+func (ctx *fromJSONSchemaContext) startRefs(root *lib.Schema) {
+	if ctx.options.Resolver == nil {
+		return
+	}
+	ctx.refs = newRefResolver(ctx.options.Resolver, root)
+	ctx.scope = ctx.refs.scopes[root]
 }
 
 type fromSchemaCell struct {
@@ -210,12 +240,31 @@ func (ctx *fromJSONSchemaContext) convertUnseen(s *lib.Schema) (core.ZodSchema,
 		return types.Never(), nil // false schema rejects everything
 	}
 
-	// Handle $ref (already pre-resolved by kaptinlin/jsonschema).
-	if s.Ref != "" {
-		if s.ResolvedRef == nil {
+	if ctx.refs != nil {
+		if scope, ok := ctx.refs.scopes[s]; ok {
+			derived := *ctx
+			derived.scope = scope
+			ctx = &derived
+		}
+	}
+
+	// Handle $ref, pre-resolved by kaptinlin/jsonschema or resolved here
+	// through the configured Resolver.
+	if s.Ref != "" && s.ResolvedRef == nil {
+		if ctx.refs == nil {
 			return nil, ctx.importError("$ref", fmt.Errorf("%w: unresolved $ref %q", ErrInvalidJSONSchema, s.Ref))
 		}
-		return ctx.convertResolvedRef(s)
+		target, scope, err := ctx.refs.resolve(s.Ref, ctx.scope)
+		if err != nil {
+			return nil, ctx.importError("$ref", err)
+		}
+		ctx.refs.scopes[target] = scope
+		resolved := *s
+		resolved.ResolvedRef = target
+		if target == s {
+			resolved.ResolvedRef = &resolved
+		}
+		return ctx.convertResolvedRef(&resolved)
 	}
 	if s.ResolvedRef != nil {
 		return ctx.convertResolvedRef(s)
@@ -236,7 +285,7 @@ func (ctx *fromJSONSchemaContext) convertUnseen(s *lib.Schema) (core.ZodSchema,
 		ctx.recordLosses(unsupported)
 	}
 
-	result, convErr := ctx.convertAssertions(s)
+	result, convErr := ctx.convertEvaluated(s)
 
 	if convErr != nil {
 		return nil, convErr
@@ -259,6 +308,8 @@ func (ctx *fromJSONSchemaContext) convertResolvedRef(s *lib.Schema) (core.ZodSch
 	siblings := *s
 	siblings.Ref = ""
 	siblings.ResolvedRef = nil
+	siblings.UnevaluatedProperties = nil
+	siblings.UnevaluatedItems = nil
 	if len(siblings.Type) == 0 && len(s.ResolvedRef.Type) > 0 {
 		siblings.Type = slices.Clone(s.ResolvedRef.Type)
 	}
@@ -266,7 +317,90 @@ func (ctx *fromJSONSchemaContext) convertResolvedRef(s *lib.Schema) (core.ZodSch
 	if err != nil {
 		return nil, err
 	}
-	return types.Intersection(target, local), nil
+	return ctx.closeUnevaluated(s, types.Intersection(target, local))
+}
+
+// convertEvaluated converts the assertions of s and closes them over the keys
+// and items they leave to unevaluatedProperties and unevaluatedItems.
+func (ctx *fromJSONSchemaContext) convertEvaluated(s *lib.Schema) (core.ZodSchema, error) {
+	if s.UnevaluatedProperties == nil && s.UnevaluatedItems == nil {
+		return ctx.convertAssertions(s)
+	}
+	assertions := *s
+	assertions.UnevaluatedProperties = nil
+	assertions.UnevaluatedItems = nil
+	result, err := ctx.convertAssertions(&assertions)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.closeUnevaluated(s, result)
+}
+
+// closeUnevaluated applies the unevaluatedProperties and unevaluatedItems of
+// s to schema. A schema that is not an open intersection, union, or xor is
+// first intersected with Unknown, which evaluates nothing.
+func (ctx *fromJSONSchemaContext) closeUnevaluated(s *lib.Schema, schema core.ZodSchema) (core.ZodSchema, error) {
+	if s.UnevaluatedProperties == nil && s.UnevaluatedItems == nil {
+		return schema, nil
+	}
+	unevaluatedItems := s.UnevaluatedItems
+	if unevaluatedItems != nil && hasPartialItemEvaluation(s, make(map[*lib.Schema]bool)) {
+		feature := unsupportedFeature{keyword: "unevaluatedItems", err: ErrUnsupportedJSONSchemaKeyword}
+		if !ctx.lossy {
+			return nil, ctx.importError(feature.keyword, feature.err)
+		}
+		ctx.recordLosses([]unsupportedFeature{feature})
+		unevaluatedItems = nil
+	}
+	var properties, items core.ZodSchema
+	var err error
+	if s.UnevaluatedProperties != nil {
+		if properties, err = ctx.at("unevaluatedProperties").convert(s.UnevaluatedProperties); err != nil {
+			return nil, err
+		}
+	}
+	if unevaluatedItems != nil {
+		if items, err = ctx.at("unevaluatedItems").convert(unevaluatedItems); err != nil {
+			return nil, err
+		}
+	}
+
+	switch composite := schema.(type) {
+	case *types.ZodUnion[any, any]:
+		if composite.UnevaluatedPropertiesSchema() == nil && composite.UnevaluatedItemsSchema() == nil {
+			return composite.UnevaluatedProperties(properties).UnevaluatedItems(items), nil
+		}
+	case *types.ZodXor[any, any]:
+		if composite.UnevaluatedPropertiesSchema() == nil && composite.UnevaluatedItemsSchema() == nil {
+			return composite.UnevaluatedProperties(properties).UnevaluatedItems(items), nil
+		}
+	case *types.ZodIntersection[any, any]:
+		if composite.UnevaluatedPropertiesSchema() == nil && composite.UnevaluatedItemsSchema() == nil {
+			return composite.UnevaluatedProperties(properties).UnevaluatedItems(items), nil
+		}
+	}
+	return types.Intersection(schema, types.Unknown()).UnevaluatedProperties(properties).UnevaluatedItems(items), nil
+}
+
+// hasPartialItemEvaluation reports whether s or one of its in-place
+// subschemas applies to arrays without items. In JSON Schema such a schema
+// evaluates only some items, while the imported slice or tuple evaluates all
+// of them, so unevaluatedItems cannot be imported over it.
+func hasPartialItemEvaluation(s *lib.Schema, visited map[*lib.Schema]bool) bool {
+	if s == nil || s.Boolean != nil || visited[s] {
+		return false
+	}
+	visited[s] = true
+	if s.Items == nil && (slices.Contains(s.Type, "array") || len(s.PrefixItems) > 0 || s.Contains != nil) {
+		return true
+	}
+	inPlace := slices.Concat(s.AllOf, s.AnyOf, s.OneOf, []*lib.Schema{s.If, s.Then, s.Else, s.ResolvedRef})
+	for _, key := range slices.Sorted(maps.Keys(s.DependentSchemas)) {
+		inPlace = append(inPlace, s.DependentSchemas[key])
+	}
+	return slices.ContainsFunc(inPlace, func(sub *lib.Schema) bool {
+		return hasPartialItemEvaluation(sub, visited)
+	})
 }
 
 func (ctx *fromJSONSchemaContext) convertAssertions(s *lib.Schema) (core.ZodSchema, error) {
@@ -299,6 +433,20 @@ func (ctx *fromJSONSchemaContext) convertAssertions(s *lib.Schema) (core.ZodSche
 		}
 		assertions = append(assertions, oneOf)
 	}
+	if s.If != nil {
+		conditional, err := ctx.convertIfThenElse(s)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, conditional)
+	}
+	if s.Not != nil {
+		negated, err := ctx.convertNot(s)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, negated)
+	}
 
 	if len(assertions) == 0 {
 		return ctx.convertBasicAssertions(s)
@@ -388,21 +536,6 @@ type unsupportedImportKeyword struct {
 }
 
 var unsupportedImportKeywords = []unsupportedImportKeyword{
-	{
-		keyword: "if/then/else",
-		err:     ErrJSONSchemaIfThenElse,
-		present: func(s *lib.Schema) bool {
-			return s.If != nil || s.Then != nil || s.Else != nil
-		},
-	},
-	{
-		keyword: "patternProperties",
-		err:     ErrJSONSchemaPatternProperties,
-		present: func(s *lib.Schema) bool {
-			return s.PatternProperties != nil && len(*s.PatternProperties) > 0 &&
-				!isImportablePatternRecord(s)
-		},
-	},
 	{
 		keyword: "$dynamicRef",
 		err:     ErrJSONSchemaDynamicRef,
@@ -410,48 +543,6 @@ var unsupportedImportKeywords = []unsupportedImportKeyword{
 			return s.DynamicRef != ""
 		},
 	},
-	{
-		keyword: "unevaluatedProperties",
-		err:     ErrJSONSchemaUnevaluatedProps,
-		present: func(s *lib.Schema) bool {
-			return s.UnevaluatedProperties != nil
-		},
-	},
-	{
-		keyword: "unevaluatedItems",
-		err:     ErrJSONSchemaUnevaluatedItems,
-		present: func(s *lib.Schema) bool {
-			return s.UnevaluatedItems != nil
-		},
-	},
-	{
-		keyword: "not",
-		err:     ErrUnsupportedJSONSchemaKeyword,
-		present: func(s *lib.Schema) bool {
-			return s.Not != nil
-		},
-	},
-	{
-		keyword: "uniqueItems",
-		err:     ErrUnsupportedJSONSchemaKeyword,
-		present: func(s *lib.Schema) bool {
-			return s.UniqueItems != nil && *s.UniqueItems
-		},
-	},
-	{
-		keyword: "dependentSchemas",
-		err:     ErrJSONSchemaDependentSchemas,
-		present: func(s *lib.Schema) bool {
-			return len(s.DependentSchemas) > 0
-		},
-	},
-	{
-		keyword: "dependentRequired",
-		err:     ErrUnsupportedJSONSchemaKeyword,
-		present: func(s *lib.Schema) bool {
-			return len(s.DependentRequired) > 0
-		},
-	},
 	{
 		keyword: "contentEncoding",
 		err:     ErrUnsupportedJSONSchemaKeyword,
@@ -476,20 +567,6 @@ var unsupportedImportKeywords = []unsupportedImportKeyword{
 			return s.ContentSchema != nil && contentMayApply(s)
 		},
 	},
-	{
-		keyword: "propertyNames",
-		err:     ErrJSONSchemaPropertyNames,
-		present: func(s *lib.Schema) bool {
-			return s.PropertyNames != nil && !isImportablePropertyNamesRecord(s)
-		},
-	},
-	{
-		keyword: "contains",
-		err:     ErrJSONSchemaContains,
-		present: func(s *lib.Schema) bool {
-			return s.Contains != nil || s.MinContains != nil || s.MaxContains != nil
-		},
-	},
 }
 
 func contentMayApply(s *lib.Schema) bool {
@@ -500,6 +577,7 @@ func isImportablePropertyNamesRecord(s *lib.Schema) bool {
 	return len(s.Type) == 1 && s.Type[0] == "object" &&
 		(s.Properties == nil || len(*s.Properties) == 0) &&
 		(s.PatternProperties == nil || len(*s.PatternProperties) == 0) &&
+		!hasObjectDependencies(s) &&
 		isImportableRecordKeySchema(s.PropertyNames) &&
 		s.AdditionalProperties != nil && len(s.Required) == 0
 }
@@ -515,11 +593,15 @@ func isImportableRecordKeySchema(s *lib.Schema) bool {
 func isImportablePatternRecord(s *lib.Schema) bool {
 	return len(s.Type) == 1 && s.Type[0] == "object" &&
 		(s.Properties == nil || len(*s.Properties) == 0) &&
-		s.PropertyNames == nil &&
+		s.PropertyNames == nil && !hasObjectDependencies(s) &&
 		s.PatternProperties != nil && len(*s.PatternProperties) == 1 &&
 		s.AdditionalProperties == nil && len(s.Required) == 0
 }
 
+func hasObjectDependencies(s *lib.Schema) bool {
+	return len(s.DependentRequired) > 0 || len(s.DependentSchemas) > 0
+}
+
 func unanchoredValidationFeatures(s *lib.Schema) []unsupportedFeature {
 	if len(s.Type) > 0 {
 		return nil
@@ -561,12 +643,24 @@ func unanchoredValidationFeatures(s *lib.Schema) []unsupportedFeature {
 	if s.Items != nil {
 		features = append(features, unsupportedFeature{keyword: "items", err: ErrUnsupportedJSONSchemaKeyword})
 	}
+	if s.Contains != nil {
+		features = append(features, unsupportedFeature{keyword: "contains", err: ErrUnsupportedJSONSchemaKeyword})
+	}
+	if s.UniqueItems != nil && *s.UniqueItems {
+		features = append(features, unsupportedFeature{keyword: "uniqueItems", err: ErrUnsupportedJSONSchemaKeyword})
+	}
 	if s.Properties != nil && len(*s.Properties) > 0 {
 		features = append(features, unsupportedFeature{keyword: "properties", err: ErrUnsupportedJSONSchemaKeyword})
 	}
+	if s.PatternProperties != nil && len(*s.PatternProperties) > 0 {
+		features = append(features, unsupportedFeature{keyword: "patternProperties", err: ErrUnsupportedJSONSchemaKeyword})
+	}
 	if s.AdditionalProperties != nil {
 		features = append(features, unsupportedFeature{keyword: "additionalProperties", err: ErrUnsupportedJSONSchemaKeyword})
 	}
+	if s.PropertyNames != nil {
+		features = append(features, unsupportedFeature{keyword: "propertyNames", err: ErrUnsupportedJSONSchemaKeyword})
+	}
 	if len(s.Required) > 0 {
 		features = append(features, unsupportedFeature{keyword: "required", err: ErrUnsupportedJSONSchemaKeyword})
 	}
@@ -576,6 +670,12 @@ func unanchoredValidationFeatures(s *lib.Schema) []unsupportedFeature {
 	if s.MaxProperties != nil {
 		features = append(features, unsupportedFeature{keyword: "maxProperties", err: ErrUnsupportedJSONSchemaKeyword})
 	}
+	if len(s.DependentRequired) > 0 {
+		features = append(features, unsupportedFeature{keyword: "dependentRequired", err: ErrUnsupportedJSONSchemaKeyword})
+	}
+	if len(s.DependentSchemas) > 0 {
+		features = append(features, unsupportedFeature{keyword: "dependentSchemas", err: ErrUnsupportedJSONSchemaKeyword})
+	}
 	return features
 }
 
@@ -894,7 +994,15 @@ func ratIntegerMultipleDivisor(r *lib.Rat) (int64, bool) {
 func (ctx *fromJSONSchemaContext) convertArray(s *lib.Schema) (core.ZodSchema, error) {
 	// Handle prefixItems (tuple-like arrays, JSON Schema Draft 2020-12)
 	if len(s.PrefixItems) > 0 {
-		return ctx.convertTuple(s)
+		tuple, err := ctx.convertTuple(s)
+		if err != nil || s.Contains == nil {
+			return tuple, err
+		}
+		contains, err := ctx.convertContains(s, types.Slice[any](types.Unknown()))
+		if err != nil {
+			return nil, err
+		}
+		return types.Intersection(tuple, contains), nil
 	}
 
 	var itemSchema core.ZodSchema = types.Unknown()
@@ -917,11 +1025,33 @@ func (ctx *fromJSONSchemaContext) convertArray(s *lib.Schema) (core.ZodSchema, e
 	if s.MaxItems != nil {
 		schema = schema.Max(int(*s.MaxItems))
 	}
-	// Note: uniqueItems is not directly supported in GoZod
+	if s.UniqueItems != nil && *s.UniqueItems {
+		schema = schema.Unique()
+	}
 
+	if s.Contains != nil {
+		return ctx.convertContains(s, schema)
+	}
 	return schema, nil
 }
 
+// convertContains applies contains with its minContains/maxContains bounds
+// to schema. minContains defaults to 1 and maxContains to unbounded.
+func (ctx *fromJSONSchemaContext) convertContains(s *lib.Schema, schema *types.ZodSlice[any, []any]) (*types.ZodSlice[any, []any], error) {
+	contains, err := ctx.at("contains").convert(s.Contains)
+	if err != nil {
+		return nil, err
+	}
+	minimum, maximum := 1, -1
+	if s.MinContains != nil {
+		minimum = int(*s.MinContains)
+	}
+	if s.MaxContains != nil {
+		maximum = int(*s.MaxContains)
+	}
+	return schema.Contains(contains, minimum, maximum), nil
+}
+
 // convertTuple converts prefixItems to a Tuple schema.
 func (ctx *fromJSONSchemaContext) convertTuple(s *lib.Schema) (core.ZodSchema, error) {
 	items := make([]core.ZodSchema, len(s.PrefixItems))
@@ -943,10 +1073,16 @@ func (ctx *fromJSONSchemaContext) convertTuple(s *lib.Schema) (core.ZodSchema, e
 		}
 	}
 
+	var tuple *types.ZodTuple[[]any, []any]
 	if rest != nil {
-		return types.TupleWithRest(items, rest), nil
+		tuple = types.TupleWithRest(items, rest)
+	} else {
+		tuple = types.Tuple(items...)
 	}
-	return types.Tuple(items...), nil
+	if s.UniqueItems != nil && *s.UniqueItems {
+		tuple = tuple.Unique()
+	}
+	return tuple, nil
 }
 
 // convertObject converts an object type schema.
@@ -982,8 +1118,11 @@ func (ctx *fromJSONSchemaContext) convertObject(s *lib.Schema) (core.ZodSchema,
 		return ctx.applyRecordPropertyBounds(s, types.Record(keySchema, valueSchema))
 	}
 
+	hasKeywords := (s.PatternProperties != nil && len(*s.PatternProperties) > 0) ||
+		s.PropertyNames != nil || hasObjectDependencies(s) || len(s.Required) > 0
+
 	// Handle record-like objects (additionalProperties without properties)
-	if s.Properties == nil || len(*s.Properties) == 0 {
+	if !hasKeywords && (s.Properties == nil || len(*s.Properties) == 0) {
 		if s.AdditionalProperties != nil {
 			valueSchema, err := ctx.at("additionalProperties").convert(s.AdditionalProperties)
 			if err != nil {
@@ -1003,22 +1142,37 @@ func (ctx *fromJSONSchemaContext) convertObject(s *lib.Schema) (core.ZodSchema,
 	}
 
 	// Convert each property
-	for _, key := range slices.Sorted(maps.Keys(*s.Properties)) {
-		propSchema := (*s.Properties)[key]
-		propZodSchema, err := ctx.at("properties", key).convert(propSchema)
-		if err != nil {
-			return nil, err
-		}
+	if s.Properties != nil {
+		for _, key := range slices.Sorted(maps.Keys(*s.Properties)) {
+			propSchema := (*s.Properties)[key]
+			propZodSchema, err := ctx.at("properties", key).convert(propSchema)
+			if err != nil {
+				return nil, err
+			}
 
-		// Make optional if not in required list
-		if !requiredSet[key] {
-			propZodSchema = makeOptional(propZodSchema)
-		}
+			// Make optional if not in required list
+			if !requiredSet[key] {
+				propZodSchema = makeOptional(propZodSchema)
+			}
 
-		shape[key] = propZodSchema
+			shape[key] = propZodSchema
+		}
+	}
+	// Required keys without a property schema only need to be present.
+	for _, key := range s.Required {
+		if _, ok := shape[key]; !ok {
+			shape[key] = types.Unknown()
+		}
 	}
 
-	result := types.Object(shape)
+	result, err := ctx.applyObjectKeySchemas(s, types.Object(shape))
+	if err != nil {
+		return nil, err
+	}
+	result, err = ctx.applyObjectDependencies(s, result)
+	if err != nil {
+		return nil, err
+	}
 
 	// Handle additionalProperties
 	if s.AdditionalProperties != nil {
@@ -1041,6 +1195,82 @@ func (ctx *fromJSONSchemaContext) convertObject(s *lib.Schema) (core.ZodSchema,
 	return ctx.applyObjectPropertyBounds(s, result)
 }
 
+// applyObjectKeySchemas imports patternProperties and propertyNames onto an
+// object schema.
+func (ctx *fromJSONSchemaContext) applyObjectKeySchemas(
+	s *lib.Schema,
+	schema *types.ZodObject[map[string]any, map[string]any],
+) (*types.ZodObject[map[string]any, map[string]any], error) {
+	if s.PatternProperties != nil && len(*s.PatternProperties) > 0 {
+		patterns := make(map[*regexp.Regexp]core.ZodSchema, len(*s.PatternProperties))
+		for _, pattern := range slices.Sorted(maps.Keys(*s.PatternProperties)) {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, ctx.importErrorAt(
+					"patternProperties",
+					fmt.Errorf("%w: %q: %w", ErrJSONSchemaPatternCompile, pattern, err),
+					"patternProperties",
+					pattern,
+				)
+			}
+			valueSchema, err := ctx.at("patternProperties", pattern).convert((*s.PatternProperties)[pattern])
+			if err != nil {
+				return nil, err
+			}
+			patterns[compiled] = valueSchema
+		}
+		schema = schema.PatternProperties(patterns)
+	}
+	if s.PropertyNames != nil {
+		// Property names are always strings, so an untyped key schema is
+		// anchored to string before its string keywords are imported.
+		names := s.PropertyNames
+		if len(names.Type) == 0 && names.Boolean == nil && names.Ref == "" && names.ResolvedRef == nil {
+			anchored := *names
+			anchored.Type = []string{"string"}
+			names = &anchored
+		}
+		keySchema, err := ctx.at("propertyNames").convert(names)
+		if err != nil {
+			return nil, err
+		}
+		schema = schema.PropertyNames(keySchema)
+	}
+	return schema, nil
+}
+
+// applyObjectDependencies imports dependentRequired and dependentSchemas onto
+// an object schema.
+func (ctx *fromJSONSchemaContext) applyObjectDependencies(
+	s *lib.Schema,
+	schema *types.ZodObject[map[string]any, map[string]any],
+) (*types.ZodObject[map[string]any, map[string]any], error) {
+	if len(s.DependentRequired) > 0 {
+		schema = schema.DependentRequired(s.DependentRequired)
+	}
+	if len(s.DependentSchemas) > 0 {
+		deps := make(map[string]core.ZodSchema, len(s.DependentSchemas))
+		for _, key := range slices.Sorted(maps.Keys(s.DependentSchemas)) {
+			// A dependent schema applies to the object itself, so an untyped
+			// one is anchored to object before its object keywords are imported.
+			dependent := s.DependentSchemas[key]
+			if dependent != nil && len(dependent.Type) == 0 && dependent.Boolean == nil &&
+				dependent.Ref == "" && dependent.ResolvedRef == nil {
+				anchored := *dependent
+				anchored.Type = []string{"object"}
+				dependent = &anchored
+			}
+			converted, err := ctx.at("dependentSchemas", key).convert(dependent)
+			if err != nil {
+				return nil, err
+			}
+			deps[key] = converted
+		}
+		schema = schema.DependentSchemas(deps)
+	}
+	return schema, nil
+}
+
 func (ctx *fromJSONSchemaContext) applyObjectPropertyBounds(
 	s *lib.Schema,
 	schema *types.ZodObject[map[string]any, map[string]any],
@@ -1206,6 +1436,40 @@ func (ctx *fromJSONSchemaContext) convertOneOf(s *lib.Schema) (core.ZodSchema, e
 	return types.Xor(zodSchemasToAny(schemas)), nil
 }
 
+// convertIfThenElse converts if/then/else into a conditional schema. Without
+// if, then and else have no effect and are not imported.
+func (ctx *fromJSONSchemaContext) convertIfThenElse(s *lib.Schema) (core.ZodSchema, error) {
+	condition, err := ctx.at("if").convert(s.If)
+	if err != nil {
+		return nil, err
+	}
+	var then, otherwise core.ZodSchema
+	if s.Then != nil {
+		if then, err = ctx.at("then").convert(s.Then); err != nil {
+			return nil, err
+		}
+	}
+	if s.Else != nil {
+		if otherwise, err = ctx.at("else").convert(s.Else); err != nil {
+			return nil, err
+		}
+	}
+	return types.When(condition, then, otherwise), nil
+}
+
+// convertNot converts not into a negation schema. not: true rejects
+// everything and imports as Never, the schema Never exports.
+func (ctx *fromJSONSchemaContext) convertNot(s *lib.Schema) (core.ZodSchema, error) {
+	if s.Not.Boolean != nil && *s.Not.Boolean {
+		return types.Never(), nil
+	}
+	negated, err := ctx.at("not").convert(s.Not)
+	if err != nil {
+		return nil, err
+	}
+	return types.Not(negated), nil
+}
+
 // convertSchemaList converts a slice of JSON Schemas to GoZod schemas.
 func (ctx *fromJSONSchemaContext) convertSchemaList(keyword string, schemas []*lib.Schema) ([]core.ZodSchema, error) {
 	result := make([]core.ZodSchema, 0, len(schemas))
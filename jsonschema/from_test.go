@@ -1,6 +1,7 @@
 package jsonschema
 
 import (
+	"encoding/json"
 	"errors"
 	"os"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
 	"github.com/kaptinlin/gozod/types"
 )
 
@@ -387,6 +389,7 @@ func TestFromJSONSchema_RejectsEveryUnanchoredArrayAssertion(t *testing.T) {
 		{keyword: "maxItems", schema: &lib.Schema{MaxItems: new(float64(2))}},
 		{keyword: "prefixItems", schema: &lib.Schema{PrefixItems: []*lib.Schema{{Boolean: new(true)}}}},
 		{keyword: "items", schema: &lib.Schema{Items: &lib.Schema{Boolean: new(false)}}},
+		{keyword: "contains", schema: &lib.Schema{Contains: &lib.Schema{Boolean: new(true)}}},
 	}
 
 	for _, test := range tests {
@@ -1160,28 +1163,267 @@ func TestFromJSONSchema_ObjectPropertyCountAssertions(t *testing.T) {
 	}
 }
 
-func TestFromJSONSchema_RejectsDependentRequired(t *testing.T) {
-	schema := &lib.Schema{
-		Type: []string{"object"},
-		Properties: &lib.SchemaMap{
+func TestFromJSONSchema_ImportsDependentRequired(t *testing.T) {
+	schema := compileImportSchema(t, `{
+		"type": "object",
+		"properties": {
 			"account": {
-				Type: []string{"object"},
-				Properties: &lib.SchemaMap{
-					"credit_card":     {Type: []string{"string"}},
-					"billing_address": {Type: []string{"string"}},
+				"type": "object",
+				"properties": {
+					"credit_card": {"type": "string"},
+					"billing_address": {"type": "string"}
 				},
-				DependentRequired: map[string][]string{"credit_card": {"billing_address"}},
+				"dependentRequired": {"credit_card": ["billing_address"]}
+			}
+		}
+	}`)
+
+	imported, err := FromJSONSchema(schema)
+	require.NoError(t, err)
+	for _, input := range []any{
+		map[string]any{"account": map[string]any{}},
+		map[string]any{"account": map[string]any{"billing_address": "Main St"}},
+		map[string]any{"account": map[string]any{"credit_card": "4242", "billing_address": "Main St"}},
+		map[string]any{"account": map[string]any{"credit_card": "4242"}},
+	} {
+		dependencyValid := schema.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "input %#v", input)
+	}
+
+	_, err = imported.ParseAny(map[string]any{"account": map[string]any{"credit_card": "4242"}})
+	var zodErr *issues.ZodError
+	require.ErrorAs(t, err, &zodErr)
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"account", "billing_address"}, zodErr.Issues[0].Path)
+}
+
+func TestFromJSONSchema_ImportsDependentSchemas(t *testing.T) {
+	schema := compileImportSchema(t, `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"credit_card": {"type": "string"}
+		},
+		"dependentSchemas": {
+			"credit_card": {
+				"properties": {"billing_address": {"type": "string", "minLength": 3}},
+				"required": ["billing_address"]
+			}
+		}
+	}`)
+
+	imported, err := FromJSONSchema(schema)
+	require.NoError(t, err)
+	for _, input := range []any{
+		map[string]any{"name": "Ada"},
+		map[string]any{"name": "Ada", "billing_address": "x"},
+		map[string]any{"credit_card": "4242", "billing_address": "Main St"},
+		map[string]any{"credit_card": "4242", "billing_address": "x"},
+		map[string]any{"credit_card": "4242"},
+	} {
+		dependencyValid := schema.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "input %#v", input)
+	}
+}
+
+func TestFromJSONSchema_RoundTripsExportedDependencies(t *testing.T) {
+	original := types.Object(core.ObjectSchema{
+		"credit_card":     types.String().Optional(),
+		"billing_address": types.String().Optional(),
+		"billing_name":    types.String().Optional(),
+	}).DependentRequired(map[string][]string{
+		"credit_card": {"billing_address", "billing_name"},
+	}).DependentSchemas(map[string]core.ZodSchema{
+		"billing_address": types.Object(core.ObjectSchema{
+			"billing_address": types.String().Min(3),
+		}).Passthrough(),
+	})
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"credit_card": {"billing_address", "billing_name"}}, exported.DependentRequired)
+	require.Contains(t, exported.DependentSchemas, "billing_address")
+
+	inputs := []any{
+		map[string]any{},
+		map[string]any{"credit_card": "4242"},
+		map[string]any{"credit_card": "4242", "billing_address": "Main St", "billing_name": "Ada"},
+		map[string]any{"billing_address": "x"},
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func TestFromJSONSchema_ImportsUnevaluatedProperties(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		inputs []any
+	}{
+		{
+			name: "allOf",
+			source: `{
+				"allOf": [
+					{"type": "object", "properties": {"name": {"type": "string"}}},
+					{"type": "object", "properties": {"age": {"type": "integer"}}}
+				],
+				"unevaluatedProperties": false
+			}`,
+			inputs: []any{
+				map[string]any{"name": "Ada", "age": 36},
+				map[string]any{"name": "Ada"},
+				map[string]any{"name": "Ada", "extra": true},
+				"not an object",
+			},
+		},
+		{
+			name: "anyOf",
+			source: `{
+				"type": "object",
+				"properties": {"kind": {"type": "string"}},
+				"anyOf": [
+					{"type": "object", "properties": {"card": {"type": "string"}}, "required": ["card"]},
+					{"type": "object", "properties": {"iban": {"type": "string"}}, "required": ["iban"]}
+				],
+				"unevaluatedProperties": {"type": "integer"}
+			}`,
+			inputs: []any{
+				map[string]any{"kind": "card", "card": "4242"},
+				map[string]any{"kind": "iban", "iban": "DE00", "retries": 3},
+				map[string]any{"kind": "iban", "iban": "DE00", "retries": "3"},
+				map[string]any{"kind": "card", "card": "4242", "iban": "DE00"},
+			},
+		},
+		{
+			name: "plain object",
+			source: `{
+				"type": "object",
+				"properties": {"name": {"type": "string"}},
+				"patternProperties": {"^x-": {"type": "string"}},
+				"unevaluatedProperties": false
+			}`,
+			inputs: []any{
+				map[string]any{"name": "Ada", "x-trace": "1"},
+				map[string]any{"name": "Ada", "other": "1"},
+			},
+		},
+		{
+			name: "$ref siblings",
+			source: `{
+				"$defs": {"named": {"type": "object", "properties": {"name": {"type": "string"}}}},
+				"$ref": "#/$defs/named",
+				"properties": {"age": {"type": "integer"}},
+				"unevaluatedProperties": false
+			}`,
+			inputs: []any{
+				map[string]any{"name": "Ada", "age": 36},
+				map[string]any{"name": "Ada", "extra": true},
 			},
 		},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := compileImportSchema(t, tt.source)
+			imported, err := FromJSONSchema(schema)
+			require.NoError(t, err)
+			for _, input := range tt.inputs {
+				valid := schema.Validate(input).IsValid()
+				_, parseErr := imported.ParseAny(input)
+				assert.Equal(t, valid, parseErr == nil, "input %#v", input)
+			}
+		})
+	}
+}
+
+func TestFromJSONSchema_ImportsUnevaluatedItems(t *testing.T) {
+	schema := compileImportSchema(t, `{
+		"anyOf": [
+			{"type": "array", "items": {"type": "string"}},
+			{"type": "object"}
+		],
+		"unevaluatedItems": false
+	}`)
+
 	imported, err := FromJSONSchema(schema)
-	assert.Nil(t, imported)
-	assert.ErrorIs(t, err, ErrUnsupportedJSONSchemaKeyword)
-	var importErr *ImportError
-	require.ErrorAs(t, err, &importErr)
-	assert.Equal(t, "dependentRequired", importErr.Keyword)
-	assert.Equal(t, "/properties/account/dependentRequired", importErr.Pointer)
+	require.NoError(t, err)
+	for _, input := range []any{
+		[]any{"a", "b"},
+		[]any{"a", 1},
+		map[string]any{"a": 1},
+	} {
+		valid := schema.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, valid, parseErr == nil, "input %#v", input)
+	}
+
+	t.Run("fails closed over partial item evaluation", func(t *testing.T) {
+		schema := compileImportSchema(t, `{
+			"allOf": [{"type": "array", "contains": {"type": "string"}}],
+			"unevaluatedItems": false
+		}`)
+		_, err := FromJSONSchema(schema)
+		require.ErrorIs(t, err, ErrUnsupportedJSONSchemaKeyword)
+
+		var importErr *ImportError
+		require.ErrorAs(t, err, &importErr)
+		assert.Equal(t, "unevaluatedItems", importErr.Keyword)
+
+		zodSchema, losses, err := FromJSONSchemaLossy(schema)
+		require.NoError(t, err)
+		require.NotNil(t, zodSchema)
+		assert.Equal(t, []string{"unevaluatedItems"}, importLossKeywords(losses))
+	})
+}
+
+func TestFromJSONSchema_RoundTripsExportedUnevaluated(t *testing.T) {
+	original := types.Intersection(
+		types.Object(core.ObjectSchema{"name": types.String()}),
+		types.Object(core.ObjectSchema{"age": types.Int().Optional()}),
+	).UnevaluatedProperties(types.Never())
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.UnevaluatedProperties)
+
+	inputs := []any{
+		map[string]any{"name": "Ada", "age": 36},
+		map[string]any{"name": "Ada"},
+		map[string]any{"name": "Ada", "extra": true},
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		valid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, valid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, valid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
 }
 
 func TestFromJSONSchema_NestedObjectPropertyCountIssuePath(t *testing.T) {
@@ -1256,6 +1498,314 @@ func TestFromJSONSchema_RoundTripsExportedRecord(t *testing.T) {
 	}
 }
 
+func TestFromJSONSchema_RoundTripsExportedWhen(t *testing.T) {
+	original := types.When(
+		types.Object(core.ObjectSchema{"card": types.String()}).Passthrough(),
+		types.Object(core.ObjectSchema{"card": types.String().Min(12)}).Passthrough(),
+		types.Object(core.ObjectSchema{"iban": types.String()}).Passthrough(),
+	)
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.If)
+	require.NotNil(t, exported.Then)
+	require.NotNil(t, exported.Else)
+
+	inputs := []any{
+		map[string]any{"card": "4242424242424242"},
+		map[string]any{"card": "4242"},
+		map[string]any{"iban": "DE00"},
+		map[string]any{"card": 4242, "iban": "DE00"},
+		map[string]any{},
+	}
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+	}
+
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func TestFromJSONSchema_RoundTripsImportedWhenWithStripBranches(t *testing.T) {
+	source := compileImportSchema(t, `{
+		"if": {"type": "object", "properties": {"country": {"const": "US"}}, "required": ["country"]},
+		"then": {"type": "object", "required": ["zip"]},
+		"else": {"type": "object", "required": ["postcode"]}
+	}`)
+	imported, err := FromJSONSchema(source)
+	require.NoError(t, err)
+	exported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+
+	for _, input := range []any{
+		map[string]any{"country": "US", "zip": "123"},
+		map[string]any{"country": "US"},
+		map[string]any{"country": "DE", "postcode": "10115"},
+		map[string]any{"country": "DE", "zip": "123"},
+	} {
+		sourceValid := source.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, sourceValid, parseErr == nil, "imported input %#v", input)
+		assert.Equal(t, sourceValid, exported.Validate(input).IsValid(), "exported input %#v", input)
+	}
+}
+
+func TestFromJSONSchema_WhenWithNullCondition(t *testing.T) {
+	source := compileImportSchema(t, `{"if": {"type": "null"}, "then": true, "else": {"type": "string"}}`)
+	imported, err := FromJSONSchema(source)
+	require.NoError(t, err)
+
+	for _, input := range []any{nil, "x", 1} {
+		sourceValid := source.Validate(input).IsValid()
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, sourceValid, parseErr == nil, "imported input %#v", input)
+	}
+	_, err = imported.ParseAny(nil)
+	require.NoError(t, err)
+}
+
+func TestFromJSONSchema_Not(t *testing.T) {
+	schema := &lib.Schema{
+		Type: []string{"string"},
+		Not: &lib.Schema{
+			Enum:        []any{"admin", "root"},
+			Description: new("reserved role name"),
+		},
+	}
+
+	zodSchema, err := FromJSONSchema(schema)
+	require.NoError(t, err)
+
+	_, err = zodSchema.ParseAny("editor")
+	require.NoError(t, err)
+	_, err = zodSchema.ParseAny(42)
+	require.Error(t, err)
+	_, err = zodSchema.ParseAny("root")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidNot, zodErr.Issues[0].Code)
+	assert.Equal(t, "reserved role name", zodErr.Issues[0].Description)
+
+	t.Run("not true imports as never", func(t *testing.T) {
+		imported, err := FromJSONSchema(&lib.Schema{Not: &lib.Schema{Boolean: new(true)}})
+		require.NoError(t, err)
+		assert.Equal(t, core.ZodTypeNever, imported.Internals().Type)
+	})
+
+	t.Run("inner errors carry their location", func(t *testing.T) {
+		_, err := FromJSONSchema(&lib.Schema{
+			Not: &lib.Schema{Type: []string{"string"}, Pattern: new("(")},
+		})
+		var importErr *ImportError
+		require.ErrorAs(t, err, &importErr)
+		assert.Equal(t, "/not/pattern", importErr.Pointer)
+	})
+}
+
+func TestFromJSONSchema_RoundTripsExportedNot(t *testing.T) {
+	original := types.Not(types.Object(core.ObjectSchema{"deleted": types.Bool()}).Passthrough())
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.Not)
+
+	inputs := []any{
+		map[string]any{"deleted": true},
+		map[string]any{"deleted": "no"},
+		map[string]any{"name": "draft"},
+		"text",
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func TestFromJSONSchema_Contains(t *testing.T) {
+	schema := compileImportSchema(t, `{
+		"type": "array",
+		"items": {"type": "string"},
+		"contains": {"const": "admin"},
+		"maxContains": 1
+	}`)
+
+	zodSchema, err := FromJSONSchema(schema)
+	require.NoError(t, err)
+
+	_, err = zodSchema.ParseAny([]any{"viewer", "admin"})
+	require.NoError(t, err)
+	_, err = zodSchema.ParseAny([]any{"viewer"})
+	require.Error(t, err)
+	_, err = zodSchema.ParseAny([]any{"admin", "viewer", "admin"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooBig, zodErr.Issues[0].Code)
+	assert.Equal(t, []int{0, 2}, zodErr.Issues[0].Params["matched"])
+
+	t.Run("minContains zero accepts no matches", func(t *testing.T) {
+		imported, err := FromJSONSchema(compileImportSchema(t, `{"type": "array", "contains": {"type": "integer"}, "minContains": 0}`))
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{"a"})
+		require.NoError(t, err)
+	})
+
+	t.Run("minContains and maxContains without contains are ignored", func(t *testing.T) {
+		imported, err := FromJSONSchema(compileImportSchema(t, `{"type": "array", "minContains": 2, "maxContains": 0}`))
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{})
+		require.NoError(t, err)
+	})
+
+	t.Run("prefixItems keeps contains", func(t *testing.T) {
+		imported, err := FromJSONSchema(compileImportSchema(t, `{
+			"type": "array",
+			"prefixItems": [{"type": "integer"}, {"type": "integer"}],
+			"contains": {"type": "integer", "minimum": 10}
+		}`))
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{1, 10})
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{1, 2})
+		require.Error(t, err)
+	})
+
+	t.Run("inner errors carry their location", func(t *testing.T) {
+		_, err := FromJSONSchema(&lib.Schema{
+			Type:     []string{"array"},
+			Contains: &lib.Schema{Type: []string{"string"}, Pattern: new("(")},
+		})
+		var importErr *ImportError
+		require.ErrorAs(t, err, &importErr)
+		assert.Equal(t, "/contains/pattern", importErr.Pointer)
+	})
+}
+
+func TestFromJSONSchema_RoundTripsExportedContains(t *testing.T) {
+	original := types.Slice[any](types.Union([]any{types.String(), types.Int()})).Contains(types.String(), 1, 2)
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.Contains)
+
+	inputs := []any{
+		[]any{"a", 1},
+		[]any{"a", "b", 1},
+		[]any{"a", "b", "c"},
+		[]any{1, 2},
+		[]any{},
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func TestFromJSONSchema_UniqueItems(t *testing.T) {
+	zodSchema, err := FromJSONSchema(compileImportSchema(t, `{"type": "array", "items": {"type": "string"}, "uniqueItems": true}`))
+	require.NoError(t, err)
+
+	_, err = zodSchema.ParseAny([]any{"a", "b"})
+	require.NoError(t, err)
+	_, err = zodSchema.ParseAny([]any{"a", "b", "a"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+
+	t.Run("uniqueItems false is not a constraint", func(t *testing.T) {
+		imported, err := FromJSONSchema(compileImportSchema(t, `{"uniqueItems": false}`))
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{1, 1})
+		require.NoError(t, err)
+	})
+
+	t.Run("prefixItems keeps uniqueItems", func(t *testing.T) {
+		imported, err := FromJSONSchema(compileImportSchema(t, `{
+			"type": "array",
+			"prefixItems": [{"type": "integer"}, {"type": "integer"}],
+			"uniqueItems": true
+		}`))
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{1, 2})
+		require.NoError(t, err)
+		_, err = imported.ParseAny([]any{1, 1})
+		require.Error(t, err)
+	})
+}
+
+func TestFromJSONSchema_RoundTripsExportedUniqueItems(t *testing.T) {
+	original := types.Slice[any](types.Union([]any{types.String(), types.Int()})).Unique()
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.UniqueItems)
+
+	inputs := []any{
+		[]any{"a", 1},
+		[]any{"a", "a"},
+		[]any{1, 2, 1},
+		[]any{},
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
 func TestFromJSONSchema_RoundTripsExportedRegexRecord(t *testing.T) {
 	original := types.Record(
 		types.String().Regex(regexp.MustCompile("^[a-z]+$")),
@@ -1314,6 +1864,44 @@ func TestFromJSONSchema_RoundTripsExportedLooseRecord(t *testing.T) {
 	}
 }
 
+func TestFromJSONSchema_RoundTripsExportedObjectKeySchemas(t *testing.T) {
+	original := types.Object(core.ObjectSchema{
+		"name": types.String(),
+	}).PatternProperties(map[*regexp.Regexp]core.ZodSchema{
+		regexp.MustCompile("^x-"): types.String(),
+	}).PropertyNames(types.String().Max(12)).Passthrough().WithCatchall(types.Int())
+	exported, err := ToJSONSchema(original)
+	require.NoError(t, err)
+	require.NotNil(t, exported.PatternProperties)
+	require.NotNil(t, exported.PropertyNames)
+
+	inputs := []any{
+		map[string]any{"name": "api"},
+		map[string]any{"name": "api", "x-owner": "team", "retries": 3},
+		map[string]any{"name": "api", "x-owner": 1},
+		map[string]any{"name": "api", "retries": "three"},
+		map[string]any{"name": "api", "x-much-too-long": "team"},
+		map[string]any{"x-owner": "team"},
+	}
+	imported, err := FromJSONSchema(exported)
+	require.NoError(t, err)
+	for _, input := range inputs {
+		dependencyValid := exported.Validate(input).IsValid()
+		_, originalErr := original.ParseAny(input)
+		_, parseErr := imported.ParseAny(input)
+		assert.Equal(t, dependencyValid, originalErr == nil, "original input %#v", input)
+		assert.Equal(t, dependencyValid, parseErr == nil, "imported input %#v", input)
+	}
+
+	reexported, err := ToJSONSchema(imported)
+	require.NoError(t, err)
+	want, err := json.Marshal(exported)
+	require.NoError(t, err)
+	got, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
 func TestFromJSONSchema_InvalidPatternPropertyReportsKeywordAndPointer(t *testing.T) {
 	patterns := lib.SchemaMap{"[": {Type: []string{"string"}}}
 	schema := &lib.Schema{
@@ -1330,85 +1918,131 @@ func TestFromJSONSchema_InvalidPatternPropertyReportsKeywordAndPointer(t *testin
 	assert.Equal(t, "/patternProperties/[", importErr.Pointer)
 }
 
-func TestFromJSONSchema_RejectsExhaustivePropertyNamesRecord(t *testing.T) {
-	schema := &lib.Schema{
-		Type: []string{"object"},
-		PropertyNames: &lib.Schema{
-			Type: []string{"string"},
-			Enum: []any{"a", "b"},
-		},
-		AdditionalProperties: &lib.Schema{Type: []string{"integer"}},
-	}
-
-	imported, err := FromJSONSchema(schema)
-	assert.Nil(t, imported)
-	assert.ErrorIs(t, err, ErrJSONSchemaPropertyNames)
-	var importErr *ImportError
-	require.ErrorAs(t, err, &importErr)
-	assert.Equal(t, "propertyNames", importErr.Keyword)
-	assert.Equal(t, "/propertyNames", importErr.Pointer)
-}
-
-func TestFromJSONSchema_RejectsConflictingRecordShapes(t *testing.T) {
-	stringNames := func() *lib.Schema { return &lib.Schema{Type: []string{"string"}} }
-	valueSchema := func() *lib.Schema { return &lib.Schema{Type: []string{"integer"}} }
+func TestFromJSONSchema_ImportsObjectKeySchemas(t *testing.T) {
 	tests := []struct {
-		name    string
-		schema  *lib.Schema
-		keyword string
+		name   string
+		source string
+		inputs []any
 	}{
+		{
+			name: "exhaustive propertyNames",
+			source: `{
+				"type": "object",
+				"propertyNames": {"type": "string", "enum": ["a", "b"]},
+				"additionalProperties": {"type": "integer"}
+			}`,
+			inputs: []any{
+				map[string]any{},
+				map[string]any{"a": 1},
+				map[string]any{"a": 1, "b": 2},
+				map[string]any{"c": 1},
+				map[string]any{"a": "x"},
+			},
+		},
 		{
 			name: "propertyNames with named properties",
-			schema: &lib.Schema{
-				Type:                 []string{"object"},
-				Properties:           &lib.SchemaMap{"name": {Type: []string{"string"}}},
-				PropertyNames:        stringNames(),
-				AdditionalProperties: valueSchema(),
+			source: `{
+				"type": "object",
+				"properties": {"name": {"type": "string"}},
+				"propertyNames": {"type": "string"},
+				"additionalProperties": {"type": "integer"}
+			}`,
+			inputs: []any{
+				map[string]any{"name": "Ada"},
+				map[string]any{"name": "Ada", "age": 36},
+				map[string]any{"name": "Ada", "age": "old"},
+				map[string]any{"name": 1},
 			},
-			keyword: "propertyNames",
 		},
 		{
-			name: "non-string propertyNames",
-			schema: &lib.Schema{
-				Type:                 []string{"object"},
-				PropertyNames:        &lib.Schema{Type: []string{"number"}},
-				AdditionalProperties: valueSchema(),
+			name: "untyped propertyNames",
+			source: `{
+				"type": "object",
+				"propertyNames": {"maxLength": 3}
+			}`,
+			inputs: []any{
+				map[string]any{"abc": 1},
+				map[string]any{"abcd": 1},
 			},
-			keyword: "propertyNames",
 		},
 		{
 			name: "multiple patterns",
-			schema: &lib.Schema{
-				Type: []string{"object"},
-				PatternProperties: &lib.SchemaMap{
-					"^a": valueSchema(),
-					"^b": valueSchema(),
-				},
+			source: `{
+				"type": "object",
+				"patternProperties": {
+					"^a": {"type": "integer"},
+					"^b": {"type": "string"}
+				}
+			}`,
+			inputs: []any{
+				map[string]any{"a1": 1, "b1": "x"},
+				map[string]any{"a1": "x"},
+				map[string]any{"b1": 1},
+				map[string]any{"c1": true},
 			},
-			keyword: "patternProperties",
 		},
 		{
 			name: "pattern with additionalProperties",
-			schema: &lib.Schema{
-				Type:                 []string{"object"},
-				PatternProperties:    &lib.SchemaMap{"^a": valueSchema()},
-				AdditionalProperties: &lib.Schema{Boolean: new(false)},
+			source: `{
+				"type": "object",
+				"patternProperties": {"^a": {"type": "integer"}},
+				"additionalProperties": false
+			}`,
+			inputs: []any{
+				map[string]any{"a1": 1},
+				map[string]any{"a1": "x"},
+				map[string]any{"b1": 1},
+			},
+		},
+		{
+			name: "fixed properties, extension keys and catchall",
+			source: `{
+				"type": "object",
+				"properties": {"name": {"type": "string"}},
+				"required": ["name"],
+				"patternProperties": {"^x-": {"type": "string"}},
+				"additionalProperties": {"type": "integer"}
+			}`,
+			inputs: []any{
+				map[string]any{"name": "api"},
+				map[string]any{"name": "api", "x-owner": "team", "retries": 3},
+				map[string]any{"name": "api", "x-owner": 1},
+				map[string]any{"name": "api", "retries": "three"},
+				map[string]any{"x-owner": "team"},
 			},
-			keyword: "patternProperties",
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			imported, err := FromJSONSchema(test.schema)
-			assert.Nil(t, imported)
-			var importErr *ImportError
-			require.ErrorAs(t, err, &importErr)
-			assert.Equal(t, test.keyword, importErr.Keyword)
+			compiled := compileImportSchema(t, test.source)
+			imported, err := FromJSONSchema(compiled)
+			require.NoError(t, err)
+			for _, input := range test.inputs {
+				want := compiled.Validate(input).IsValid()
+				_, parseErr := imported.ParseAny(input)
+				assert.Equal(t, want, parseErr == nil, "input %#v", input)
+			}
 		})
 	}
 }
 
+func TestFromJSONSchema_MixedObjectKeepsExtensionKeys(t *testing.T) {
+	imported, err := FromJSONSchema(compileImportSchema(t, `{
+		"type": "object",
+		"properties": {"name": {"type": "string"}},
+		"required": ["name"],
+		"patternProperties": {"^x-": {"type": "string"}},
+		"additionalProperties": {"type": "integer"}
+	}`))
+	require.NoError(t, err)
+
+	input := map[string]any{"name": "api", "x-owner": "team", "retries": 3}
+	result, err := imported.ParseAny(input)
+	require.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
 func TestFromJSONSchema_ObjectPropertyConversionError(t *testing.T) {
 	t.Parallel()
 
@@ -1435,7 +2069,7 @@ func TestFromJSONSchema_NestedImportErrorCarriesJSONPointer(t *testing.T) {
 	schema := &lib.Schema{
 		Type: []string{"object"},
 		Properties: &lib.SchemaMap{
-			"a/b~c": {Not: &lib.Schema{Boolean: new(true)}},
+			"a/b~c": {Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}},
 		},
 	}
 
@@ -1444,8 +2078,8 @@ func TestFromJSONSchema_NestedImportErrorCarriesJSONPointer(t *testing.T) {
 	assert.ErrorIs(t, err, ErrUnsupportedJSONSchemaKeyword)
 	var importErr *ImportError
 	require.True(t, errors.As(err, &importErr))
-	assert.Equal(t, "not", importErr.Keyword)
-	assert.Equal(t, "/properties/a~1b~0c/not", importErr.Pointer)
+	assert.Equal(t, "contentSchema", importErr.Keyword)
+	assert.Equal(t, "/properties/a~1b~0c/contentSchema", importErr.Pointer)
 }
 
 func TestFromJSONSchema_TypeErrorCarriesJSONPointer(t *testing.T) {
@@ -1462,7 +2096,7 @@ func TestFromJSONSchema_TypeErrorCarriesJSONPointer(t *testing.T) {
 
 func TestFromJSONSchema_RecursiveImportErrorPointers(t *testing.T) {
 	unsupported := func() *lib.Schema {
-		return &lib.Schema{Not: &lib.Schema{Boolean: new(true)}}
+		return &lib.Schema{Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}}
 	}
 	tests := []struct {
 		name    string
@@ -1472,7 +2106,7 @@ func TestFromJSONSchema_RecursiveImportErrorPointers(t *testing.T) {
 		{
 			name:    "items",
 			schema:  &lib.Schema{Type: []string{"array"}, Items: unsupported()},
-			pointer: "/items/not",
+			pointer: "/items/contentSchema",
 		},
 		{
 			name: "prefixItems",
@@ -1480,22 +2114,22 @@ func TestFromJSONSchema_RecursiveImportErrorPointers(t *testing.T) {
 				Type:        []string{"array"},
 				PrefixItems: []*lib.Schema{{Type: []string{"string"}}, unsupported()},
 			},
-			pointer: "/prefixItems/1/not",
+			pointer: "/prefixItems/1/contentSchema",
 		},
 		{
 			name:    "allOf",
 			schema:  &lib.Schema{AllOf: []*lib.Schema{unsupported()}},
-			pointer: "/allOf/0/not",
+			pointer: "/allOf/0/contentSchema",
 		},
 		{
 			name:    "anyOf",
 			schema:  &lib.Schema{AnyOf: []*lib.Schema{unsupported()}},
-			pointer: "/anyOf/0/not",
+			pointer: "/anyOf/0/contentSchema",
 		},
 		{
 			name:    "oneOf",
 			schema:  &lib.Schema{OneOf: []*lib.Schema{unsupported()}},
-			pointer: "/oneOf/0/not",
+			pointer: "/oneOf/0/contentSchema",
 		},
 		{
 			name: "$ref",
@@ -1503,7 +2137,7 @@ func TestFromJSONSchema_RecursiveImportErrorPointers(t *testing.T) {
 				Ref:         "#/$defs/value",
 				ResolvedRef: unsupported(),
 			},
-			pointer: "/$ref/not",
+			pointer: "/$ref/contentSchema",
 		},
 	}
 
@@ -1513,7 +2147,7 @@ func TestFromJSONSchema_RecursiveImportErrorPointers(t *testing.T) {
 			assert.Nil(t, imported)
 			var importErr *ImportError
 			require.ErrorAs(t, err, &importErr)
-			assert.Equal(t, "not", importErr.Keyword)
+			assert.Equal(t, "contentSchema", importErr.Keyword)
 			assert.Equal(t, test.pointer, importErr.Pointer)
 		})
 	}
@@ -2029,61 +2663,31 @@ func TestJSONSchemaSupportedSubsetRoundTrip(t *testing.T) {
 }
 
 func TestFromJSONSchema_UnsupportedDefaultsToError(t *testing.T) {
-	t.Run("default conversion fails on if/then/else", func(t *testing.T) {
-		ifSchema := &lib.Schema{}
-		ifSchema.Type = []string{"string"}
-
+	t.Run("default conversion fails on $dynamicRef", func(t *testing.T) {
 		schema := &lib.Schema{}
-		schema.If = ifSchema
+		schema.DynamicRef = "#node"
 
 		_, err := FromJSONSchema(schema)
-		assert.ErrorIs(t, err, ErrJSONSchemaIfThenElse)
+		assert.ErrorIs(t, err, ErrJSONSchemaDynamicRef)
 	})
 
 	t.Run("explicit lossy mode reports ignored keywords", func(t *testing.T) {
-		ifSchema := &lib.Schema{}
-		ifSchema.Type = []string{"string"}
-
 		schema := &lib.Schema{}
-		schema.If = ifSchema
+		schema.DynamicRef = "#node"
 
 		zodSchema, losses, err := FromJSONSchemaLossy(schema)
 		require.NoError(t, err)
 		require.NotNil(t, zodSchema)
 		require.Len(t, losses, 1)
-		assert.Equal(t, "if/then/else", losses[0].Keyword)
+		assert.Equal(t, "$dynamicRef", losses[0].Keyword)
 	})
 }
 
 func TestFromJSONSchema_UnsupportedImportKeywordContract(t *testing.T) {
 	samples := map[string]func() *lib.Schema{
-		"if/then/else": func() *lib.Schema {
-			return &lib.Schema{If: &lib.Schema{Type: []string{"string"}}}
-		},
-		"patternProperties": func() *lib.Schema {
-			return &lib.Schema{PatternProperties: &lib.SchemaMap{"^x-": {Type: []string{"string"}}}}
-		},
 		"$dynamicRef": func() *lib.Schema {
 			return &lib.Schema{DynamicRef: "#node"}
 		},
-		"unevaluatedProperties": func() *lib.Schema {
-			return &lib.Schema{UnevaluatedProperties: &lib.Schema{Boolean: new(false)}}
-		},
-		"unevaluatedItems": func() *lib.Schema {
-			return &lib.Schema{UnevaluatedItems: &lib.Schema{Boolean: new(false)}}
-		},
-		"not": func() *lib.Schema {
-			return &lib.Schema{Not: &lib.Schema{Type: []string{"string"}}}
-		},
-		"uniqueItems": func() *lib.Schema {
-			return &lib.Schema{UniqueItems: new(true)}
-		},
-		"dependentSchemas": func() *lib.Schema {
-			return &lib.Schema{DependentSchemas: map[string]*lib.Schema{"card": {Boolean: new(true)}}}
-		},
-		"dependentRequired": func() *lib.Schema {
-			return &lib.Schema{DependentRequired: map[string][]string{"card": {"billing"}}}
-		},
 		"contentEncoding": func() *lib.Schema {
 			return &lib.Schema{Type: []string{"string"}, ContentEncoding: new("base64")}
 		},
@@ -2093,12 +2697,6 @@ func TestFromJSONSchema_UnsupportedImportKeywordContract(t *testing.T) {
 		"contentSchema": func() *lib.Schema {
 			return &lib.Schema{Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}}
 		},
-		"propertyNames": func() *lib.Schema {
-			return &lib.Schema{PropertyNames: &lib.Schema{Boolean: new(true)}}
-		},
-		"contains": func() *lib.Schema {
-			return &lib.Schema{Contains: &lib.Schema{Boolean: new(true)}}
-		},
 	}
 
 	require.Len(t, samples, len(unsupportedImportKeywords))
@@ -2133,23 +2731,75 @@ func TestFromJSONSchema_UnsupportedImportKeywordContract(t *testing.T) {
 
 func TestFromJSONSchema_LossyRecordsEveryUnsupportedKeyword(t *testing.T) {
 	schema := &lib.Schema{
-		If:          &lib.Schema{Type: []string{"string"}},
-		DynamicRef:  "#node",
-		Not:         &lib.Schema{Type: []string{"integer"}},
-		UniqueItems: new(true),
+		DynamicRef:      "#node",
+		ContentEncoding: new("base64"),
+		UniqueItems:     new(true),
 	}
 
 	zodSchema, losses, err := FromJSONSchemaLossy(schema)
 	require.NoError(t, err)
 	require.NotNil(t, zodSchema)
-	assert.Equal(t, []string{"$dynamicRef", "if/then/else", "not", "uniqueItems"}, importLossKeywords(losses))
+	assert.Equal(t, []string{"$dynamicRef", "contentEncoding", "uniqueItems"}, importLossKeywords(losses))
+}
+
+func TestFromJSONSchema_IfThenElse(t *testing.T) {
+	schema := &lib.Schema{
+		Type: []string{"object"},
+		Properties: &lib.SchemaMap{
+			"kind":   {Type: []string{"string"}},
+			"number": {Type: []string{"string"}},
+		},
+		If: &lib.Schema{
+			Properties: &lib.SchemaMap{"kind": {Const: &lib.ConstValue{Value: "card", IsSet: true}}},
+			Required:   []string{"kind"},
+		},
+		Then: &lib.Schema{
+			Properties: &lib.SchemaMap{"number": {Type: []string{"string"}, MinLength: new(float64(4))}},
+			Required:   []string{"number"},
+		},
+		Else: &lib.Schema{Boolean: new(true)},
+	}
+	schema.If.Type = []string{"object"}
+	schema.Then.Type = []string{"object"}
+
+	zodSchema, err := FromJSONSchema(schema)
+	require.NoError(t, err)
+
+	_, err = zodSchema.ParseAny(map[string]any{"kind": "cash"})
+	require.NoError(t, err)
+	_, err = zodSchema.ParseAny(map[string]any{"kind": "card", "number": "4242"})
+	require.NoError(t, err)
+	_, err = zodSchema.ParseAny(map[string]any{"kind": "card"})
+	require.Error(t, err)
+	_, err = zodSchema.ParseAny(map[string]any{"kind": "card", "number": "42"})
+	require.Error(t, err)
+
+	t.Run("then and else without if have no effect", func(t *testing.T) {
+		imported, err := FromJSONSchema(&lib.Schema{
+			Type: []string{"string"},
+			Then: &lib.Schema{Boolean: new(false)},
+		})
+		require.NoError(t, err)
+		_, err = imported.ParseAny("value")
+		require.NoError(t, err)
+	})
+
+	t.Run("branch errors carry their location", func(t *testing.T) {
+		_, err := FromJSONSchema(&lib.Schema{
+			If:   &lib.Schema{Type: []string{"string"}},
+			Then: &lib.Schema{Type: []string{"string"}, Pattern: new("(")},
+		})
+		var importErr *ImportError
+		require.ErrorAs(t, err, &importErr)
+		assert.Equal(t, "/then/pattern", importErr.Pointer)
+	})
 }
 
 func TestFromJSONSchemaLossy_ReturnsTypedLoss(t *testing.T) {
 	schema := &lib.Schema{
 		Type: []string{"object"},
 		Properties: &lib.SchemaMap{
-			"value": {Not: &lib.Schema{Boolean: new(true)}},
+			"value": {Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}},
 		},
 	}
 
@@ -2157,8 +2807,8 @@ func TestFromJSONSchemaLossy_ReturnsTypedLoss(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, imported)
 	require.Len(t, losses, 1)
-	assert.Equal(t, "not", losses[0].Keyword)
-	assert.Equal(t, "/properties/value/not", losses[0].Pointer)
+	assert.Equal(t, "contentSchema", losses[0].Keyword)
+	assert.Equal(t, "/properties/value/contentSchema", losses[0].Pointer)
 	assert.ErrorIs(t, losses[0], ErrUnsupportedJSONSchemaKeyword)
 }
 
@@ -2166,8 +2816,8 @@ func TestFromJSONSchemaLossy_PreservesTheSameKeywordAtDifferentLocations(t *test
 	document := &lib.Schema{
 		Type: []string{"object"},
 		Properties: &lib.SchemaMap{
-			"first":  {Not: &lib.Schema{Boolean: new(true)}},
-			"second": {Not: &lib.Schema{Boolean: new(true)}},
+			"first":  {Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}},
+			"second": {Type: []string{"string"}, ContentSchema: &lib.Schema{Boolean: new(true)}},
 		},
 	}
 
@@ -2176,27 +2826,27 @@ func TestFromJSONSchemaLossy_PreservesTheSameKeywordAtDifferentLocations(t *test
 	require.NotNil(t, imported)
 	require.Len(t, losses, 2)
 	assert.Equal(t, []string{
-		"/properties/first/not",
-		"/properties/second/not",
+		"/properties/first/contentSchema",
+		"/properties/second/contentSchema",
 	}, []string{losses[0].Pointer, losses[1].Pointer})
 }
 
 func TestFromJSONSchemaLossy_SortsLossesByLocation(t *testing.T) {
 	schema := &lib.Schema{
-		Not:       &lib.Schema{Boolean: new(true)},
-		MinLength: new(float64(1)),
-		Maximum:   lib.NewRat(1),
-		Items:     &lib.Schema{Boolean: new(false)},
+		ContentSchema: &lib.Schema{Boolean: new(true)},
+		MinLength:     new(float64(1)),
+		Maximum:       lib.NewRat(1),
+		Items:         &lib.Schema{Boolean: new(false)},
 	}
 
 	_, losses, err := FromJSONSchemaLossy(schema)
 	require.NoError(t, err)
 	require.Len(t, losses, 4)
 	assert.Equal(t, []string{
+		"/contentSchema",
 		"/items",
 		"/maximum",
 		"/minLength",
-		"/not",
 	}, []string{losses[0].Pointer, losses[1].Pointer, losses[2].Pointer, losses[3].Pointer})
 }
 
@@ -2210,7 +2860,7 @@ func TestFromJSONSchemaLossy_NoLossReturnsEmptySnapshot(t *testing.T) {
 }
 
 func TestFromJSONSchemaLossy_ReturnedSnapshotsAreIndependent(t *testing.T) {
-	document := &lib.Schema{Not: &lib.Schema{Boolean: new(true)}}
+	document := &lib.Schema{ContentSchema: &lib.Schema{Boolean: new(true)}}
 
 	_, first, err := FromJSONSchemaLossy(document)
 	require.NoError(t, err)
@@ -2220,14 +2870,14 @@ func TestFromJSONSchemaLossy_ReturnedSnapshotsAreIndependent(t *testing.T) {
 	require.Len(t, second, 1)
 
 	first[0].Keyword = "caller-mutated"
-	assert.Equal(t, "not", second[0].Keyword)
+	assert.Equal(t, "contentSchema", second[0].Keyword)
 }
 
 func TestFromJSONSchemaLossy_ConcurrentCallsReturnIdenticalSnapshots(t *testing.T) {
 	document := &lib.Schema{
-		Not:       &lib.Schema{Boolean: new(true)},
-		MinLength: new(float64(1)),
-		Maximum:   lib.NewRat(1),
+		ContentSchema: &lib.Schema{Boolean: new(true)},
+		MinLength:     new(float64(1)),
+		Maximum:       lib.NewRat(1),
 	}
 	_, want, err := FromJSONSchemaLossy(document)
 	require.NoError(t, err)
@@ -2256,8 +2906,8 @@ func TestFromJSONSchemaLossy_ConcurrentCallsReturnIdenticalSnapshots(t *testing.
 
 func TestFromJSONSchemaLossy_FatalErrorPreservesEarlierLosses(t *testing.T) {
 	document := &lib.Schema{
-		Type: []string{"object"},
-		Not:  &lib.Schema{Boolean: new(true)},
+		Type:       []string{"object"},
+		DynamicRef: "#node",
 		Properties: &lib.SchemaMap{
 			"value": {
 				Type:    []string{"string"},
@@ -2270,12 +2920,12 @@ func TestFromJSONSchemaLossy_FatalErrorPreservesEarlierLosses(t *testing.T) {
 	require.Nil(t, imported)
 	require.ErrorIs(t, err, ErrJSONSchemaPatternCompile)
 	require.Len(t, losses, 1)
-	assert.Equal(t, "not", losses[0].Keyword)
-	assert.Equal(t, "/not", losses[0].Pointer)
+	assert.Equal(t, "$dynamicRef", losses[0].Keyword)
+	assert.Equal(t, "/$dynamicRef", losses[0].Pointer)
 
 	strict, err := FromJSONSchema(document)
 	require.Nil(t, strict)
-	assert.ErrorIs(t, err, ErrUnsupportedJSONSchemaKeyword)
+	assert.ErrorIs(t, err, ErrJSONSchemaDynamicRef)
 }
 
 func TestFromJSONSchema_NilSchema(t *testing.T) {
@@ -2562,7 +3212,7 @@ func TestFromJSONSchema_DefaultUnsupportedKeywords(t *testing.T) {
 				patternSchema.Type = []string{"string"}
 				return &lib.Schema{PatternProperties: &lib.SchemaMap{"^x-": patternSchema}}
 			},
-			want: ErrJSONSchemaPatternProperties,
+			want: ErrUnsupportedJSONSchemaKeyword,
 		},
 		{
 			name: "dynamicRef",
@@ -2572,45 +3222,24 @@ func TestFromJSONSchema_DefaultUnsupportedKeywords(t *testing.T) {
 			want: ErrJSONSchemaDynamicRef,
 		},
 		{
-			name: "unevaluatedProperties",
-			schema: func() *lib.Schema {
-				return &lib.Schema{UnevaluatedProperties: &lib.Schema{Boolean: new(false)}}
-			},
-			want: ErrJSONSchemaUnevaluatedProps,
-		},
-		{
-			name: "unevaluatedItems",
+			name: "unevaluatedItems over items-less array",
 			schema: func() *lib.Schema {
-				return &lib.Schema{UnevaluatedItems: &lib.Schema{Boolean: new(false)}}
+				return &lib.Schema{Type: []string{"array"}, UnevaluatedItems: &lib.Schema{Boolean: new(false)}}
 			},
-			want: ErrJSONSchemaUnevaluatedItems,
+			want: ErrUnsupportedJSONSchemaKeyword,
 		},
 		{
 			name: "dependentSchemas",
 			schema: func() *lib.Schema {
 				return &lib.Schema{DependentSchemas: map[string]*lib.Schema{"card": {Boolean: new(true)}}}
 			},
-			want: ErrJSONSchemaDependentSchemas,
+			want: ErrUnsupportedJSONSchemaKeyword,
 		},
 		{
 			name: "propertyNames",
 			schema: func() *lib.Schema {
 				return &lib.Schema{PropertyNames: &lib.Schema{Boolean: new(true)}}
 			},
-			want: ErrJSONSchemaPropertyNames,
-		},
-		{
-			name: "contains",
-			schema: func() *lib.Schema {
-				return &lib.Schema{Contains: &lib.Schema{Boolean: new(true)}}
-			},
-			want: ErrJSONSchemaContains,
-		},
-		{
-			name: "not",
-			schema: func() *lib.Schema {
-				return &lib.Schema{Not: &lib.Schema{Type: []string{"string"}}}
-			},
 			want: ErrUnsupportedJSONSchemaKeyword,
 		},
 		{
@@ -2620,22 +3249,6 @@ func TestFromJSONSchema_DefaultUnsupportedKeywords(t *testing.T) {
 			},
 			want: ErrUnsupportedJSONSchemaKeyword,
 		},
-		{
-			name: "minContains",
-			schema: func() *lib.Schema {
-				minContains := float64(1)
-				return &lib.Schema{MinContains: &minContains}
-			},
-			want: ErrJSONSchemaContains,
-		},
-		{
-			name: "maxContains",
-			schema: func() *lib.Schema {
-				maxContains := float64(2)
-				return &lib.Schema{MaxContains: &maxContains}
-			},
-			want: ErrJSONSchemaContains,
-		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,314 @@
+package jsonschema
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/go-json-experiment/json"
+	lib "github.com/kaptinlin/jsonschema"
+)
+
+// Resolver loads the JSON Schema documents that $ref values name.
+type Resolver interface {
+	// Resolve returns the document identified by uri: the $ref resolved
+	// against its base URI, without the fragment. The uri stays relative
+	// when no enclosing schema declares an absolute $id.
+	Resolve(uri string) (*lib.Schema, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(uri string) (*lib.Schema, error)
+
+// Resolve calls f(uri).
+func (f ResolverFunc) Resolve(uri string) (*lib.Schema, error) {
+	return f(uri)
+}
+
+// MapResolver resolves URIs from an in-memory set of documents keyed by URI.
+type MapResolver map[string]*lib.Schema
+
+// Resolve returns the document stored under uri.
+func (m MapResolver) Resolve(uri string) (*lib.Schema, error) {
+	if document, ok := m[uri]; ok {
+		return document, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrJSONSchemaRefNotFound, uri)
+}
+
+// NewFSResolver returns a Resolver that reads documents from fsys. URIs under
+// baseURI, and relative URIs, name the file at the remaining path.
+func NewFSResolver(fsys fs.FS, baseURI string) Resolver {
+	return ResolverFunc(func(uri string) (*lib.Schema, error) {
+		name, ok := strings.CutPrefix(uri, baseURI)
+		if !ok || baseURI == "" {
+			if parsed, err := url.Parse(uri); err != nil || parsed.IsAbs() {
+				return nil, fmt.Errorf("%w: %q", ErrJSONSchemaRefNotFound, uri)
+			}
+		}
+		name = path.Clean(strings.TrimPrefix(name, "/"))
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrJSONSchemaRefNotFound, uri, err)
+		}
+		document := new(lib.Schema)
+		if err := json.Unmarshal(data, document); err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidJSONSchema, uri, err)
+		}
+		return document, nil
+	})
+}
+
+// refScope is the base URI that relative references resolve against and the
+// schema resource that their fragments resolve within.
+type refScope struct {
+	base     string
+	resource *lib.Schema
+}
+
+// refResolver resolves the $ref values that kaptinlin/jsonschema left
+// unresolved. Documents are loaded once per URI, so every reference to the
+// same target reaches the same schema node and imports as one GoZod schema.
+type refResolver struct {
+	resolver  Resolver
+	documents map[string]*lib.Schema
+	anchors   map[string]*lib.Schema
+	scopes    map[*lib.Schema]refScope
+}
+
+func newRefResolver(resolver Resolver, root *lib.Schema) *refResolver {
+	r := &refResolver{
+		resolver:  resolver,
+		documents: make(map[string]*lib.Schema),
+		anchors:   make(map[string]*lib.Schema),
+		scopes:    make(map[*lib.Schema]refScope),
+	}
+	if root != nil {
+		r.index(root, refScope{resource: root})
+	}
+	return r
+}
+
+// index records the resources and anchors a document declares.
+func (r *refResolver) index(s *lib.Schema, scope refScope) {
+	if s == nil || s.Boolean != nil {
+		return
+	}
+	if _, seen := r.scopes[s]; seen {
+		return
+	}
+	if s.ID != "" {
+		id, anchor, _ := strings.Cut(resolveURI(scope.base, s.ID), "#")
+		if id != "" {
+			scope = refScope{base: id, resource: s}
+			r.documents[id] = s
+		}
+		if anchor != "" {
+			r.anchors[scope.base+"#"+anchor] = s
+		}
+	}
+	if s.Anchor != "" {
+		r.anchors[scope.base+"#"+s.Anchor] = s
+	}
+	r.scopes[s] = scope
+	for _, sub := range subschemas(s) {
+		r.index(sub, scope)
+	}
+}
+
+// resolve returns the target of ref, written in a schema within scope, and
+// the scope of that target.
+func (r *refResolver) resolve(ref string, scope refScope) (*lib.Schema, refScope, error) {
+	uri, fragment, _ := strings.Cut(resolveURI(scope.base, ref), "#")
+	resource := scope.resource
+	if uri != "" && uri != scope.base {
+		document, ok := r.documents[uri]
+		if !ok {
+			loaded, err := r.resolver.Resolve(uri)
+			if err != nil {
+				return nil, refScope{}, err
+			}
+			if loaded == nil {
+				return nil, refScope{}, fmt.Errorf("%w: %q", ErrJSONSchemaRefNotFound, uri)
+			}
+			document = loaded
+			r.documents[uri] = document
+			r.index(document, refScope{base: uri, resource: document})
+		}
+		resource = document
+		scope = r.scopes[document]
+	}
+
+	if fragment == "" {
+		return resource, scope, nil
+	}
+	if !strings.HasPrefix(fragment, "/") {
+		target, ok := r.anchors[scope.base+"#"+fragment]
+		if !ok {
+			return nil, refScope{}, fmt.Errorf("%w: anchor %q", ErrJSONSchemaRefNotFound, ref)
+		}
+		return target, r.scopes[target], nil
+	}
+	target, err := pointerTarget(resource, fragment)
+	if err != nil {
+		return nil, refScope{}, fmt.Errorf("%w: %q: %w", ErrJSONSchemaRefNotFound, ref, err)
+	}
+	if targetScope, ok := r.scopes[target]; ok {
+		scope = targetScope
+	}
+	return target, scope, nil
+}
+
+// resolveURI resolves ref against base. A relative ref stays relative when
+// there is no base.
+func resolveURI(base, ref string) string {
+	if base == "" {
+		return ref
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	if baseURL.IsAbs() {
+		return baseURL.ResolveReference(refURL).String()
+	}
+	// Relative document names, such as "schemas/user.json", resolve like paths.
+	if refURL.Scheme != "" || strings.HasPrefix(refURL.Path, "/") {
+		return ref
+	}
+	if refURL.Path == "" {
+		refURL.Path = baseURL.Path
+	} else {
+		refURL.Path = path.Join(path.Dir(baseURL.Path), refURL.Path)
+	}
+	return refURL.String()
+}
+
+// pointerTarget follows a JSON Pointer fragment from resource to a subschema.
+func pointerTarget(resource *lib.Schema, fragment string) (*lib.Schema, error) {
+	decoded, err := url.PathUnescape(fragment)
+	if err != nil {
+		return nil, err
+	}
+	tokens := strings.Split(strings.TrimPrefix(decoded, "/"), "/")
+	if decoded == "/" {
+		tokens = nil
+	}
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+
+	current := resource
+	for i := 0; i < len(tokens); i++ {
+		if current == nil || current.Boolean != nil {
+			return nil, fmt.Errorf("no schema at %q", fragment)
+		}
+		keyword := tokens[i]
+		var next *lib.Schema
+		switch keyword {
+		case "not":
+			next = current.Not
+		case "if":
+			next = current.If
+		case "then":
+			next = current.Then
+		case "else":
+			next = current.Else
+		case "items":
+			next = current.Items
+		case "contains":
+			next = current.Contains
+		case "additionalProperties":
+			next = current.AdditionalProperties
+		case "propertyNames":
+			next = current.PropertyNames
+		case "unevaluatedItems":
+			next = current.UnevaluatedItems
+		case "unevaluatedProperties":
+			next = current.UnevaluatedProperties
+		case "contentSchema":
+			next = current.ContentSchema
+		case "$defs", "definitions", "properties", "patternProperties", "dependentSchemas":
+			if i+1 >= len(tokens) {
+				return nil, fmt.Errorf("no schema at %q", fragment)
+			}
+			i++
+			next = keyedSubschemas(current, keyword)[tokens[i]]
+		case "allOf", "anyOf", "oneOf", "prefixItems":
+			if i+1 >= len(tokens) {
+				return nil, fmt.Errorf("no schema at %q", fragment)
+			}
+			i++
+			list := listedSubschemas(current, keyword)
+			index, err := strconv.Atoi(tokens[i])
+			if err != nil || index < 0 || index >= len(list) {
+				return nil, fmt.Errorf("no schema at %q", fragment)
+			}
+			next = list[index]
+		default:
+			return nil, fmt.Errorf("no schema at %q", fragment)
+		}
+		if next == nil {
+			return nil, fmt.Errorf("no schema at %q", fragment)
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func keyedSubschemas(s *lib.Schema, keyword string) map[string]*lib.Schema {
+	switch keyword {
+	case "$defs", "definitions":
+		return s.Defs
+	case "dependentSchemas":
+		return s.DependentSchemas
+	case "properties":
+		if s.Properties != nil {
+			return *s.Properties
+		}
+	case "patternProperties":
+		if s.PatternProperties != nil {
+			return *s.PatternProperties
+		}
+	}
+	return nil
+}
+
+func listedSubschemas(s *lib.Schema, keyword string) []*lib.Schema {
+	switch keyword {
+	case "allOf":
+		return s.AllOf
+	case "anyOf":
+		return s.AnyOf
+	case "oneOf":
+		return s.OneOf
+	case "prefixItems":
+		return s.PrefixItems
+	}
+	return nil
+}
+
+// subschemas returns every direct subschema of s.
+func subschemas(s *lib.Schema) []*lib.Schema {
+	out := []*lib.Schema{
+		s.Not, s.If, s.Then, s.Else, s.Items, s.Contains, s.AdditionalProperties,
+		s.PropertyNames, s.UnevaluatedItems, s.UnevaluatedProperties, s.ContentSchema,
+	}
+	out = append(out, s.AllOf...)
+	out = append(out, s.AnyOf...)
+	out = append(out, s.OneOf...)
+	out = append(out, s.PrefixItems...)
+	for _, keyword := range []string{"$defs", "properties", "patternProperties", "dependentSchemas"} {
+		for _, sub := range keyedSubschemas(s, keyword) {
+			out = append(out, sub)
+		}
+	}
+	return out
+}
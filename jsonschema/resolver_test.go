@@ -0,0 +1,198 @@
+package jsonschema
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-json-experiment/json"
+	lib "github.com/kaptinlin/jsonschema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeImportSchema(t *testing.T, source string) *lib.Schema {
+	t.Helper()
+	schema := new(lib.Schema)
+	require.NoError(t, json.Unmarshal([]byte(source), schema))
+	return schema
+}
+
+// countingResolver records how often each URI is loaded.
+func countingResolver(resolver Resolver, loads map[string]int) Resolver {
+	return ResolverFunc(func(uri string) (*lib.Schema, error) {
+		loads[uri]++
+		return resolver.Resolve(uri)
+	})
+}
+
+func TestFromJSONSchema_ResolverImportsMultiFileSchemas(t *testing.T) {
+	documents := MapResolver{
+		"user.json": decodeImportSchema(t, `{
+			"type": "object",
+			"properties": {"name": {"$ref": "common.json#/$defs/name"}},
+			"required": ["name"]
+		}`),
+		"common.json": decodeImportSchema(t, `{"$defs": {"name": {"type": "string", "minLength": 1}}}`),
+	}
+	root := decodeImportSchema(t, `{
+		"type": "object",
+		"properties": {
+			"author": {"$ref": "user.json"},
+			"editor": {"$ref": "user.json"}
+		},
+		"required": ["author"]
+	}`)
+	loads := make(map[string]int)
+
+	imported, err := FromJSONSchema(root, FromJSONSchemaOptions{Resolver: countingResolver(documents, loads)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"user.json": 1, "common.json": 1}, loads)
+
+	_, err = imported.ParseAny(map[string]any{
+		"author": map[string]any{"name": "Ada"},
+		"editor": map[string]any{"name": "Grace"},
+	})
+	require.NoError(t, err)
+	_, err = imported.ParseAny(map[string]any{"author": map[string]any{"name": ""}})
+	require.Error(t, err)
+	_, err = imported.ParseAny(map[string]any{"author": map[string]any{}})
+	require.Error(t, err)
+}
+
+func TestFromJSONSchema_ResolverFollowsIDs(t *testing.T) {
+	files := fstest.MapFS{
+		"schemas/user.json": {Data: []byte(`{
+			"$id": "https://example.com/schemas/user.json",
+			"type": "object",
+			"properties": {"address": {"$ref": "address.json"}},
+			"required": ["address"],
+			"$defs": {
+				"address": {
+					"$id": "address.json",
+					"type": "object",
+					"properties": {"zip": {"$ref": "#zip"}},
+					"required": ["zip"],
+					"$defs": {"zip": {"$anchor": "zip", "type": "string", "pattern": "^[0-9]{5}$"}}
+				}
+			}
+		}`)},
+	}
+	root := decodeImportSchema(t, `{
+		"$id": "https://example.com/root.json",
+		"$ref": "schemas/user.json"
+	}`)
+	loads := make(map[string]int)
+	resolver := countingResolver(NewFSResolver(files, "https://example.com/"), loads)
+
+	imported, err := FromJSONSchema(root, FromJSONSchemaOptions{Resolver: resolver})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"https://example.com/schemas/user.json": 1}, loads)
+
+	_, err = imported.ParseAny(map[string]any{"address": map[string]any{"zip": "12345"}})
+	require.NoError(t, err)
+	_, err = imported.ParseAny(map[string]any{"address": map[string]any{"zip": "1234"}})
+	require.Error(t, err)
+}
+
+func TestFromJSONSchema_ResolverTurnsCyclesIntoLazy(t *testing.T) {
+	documents := MapResolver{
+		"node.json": decodeImportSchema(t, `{
+			"type": "object",
+			"properties": {
+				"value": {"type": "integer"},
+				"children": {"type": "array", "items": {"$ref": "tree.json#/$defs/child"}}
+			},
+			"required": ["value"]
+		}`),
+		"tree.json": decodeImportSchema(t, `{"$defs": {"child": {"$ref": "node.json"}}}`),
+	}
+
+	imported, err := FromJSONSchema(&lib.Schema{Ref: "node.json"}, FromJSONSchemaOptions{Resolver: documents})
+	require.NoError(t, err)
+
+	_, err = imported.ParseAny(map[string]any{
+		"value": 1,
+		"children": []any{
+			map[string]any{"value": 2, "children": []any{map[string]any{"value": 3}}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = imported.ParseAny(map[string]any{
+		"value":    1,
+		"children": []any{map[string]any{"children": []any{}}},
+	})
+	require.Error(t, err)
+}
+
+func TestFromJSONSchema_ResolverResolvesLocalReferences(t *testing.T) {
+	root := decodeImportSchema(t, `{
+		"type": "array",
+		"items": {"$ref": "#/$defs/positive"},
+		"$defs": {"positive": {"type": "integer", "minimum": 1}}
+	}`)
+
+	_, err := FromJSONSchema(root)
+	require.ErrorIs(t, err, ErrInvalidJSONSchema)
+
+	imported, err := FromJSONSchema(root, FromJSONSchemaOptions{Resolver: MapResolver{}})
+	require.NoError(t, err)
+	_, err = imported.ParseAny([]any{1, 2})
+	require.NoError(t, err)
+	_, err = imported.ParseAny([]any{0})
+	require.Error(t, err)
+}
+
+func TestFromJSONSchema_ResolverErrors(t *testing.T) {
+	documents := MapResolver{"common.json": decodeImportSchema(t, `{"$defs": {"name": {"type": "string"}}}`)}
+	tests := []struct {
+		name     string
+		schema   string
+		contains string
+	}{
+		{
+			name:     "missing document",
+			schema:   `{"type": "object", "properties": {"a": {"$ref": "missing.json"}}}`,
+			contains: `"missing.json"`,
+		},
+		{
+			name:     "missing pointer",
+			schema:   `{"$ref": "common.json#/$defs/other"}`,
+			contains: `no schema at "/$defs/other"`,
+		},
+		{
+			name:     "missing anchor",
+			schema:   `{"$ref": "#nowhere"}`,
+			contains: `anchor "#nowhere"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromJSONSchema(decodeImportSchema(t, tt.schema), FromJSONSchemaOptions{Resolver: documents})
+			require.ErrorIs(t, err, ErrJSONSchemaRefNotFound)
+			assert.ErrorContains(t, err, tt.contains)
+			var importErr *ImportError
+			require.ErrorAs(t, err, &importErr)
+			assert.Equal(t, "$ref", importErr.Keyword)
+		})
+	}
+
+	t.Run("self reference", func(t *testing.T) {
+		_, err := FromJSONSchema(decodeImportSchema(t, `{"$ref": "#"}`), FromJSONSchemaOptions{Resolver: documents})
+		require.ErrorIs(t, err, ErrJSONSchemaCircularRef)
+	})
+}
+
+func TestNewFSResolverRejectsURIsOutsideBase(t *testing.T) {
+	resolver := NewFSResolver(fstest.MapFS{"a.json": {Data: []byte(`{"type": "string"}`)}}, "https://example.com/")
+
+	document, err := resolver.Resolve("https://example.com/a.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"string"}, []string(document.Type))
+
+	_, err = resolver.Resolve("https://other.example/a.json")
+	require.ErrorIs(t, err, ErrJSONSchemaRefNotFound)
+	_, err = resolver.Resolve("https://example.com/b.json")
+	require.ErrorIs(t, err, ErrJSONSchemaRefNotFound)
+}
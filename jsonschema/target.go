@@ -0,0 +1,576 @@
+package jsonschema
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	lib "github.com/kaptinlin/jsonschema"
+)
+
+// Target selects the JSON Schema dialect export emits.
+type Target string
+
+// Supported export targets.
+const (
+	TargetDraft202012 Target = "draft-2020-12"
+	TargetDraft07     Target = "draft-07"
+	TargetOpenAPI30   Target = "openapi-3.0"
+	TargetOpenAPI31   Target = "openapi-3.1"
+)
+
+const (
+	draft07SchemaURI     = "http://json-schema.org/draft-07/schema#"
+	defsRefPrefix        = "#/$defs/"
+	definitionsRefPrefix = "#/definitions/"
+	componentsRefPrefix  = "#/components/schemas/"
+)
+
+// unsupportedKeywords lists, per target, the Draft 2020-12 keywords the
+// target has no faithful equivalent for.
+var unsupportedKeywords = map[Target][]targetKeyword{
+	TargetDraft07: {
+		{"minContains", func(s *lib.Schema) bool { return s.MinContains != nil && *s.MinContains > 1 }},
+		{"maxContains", func(s *lib.Schema) bool { return s.MaxContains != nil }},
+		{"unevaluatedProperties", func(s *lib.Schema) bool { return s.UnevaluatedProperties != nil }},
+		{"unevaluatedItems", func(s *lib.Schema) bool { return s.UnevaluatedItems != nil }},
+		{"contentSchema", func(s *lib.Schema) bool { return s.ContentSchema != nil }},
+	},
+	TargetOpenAPI30: {
+		{"prefixItems", func(s *lib.Schema) bool { return len(s.PrefixItems) > 0 }},
+		{"contains", func(s *lib.Schema) bool { return s.Contains != nil }},
+		{"if", func(s *lib.Schema) bool { return s.If != nil }},
+		{"patternProperties", func(s *lib.Schema) bool { return s.PatternProperties != nil && len(*s.PatternProperties) > 0 }},
+		{"propertyNames", func(s *lib.Schema) bool { return s.PropertyNames != nil }},
+		{"dependentRequired", func(s *lib.Schema) bool { return len(s.DependentRequired) > 0 }},
+		{"dependentSchemas", func(s *lib.Schema) bool { return len(s.DependentSchemas) > 0 }},
+		{"unevaluatedProperties", func(s *lib.Schema) bool { return s.UnevaluatedProperties != nil }},
+		{"unevaluatedItems", func(s *lib.Schema) bool { return s.UnevaluatedItems != nil }},
+		{"contentSchema", func(s *lib.Schema) bool { return s.ContentSchema != nil }},
+	},
+}
+
+type targetKeyword struct {
+	keyword string
+	present func(*lib.Schema) bool
+}
+
+// retargeter rewrites a Draft 2020-12 export into another target. It copies
+// every node it visits, so the converter's output is never mutated, and
+// memoizes copies so shared nodes stay shared.
+type retargeter struct {
+	target          Target
+	unrepresentable UnrepresentableMode
+	memo            map[*lib.Schema]*lib.Schema
+	path            []string
+	// defsPrefix replaces the #/$defs/ prefix of definition references.
+	defsPrefix string
+	// rootRef, when set, replaces the # reference to the root schema, and
+	// rootReferenced records that it did.
+	rootRef        string
+	rootReferenced bool
+}
+
+// rootDefName is the definition name an OpenAPI export hoists a
+// self-referencing root schema under.
+const rootDefName = "root"
+
+// retarget rewrites the exported root schema for opts.Target. The OpenAPI
+// targets keep definitions and references under $defs, so the output stays
+// a self-contained document, and hoist a root schema that references itself
+// into a definition, since OpenAPI has no # reference.
+func retarget(root *lib.Schema, opts Options) (*lib.Schema, error) {
+	if opts.Target == TargetDraft202012 || root == nil {
+		return root, nil
+	}
+	r := newRetargeter(opts)
+	if r.target == TargetOpenAPI30 || r.target == TargetOpenAPI31 {
+		r.defsPrefix = defsRefPrefix
+		r.rootRef = defsRefPrefix + unusedDefName(root.Defs, rootDefName)
+	}
+	out, err := r.schema(root, false)
+	if err != nil {
+		return nil, err
+	}
+	if r.rootReferenced {
+		out = hoistRoot(out, strings.TrimPrefix(r.rootRef, defsRefPrefix))
+	}
+	if r.target == TargetDraft07 {
+		if out.Boolean != nil {
+			out = &lib.Schema{Not: booleanNot(*out.Boolean)}
+		}
+		out.Schema = draft07SchemaURI
+	}
+	return out, nil
+}
+
+// retargetBundle rewrites the schemas and definitions of a bundle for
+// opts.Target with one retargeter, so nodes they share stay shared. Bundle
+// schemas are embedded by the caller, so none of them gains a $schema.
+func retargetBundle(b *Bundle, opts Options) (*Bundle, error) {
+	if opts.Target == TargetDraft202012 {
+		return b, nil
+	}
+	r := newRetargeter(opts)
+	out := &Bundle{Schemas: make([]*lib.Schema, len(b.Schemas))}
+	for i, s := range b.Schemas {
+		converted, err := r.schema(s, false)
+		if err != nil {
+			return nil, err
+		}
+		out.Schemas[i] = converted
+	}
+	defs, err := r.schemaMap("$defs", b.Defs)
+	if err != nil {
+		return nil, err
+	}
+	out.Defs = defs
+	return out, nil
+}
+
+func newRetargeter(opts Options) *retargeter {
+	r := &retargeter{
+		target:          opts.Target,
+		unrepresentable: opts.Unrepresentable,
+		memo:            make(map[*lib.Schema]*lib.Schema),
+	}
+	switch r.target {
+	case TargetDraft07:
+		r.defsPrefix = definitionsRefPrefix
+	case TargetOpenAPI30, TargetOpenAPI31:
+		r.defsPrefix = componentsRefPrefix
+	}
+	return r
+}
+
+// unusedDefName returns name, or name with the smallest numeric suffix from 2
+// that defs does not already use.
+func unusedDefName(defs map[string]*lib.Schema, name string) string {
+	candidate := name
+	for i := 2; ; i++ {
+		if _, taken := defs[candidate]; !taken {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+// hoistRoot moves the retargeted root schema into its definitions under name
+// and returns a root that references it. The root keeps $schema and $id, so
+// references into $defs still resolve against the document.
+func hoistRoot(out *lib.Schema, name string) *lib.Schema {
+	def := new(lib.Schema)
+	*def = *out
+	def.Defs = nil
+	def.Schema = ""
+	def.ID = ""
+	root := &lib.Schema{
+		Schema: out.Schema,
+		ID:     out.ID,
+		Ref:    defsRefPrefix + name,
+		Defs:   maps.Clone(out.Defs),
+	}
+	if root.Defs == nil {
+		root.Defs = make(map[string]*lib.Schema, 1)
+	}
+	root.Defs[name] = def
+	return root
+}
+
+// booleanNot returns the subschema of not that makes a schema behave like the
+// boolean schema value.
+func booleanNot(value bool) *lib.Schema {
+	if value {
+		return nil
+	}
+	return &lib.Schema{}
+}
+
+func (r *retargeter) schema(s *lib.Schema, booleanAllowed bool) (*lib.Schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if out, ok := r.memo[s]; ok {
+		return out, nil
+	}
+	if s.Boolean != nil {
+		if r.target != TargetOpenAPI30 || booleanAllowed {
+			return s, nil
+		}
+		// OpenAPI 3.0 has no boolean schemas outside additionalProperties.
+		return &lib.Schema{Not: booleanNot(*s.Boolean)}, nil
+	}
+
+	if keyword, ok := r.unsupportedKeyword(s); ok {
+		if r.unrepresentable == UnrepresentableAny {
+			return &lib.Schema{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s for target %s at %q",
+			ErrUnrepresentableType, keyword, r.target, r.pointer())
+	}
+
+	out := new(lib.Schema)
+	*out = *s
+	out.Extra = maps.Clone(s.Extra)
+	r.memo[s] = out
+	if err := r.children(out); err != nil {
+		return nil, err
+	}
+
+	switch r.target {
+	case TargetDraft07:
+		toDraft07(out)
+	case TargetOpenAPI30:
+		toOpenAPI30(out)
+	}
+	r.rewriteRef(out)
+	if r.target == TargetDraft07 || r.target == TargetOpenAPI30 {
+		out = wrapRefSiblings(out)
+	}
+	return out, nil
+}
+
+// unsupportedKeyword returns the first keyword of s the target cannot
+// express. Under the OpenAPI targets, the # reference to the root schema is
+// one unless the root is hoisted into a definition.
+func (r *retargeter) unsupportedKeyword(s *lib.Schema) (string, bool) {
+	for _, keyword := range unsupportedKeywords[r.target] {
+		if keyword.present(s) {
+			return keyword.keyword, true
+		}
+	}
+	if s.Ref == "#" && r.rootRef == "" && (r.target == TargetOpenAPI30 || r.target == TargetOpenAPI31) {
+		return "$ref", true
+	}
+	return "", false
+}
+
+// children replaces every subschema of out with its retargeted copy.
+func (r *retargeter) children(out *lib.Schema) error {
+	for _, field := range []struct {
+		keyword string
+		schema  **lib.Schema
+		boolean bool
+	}{
+		{"not", &out.Not, false},
+		{"if", &out.If, false},
+		{"then", &out.Then, false},
+		{"else", &out.Else, false},
+		{"items", &out.Items, false},
+		{"contains", &out.Contains, false},
+		{"additionalProperties", &out.AdditionalProperties, true},
+		{"propertyNames", &out.PropertyNames, false},
+		{"unevaluatedItems", &out.UnevaluatedItems, false},
+		{"unevaluatedProperties", &out.UnevaluatedProperties, false},
+		{"contentSchema", &out.ContentSchema, false},
+	} {
+		converted, err := r.at(field.keyword).schema(*field.schema, field.boolean)
+		r.pop(1)
+		if err != nil {
+			return err
+		}
+		*field.schema = converted
+	}
+
+	for _, list := range []struct {
+		keyword string
+		schemas *[]*lib.Schema
+	}{
+		{"allOf", &out.AllOf},
+		{"anyOf", &out.AnyOf},
+		{"oneOf", &out.OneOf},
+		{"prefixItems", &out.PrefixItems},
+	} {
+		if *list.schemas == nil {
+			continue
+		}
+		converted := make([]*lib.Schema, len(*list.schemas))
+		for i, sub := range *list.schemas {
+			var err error
+			converted[i], err = r.at(list.keyword, fmt.Sprint(i)).schema(sub, false)
+			r.pop(2)
+			if err != nil {
+				return err
+			}
+		}
+		*list.schemas = converted
+	}
+
+	for _, keyed := range []struct {
+		keyword string
+		schemas *map[string]*lib.Schema
+	}{
+		{"$defs", &out.Defs},
+		{"dependentSchemas", &out.DependentSchemas},
+	} {
+		converted, err := r.schemaMap(keyed.keyword, *keyed.schemas)
+		if err != nil {
+			return err
+		}
+		*keyed.schemas = converted
+	}
+	for _, keyed := range []struct {
+		keyword string
+		schemas **lib.SchemaMap
+	}{
+		{"properties", &out.Properties},
+		{"patternProperties", &out.PatternProperties},
+	} {
+		if *keyed.schemas == nil {
+			continue
+		}
+		converted, err := r.schemaMap(keyed.keyword, **keyed.schemas)
+		if err != nil {
+			return err
+		}
+		*keyed.schemas = new(lib.SchemaMap(converted))
+	}
+	return nil
+}
+
+func (r *retargeter) schemaMap(keyword string, schemas map[string]*lib.Schema) (map[string]*lib.Schema, error) {
+	if schemas == nil {
+		return nil, nil
+	}
+	converted := make(map[string]*lib.Schema, len(schemas))
+	for _, key := range slices.Sorted(maps.Keys(schemas)) {
+		sub, err := r.at(keyword, key).schema(schemas[key], false)
+		r.pop(2)
+		if err != nil {
+			return nil, err
+		}
+		converted[key] = sub
+	}
+	return converted, nil
+}
+
+func (r *retargeter) at(tokens ...string) *retargeter {
+	r.path = append(r.path, tokens...)
+	return r
+}
+
+func (r *retargeter) pop(n int) {
+	r.path = r.path[:len(r.path)-n]
+}
+
+// pointer returns the RFC 6901 JSON Pointer of the node being rewritten.
+func (r *retargeter) pointer() string {
+	var b strings.Builder
+	for _, token := range r.path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return b.String()
+}
+
+// rewriteRef points local definition references at the target's definition
+// location, and the root reference at the hoisted root.
+func (r *retargeter) rewriteRef(out *lib.Schema) {
+	if out.Ref == "#" && r.rootRef != "" {
+		out.Ref = r.rootRef
+		r.rootReferenced = true
+		return
+	}
+	if name, ok := strings.CutPrefix(out.Ref, defsRefPrefix); ok {
+		out.Ref = r.defsPrefix + name
+	}
+}
+
+// toDraft07 rewrites the Draft 2020-12 keywords of out that Draft-07 spells
+// differently: $defs, prefixItems, and the split dependencies keywords.
+func toDraft07(out *lib.Schema) {
+	if out.Defs != nil {
+		setExtraKeyword(out, "definitions", out.Defs)
+		out.Defs = nil
+	}
+	if len(out.PrefixItems) > 0 {
+		setExtraKeyword(out, "items", out.PrefixItems)
+		if out.Items != nil {
+			setExtraKeyword(out, "additionalItems", out.Items)
+		}
+		out.PrefixItems = nil
+		out.Items = nil
+	}
+	if out.MinContains != nil {
+		// contains always holds with minContains 0, and Draft-07 contains
+		// already means at least one.
+		if *out.MinContains == 0 {
+			out.Contains = nil
+		}
+		out.MinContains = nil
+	}
+	if len(out.DependentRequired) > 0 || len(out.DependentSchemas) > 0 {
+		dependencies := make(map[string]any, len(out.DependentRequired)+len(out.DependentSchemas))
+		for key, required := range out.DependentRequired {
+			dependencies[key] = required
+		}
+		for key, schema := range out.DependentSchemas {
+			if required, ok := out.DependentRequired[key]; ok {
+				schema = &lib.Schema{AllOf: []*lib.Schema{{Required: required}, schema}}
+			}
+			dependencies[key] = schema
+		}
+		setExtraKeyword(out, "dependencies", dependencies)
+		out.DependentRequired = nil
+		out.DependentSchemas = nil
+	}
+}
+
+// toOpenAPI30 rewrites out into an OpenAPI 3.0 Schema Object: null becomes
+// nullable, const becomes a single-value enum, exclusive bounds become the
+// boolean modifiers, and examples becomes example.
+func toOpenAPI30(out *lib.Schema) {
+	out.Schema = ""
+	out.ID = ""
+	out.Comment = ""
+	out.ContentEncoding = nil
+	out.ContentMediaType = nil
+
+	if out.Const != nil && out.Const.IsSet {
+		value := out.Const.Value
+		out.Const = nil
+		if out.Enum == nil {
+			out.Enum = []any{value}
+		} else {
+			out.Enum = slices.DeleteFunc(slices.Clone(out.Enum), func(v any) bool {
+				return !reflect.DeepEqual(v, value)
+			})
+		}
+	}
+	if slices.Contains(out.Enum, nil) {
+		setExtraKeyword(out, "nullable", true)
+	}
+
+	if len(out.Examples) > 0 {
+		setExtraKeyword(out, "example", out.Examples[0])
+		out.Examples = nil
+	}
+
+	exclusiveBound(out, &out.Minimum, &out.ExclusiveMinimum, "exclusiveMinimum", 1)
+	exclusiveBound(out, &out.Maximum, &out.ExclusiveMaximum, "exclusiveMaximum", -1)
+
+	openAPI30Type(out)
+	openAPI30NullableAnyOf(out)
+}
+
+// exclusiveBound folds a numeric exclusive bound into the inclusive bound
+// keyword with the boolean modifier, keeping whichever bound is stricter.
+// sign is 1 for lower bounds and -1 for upper bounds.
+func exclusiveBound(out *lib.Schema, inclusive, exclusive **lib.Rat, keyword string, sign int) {
+	if *exclusive == nil {
+		return
+	}
+	if *inclusive == nil || (*exclusive).Cmp((*inclusive).Rat)*sign >= 0 {
+		*inclusive = *exclusive
+		setExtraKeyword(out, keyword, true)
+	}
+	*exclusive = nil
+}
+
+// openAPI30Type reduces a type array to the single type OpenAPI 3.0 allows,
+// expressing null as nullable and several types as anyOf.
+func openAPI30Type(out *lib.Schema) {
+	if len(out.Type) == 0 || (len(out.Type) == 1 && out.Type[0] != "null") {
+		return
+	}
+	types := slices.DeleteFunc(slices.Clone(out.Type), func(t string) bool { return t == "null" })
+	if len(types) < len(out.Type) {
+		setExtraKeyword(out, "nullable", true)
+	}
+	switch len(types) {
+	case 0:
+		out.Type = nil
+		out.Enum = []any{nil}
+	case 1:
+		out.Type = types
+	default:
+		out.Type = nil
+		branches := make([]*lib.Schema, len(types))
+		for i, t := range types {
+			branches[i] = &lib.Schema{Type: lib.SchemaType{t}}
+		}
+		out.AllOf = append(out.AllOf, &lib.Schema{AnyOf: branches})
+	}
+}
+
+// openAPI30NullableAnyOf folds anyOf [schema, null], the export of a nilable
+// schema, into schema with nullable: true.
+func openAPI30NullableAnyOf(out *lib.Schema) {
+	if len(out.AnyOf) != 2 {
+		return
+	}
+	var inner *lib.Schema
+	for i, branch := range out.AnyOf {
+		if isOpenAPI30Null(branch) {
+			inner = out.AnyOf[1-i]
+		}
+	}
+	if inner == nil || isOpenAPI30Null(inner) {
+		return
+	}
+
+	annotations := *out
+	annotations.AnyOf = nil
+	annotations.Extra = nil
+	annotations.Title, annotations.Description, annotations.Default = nil, nil, nil
+	annotations.ReadOnly, annotations.WriteOnly, annotations.Deprecated = nil, nil, nil
+	if inner.Ref != "" || inner.Boolean != nil || !reflect.ValueOf(annotations).IsZero() {
+		out.AnyOf = nil
+		out.AllOf = append(out.AllOf, inner)
+		setExtraKeyword(out, "nullable", true)
+		return
+	}
+
+	merged := *inner
+	merged.Extra = maps.Clone(inner.Extra)
+	for key, value := range out.Extra {
+		setExtraKeyword(&merged, key, value)
+	}
+	if out.Title != nil {
+		merged.Title = out.Title
+	}
+	if out.Description != nil {
+		merged.Description = out.Description
+	}
+	if out.Default != nil {
+		merged.Default = out.Default
+	}
+	if out.ReadOnly != nil {
+		merged.ReadOnly = out.ReadOnly
+	}
+	if out.WriteOnly != nil {
+		merged.WriteOnly = out.WriteOnly
+	}
+	if out.Deprecated != nil {
+		merged.Deprecated = out.Deprecated
+	}
+	if len(merged.Enum) > 0 && !slices.Contains(merged.Enum, nil) {
+		merged.Enum = append(slices.Clone(merged.Enum), nil)
+	}
+	setExtraKeyword(&merged, "nullable", true)
+	*out = merged
+}
+
+// isOpenAPI30Null reports whether s is the rewritten null schema.
+func isOpenAPI30Null(s *lib.Schema) bool {
+	return s != nil && len(s.Type) == 0 && len(s.Enum) == 1 && s.Enum[0] == nil &&
+		len(s.Extra) == 1 && s.Extra["nullable"] == true
+}
+
+// wrapRefSiblings moves the keywords next to $ref into an allOf, since
+// Draft-07 and OpenAPI 3.0 ignore the siblings of $ref.
+func wrapRefSiblings(out *lib.Schema) *lib.Schema {
+	if out.Ref == "" {
+		return out
+	}
+	siblings := *out
+	siblings.Ref = ""
+	if reflect.ValueOf(siblings).IsZero() {
+		return out
+	}
+	siblings.AllOf = append([]*lib.Schema{{Ref: out.Ref}}, siblings.AllOf...)
+	*out = siblings
+	return out
+}
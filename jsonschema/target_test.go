@@ -0,0 +1,263 @@
+package jsonschema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-json-experiment/json"
+	lib "github.com/kaptinlin/jsonschema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/types"
+)
+
+func exportTarget(t *testing.T, schema core.ZodSchema, opts Options) string {
+	t.Helper()
+	exported, err := ToJSONSchema(schema, opts)
+	require.NoError(t, err)
+	data, err := json.Marshal(exported)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// assertRefsResolve requires every local $ref in the JSON document doc to
+// resolve to a node of doc.
+func assertRefsResolve(t *testing.T, doc string) {
+	t.Helper()
+	var root any
+	require.NoError(t, json.Unmarshal([]byte(doc), &root))
+	var walk func(node any)
+	walk = func(node any) {
+		switch node := node.(type) {
+		case map[string]any:
+			if ref, ok := node["$ref"].(string); ok {
+				pointer, local := strings.CutPrefix(ref, "#")
+				require.True(t, local, "$ref %q is not local", ref)
+				target := root
+				for token := range strings.SplitSeq(strings.TrimPrefix(pointer, "/"), "/") {
+					if token == "" {
+						continue
+					}
+					token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+					object, ok := target.(map[string]any)
+					require.True(t, ok, "$ref %q does not resolve", ref)
+					target, ok = object[token]
+					require.True(t, ok, "$ref %q does not resolve", ref)
+				}
+			}
+			for _, child := range node {
+				walk(child)
+			}
+		case []any:
+			for _, child := range node {
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+}
+
+func TestToJSONSchema_TargetDraft07(t *testing.T) {
+	t.Run("tuples use items arrays", func(t *testing.T) {
+		schema := types.TupleWithRest([]core.ZodSchema{types.String()}, types.Bool())
+		assert.JSONEq(t, `{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"type": "array",
+			"items": [{"type": "string"}],
+			"additionalItems": {"type": "boolean"}
+		}`, exportTarget(t, schema, Options{Target: TargetDraft07}))
+	})
+
+	t.Run("definitions and dependencies", func(t *testing.T) {
+		name := types.String().Min(1).Meta(core.GlobalMeta{ID: "name"})
+		schema := types.Object(core.ObjectSchema{
+			"name":  name.Optional(),
+			"alias": name.Optional(),
+		}).DependentRequired(map[string][]string{"alias": {"name"}})
+		assert.JSONEq(t, `{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"type": "object",
+			"properties": {
+				"alias": {"$ref": "#/definitions/name"},
+				"name": {"$ref": "#/definitions/name"}
+			},
+			"additionalProperties": false,
+			"dependencies": {"alias": ["name"]},
+			"definitions": {"name": {"type": "string", "minLength": 1}}
+		}`, exportTarget(t, schema, Options{Target: TargetDraft07}))
+	})
+
+	t.Run("validates like the source schema", func(t *testing.T) {
+		schema := types.Object(core.ObjectSchema{
+			"pair":  types.Tuple(types.String(), types.Int()),
+			"alias": types.String().Optional(),
+			"name":  types.String().Meta(core.GlobalMeta{ID: "name"}).Optional(),
+		}).DependentRequired(map[string][]string{"alias": {"name"}})
+		compiled, err := lib.NewCompiler().Compile([]byte(exportTarget(t, schema, Options{Target: TargetDraft07})))
+		require.NoError(t, err)
+
+		for _, input := range []any{
+			map[string]any{"pair": []any{"a", 1}},
+			map[string]any{"pair": []any{"a", 1, true}},
+			map[string]any{"pair": []any{1, "a"}},
+			map[string]any{"pair": []any{"a", 1}, "alias": "b"},
+			map[string]any{"pair": []any{"a", 1}, "alias": "b", "name": "c"},
+			map[string]any{"pair": []any{"a", 1}, "name": 3},
+		} {
+			_, parseErr := schema.ParseAny(input)
+			assert.Equal(t, parseErr == nil, compiled.Validate(input).IsValid(), "input %#v", input)
+		}
+	})
+
+	t.Run("unevaluated keywords are unrepresentable", func(t *testing.T) {
+		schema := types.Object(core.ObjectSchema{
+			"nested": types.Intersection(types.Object(core.ObjectSchema{}), types.Unknown()).Unevaluated(types.Never()),
+		})
+		_, err := ToJSONSchema(schema, Options{Target: TargetDraft07})
+		require.ErrorIs(t, err, ErrUnrepresentableType)
+		assert.ErrorContains(t, err, `unevaluatedProperties for target draft-07 at "/properties/nested"`)
+
+		assert.JSONEq(t, `{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"type": "object",
+			"properties": {"nested": {}},
+			"required": ["nested"],
+			"additionalProperties": false
+		}`, exportTarget(t, schema, Options{Target: TargetDraft07, Unrepresentable: UnrepresentableAny}))
+	})
+}
+
+func TestToJSONSchema_TargetOpenAPI30(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   core.ZodSchema
+		expected string
+	}{
+		{
+			name:     "nilable becomes nullable",
+			schema:   types.String().Min(2).Nilable(),
+			expected: `{"type": "string", "minLength": 2, "nullable": true}`,
+		},
+		{
+			name:     "nilable enum admits null",
+			schema:   types.Enum("a", "b").Nilable(),
+			expected: `{"type": "string", "enum": ["a", "b", null], "nullable": true}`,
+		},
+		{
+			name:     "const becomes enum",
+			schema:   types.Literal("on"),
+			expected: `{"type": "string", "enum": ["on"]}`,
+		},
+		{
+			name:     "exclusive bounds become boolean modifiers",
+			schema:   types.Float64().Gt(0).Lt(10),
+			expected: `{"type": "number", "minimum": 0, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": true}`,
+		},
+		{
+			name:   "nilable reference moves into allOf",
+			schema: types.Object(core.ObjectSchema{"name": types.String().Meta(core.GlobalMeta{ID: "name"}).Nilable()}),
+			expected: `{
+				"type": "object",
+				"properties": {"name": {"allOf": [{"$ref": "#/$defs/name"}], "nullable": true}},
+				"required": ["name"],
+				"additionalProperties": false,
+				"$defs": {"name": {"type": "string"}}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.JSONEq(t, tt.expected, exportTarget(t, tt.schema, Options{Target: TargetOpenAPI30}))
+		})
+	}
+
+	t.Run("tuples are unrepresentable", func(t *testing.T) {
+		_, err := ToJSONSchema(types.Tuple(types.String()), Options{Target: TargetOpenAPI30})
+		require.ErrorIs(t, err, ErrUnrepresentableType)
+		assert.ErrorContains(t, err, "prefixItems for target openapi-3.0")
+
+		assert.JSONEq(t, `{}`, exportTarget(t, types.Tuple(types.String()), Options{
+			Target:          TargetOpenAPI30,
+			Unrepresentable: UnrepresentableAny,
+		}))
+	})
+}
+
+func TestToJSONSchema_TargetOpenAPI31(t *testing.T) {
+	schema := types.Object(core.ObjectSchema{
+		"name": types.String().Meta(core.GlobalMeta{ID: "name"}).Nilable(),
+		"pair": types.Tuple(types.String(), types.Literal(1)),
+	})
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"name": {"anyOf": [{"$ref": "#/$defs/name"}, {"type": "null"}]},
+			"pair": {"type": "array", "prefixItems": [{"type": "string"}, {"type": "number", "const": 1}], "minItems": 2, "maxItems": 2}
+		},
+		"required": ["pair", "name"],
+		"additionalProperties": false,
+		"$defs": {"name": {"type": "string"}}
+	}`, exportTarget(t, schema, Options{Target: TargetOpenAPI31}))
+}
+
+func TestToJSONSchema_TargetDoesNotMutateDraft202012Export(t *testing.T) {
+	schema := types.String().Nilable()
+	before := exportTarget(t, schema, Options{})
+	exportTarget(t, schema, Options{Target: TargetOpenAPI30})
+	assert.JSONEq(t, before, exportTarget(t, schema, Options{}))
+}
+
+func TestToJSONSchema_TargetReferencesResolve(t *testing.T) {
+	type Category struct {
+		Name          string     `json:"name"`
+		Subcategories []Category `json:"subcategories"`
+	}
+	var category core.ZodSchema
+	category = types.Struct[Category](core.StructSchema{
+		"name": types.String().Meta(core.GlobalMeta{ID: "name"}).Nilable(),
+		"subcategories": types.Slice[Category](types.LazyAny(func() any {
+			return category
+		})),
+	})
+
+	for _, target := range []Target{TargetDraft202012, TargetDraft07, TargetOpenAPI30, TargetOpenAPI31} {
+		t.Run(string(target), func(t *testing.T) {
+			assertRefsResolve(t, exportTarget(t, category, Options{Target: target}))
+		})
+	}
+
+	t.Run("openapi hoists a self-referencing root", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"$ref": "#/$defs/root",
+			"$defs": {
+				"name": {"type": "string"},
+				"root": {
+					"type": "object",
+					"properties": {
+						"name": {"anyOf": [{"$ref": "#/$defs/name"}, {"type": "null"}]},
+						"subcategories": {"type": "array", "items": {"$ref": "#/$defs/root"}}
+					},
+					"required": ["subcategories", "name"],
+					"additionalProperties": false
+				}
+			}
+		}`, exportTarget(t, category, Options{Target: TargetOpenAPI31}))
+	})
+
+	t.Run("the hoisted root avoids taken names", func(t *testing.T) {
+		var tree core.ZodSchema
+		tree = types.Object(core.ObjectSchema{
+			"root": types.String().Meta(core.GlobalMeta{ID: "root"}),
+			"children": types.Slice[any](types.LazyAny(func() any {
+				return tree
+			})),
+		})
+		exported := exportTarget(t, tree, Options{Target: TargetOpenAPI30})
+		assertRefsResolve(t, exported)
+		assert.Contains(t, exported, `"$ref":"#/$defs/root2"`)
+	})
+}
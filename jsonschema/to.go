@@ -26,6 +26,8 @@ var (
 	ErrUnionInvalid                  = errors.New("schema is not a union type with Options method")
 	ErrUnionNoMembers                = errors.New("union has no member schemas")
 	ErrIntersectionInvalid           = errors.New("schema is not an intersection type")
+	ErrWhenInvalid                   = errors.New("schema is not a conditional type")
+	ErrNotInvalid                    = errors.New("schema is not a negation type")
 	ErrInvalidEnumSchema             = errors.New("invalid enum schema")
 	ErrEnumExtractValues             = errors.New("unable to extract enum values")
 	ErrLiteralNoValuesMethod         = errors.New("schema does not have a Values method")
@@ -103,6 +105,11 @@ type Options struct {
 	// IO specifies whether to convert the "input" or "output" schema.
 	// "output" (default) or "input".
 	IO IOMode
+
+	// Target selects the emitted dialect:
+	// "draft-2020-12" (default), "draft-07", "openapi-3.0", or "openapi-3.1".
+	// Keywords a target cannot express are handled per Unrepresentable.
+	Target Target
 }
 
 // ToJSONSchema converts a GoZod schema into a JSON Schema instance.
@@ -166,6 +173,15 @@ func normalizeOptions(opts Options) (Options, error) {
 		return opts, fmt.Errorf("%w: IO=%q", ErrInvalidJSONSchemaOption, opts.IO)
 	}
 
+	if opts.Target == "" {
+		opts.Target = TargetDraft202012
+	}
+	switch opts.Target {
+	case TargetDraft202012, TargetDraft07, TargetOpenAPI30, TargetOpenAPI31:
+	default:
+		return opts, fmt.Errorf("%w: Target=%q", ErrInvalidJSONSchemaOption, opts.Target)
+	}
+
 	return opts, nil
 }
 
@@ -186,7 +202,7 @@ func toJSONSchemaSingle(schema core.ZodSchema, opts Options) (*lib.Schema, error
 		}
 	}
 
-	return s, nil
+	return retarget(s, opts)
 }
 
 type registryEntry struct {
@@ -196,6 +212,19 @@ type registryEntry struct {
 
 // toJSONSchemaRegistry handles the conversion of a schema Registry.
 func toJSONSchemaRegistry(reg *core.Registry[core.GlobalMeta], opts Options) (*lib.Schema, error) {
+	c, err := convertRegistry(reg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a root schema to hold all definitions.
+	rootSchema := &lib.Schema{Defs: c.copyDefs()}
+	return retarget(rootSchema, opts)
+}
+
+// convertRegistry converts every registry entry with one converter, leaving
+// the registry schemas in the converter's definitions.
+func convertRegistry(reg *core.Registry[core.GlobalMeta], opts Options) (*converter, error) {
 	var entries []registryEntry
 	reg.Range(func(schema core.ZodSchema, meta core.GlobalMeta) bool {
 		meta = cloneutil.Clone(meta).(core.GlobalMeta)
@@ -228,17 +257,60 @@ func toJSONSchemaRegistry(reg *core.Registry[core.GlobalMeta], opts Options) (*l
 			return nil, err
 		}
 	}
+	return c, nil
+}
 
-	// Create a root schema to hold all definitions.
-	rootSchema := &lib.Schema{}
-	if len(c.defs) > 0 {
-		rootSchema.Defs = make(map[string]*lib.Schema, len(c.defs))
-		for _, key := range slices.Sorted(maps.Keys(c.defs)) {
-			rootSchema.Defs[key] = c.defs[key]
+// Bundle holds schemas converted in the same pass as a registry.
+type Bundle struct {
+	// Schemas holds one converted schema per input schema, in input order.
+	Schemas []*lib.Schema
+	// Defs holds the registry schemas and every other hoisted definition.
+	// References in Schemas and Defs point at the target's definition
+	// location, so the caller places Defs there.
+	Defs map[string]*lib.Schema
+}
+
+// ToJSONSchemaBundle converts schemas together with a registry, so each
+// registered schema is emitted once in Defs and referenced everywhere else.
+func ToJSONSchemaBundle(
+	registry *core.Registry[core.GlobalMeta],
+	schemas []core.ZodSchema,
+	opts ...Options,
+) (*Bundle, error) {
+	if registry == nil {
+		return nil, fmt.Errorf("registry is nil: %w", ErrInvalidRegistrySchemaID)
+	}
+	options, err := optionsFrom(opts)
+	if err != nil {
+		return nil, err
+	}
+	c, err := convertRegistry(registry, options)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{Schemas: make([]*lib.Schema, len(schemas))}
+	for i, schema := range schemas {
+		converted, err := c.convert(schema)
+		if err != nil {
+			return nil, err
 		}
+		bundle.Schemas[i] = converted
 	}
+	bundle.Defs = c.copyDefs()
+	return retargetBundle(bundle, options)
+}
 
-	return rootSchema, nil
+// copyDefs returns a copy of the collected definitions, or nil when there are none.
+func (c *converter) copyDefs() map[string]*lib.Schema {
+	if len(c.defs) == 0 {
+		return nil
+	}
+	defs := make(map[string]*lib.Schema, len(c.defs))
+	for _, key := range slices.Sorted(maps.Keys(c.defs)) {
+		defs[key] = c.defs[key]
+	}
+	return defs
 }
 
 // converter holds the state for a single conversion run.
@@ -370,6 +442,9 @@ func (c *converter) convert(schema core.ZodSchema) (*lib.Schema, error) {
 
 	// Attach metadata (title, description, examples) if available
 	c.applyMeta(schema, finalSchema)
+	if internals.IsReadonly() && finalSchema != nil {
+		finalSchema.ReadOnly = new(true)
+	}
 
 	// Ensure a definition is registered **before** any placeholder replacement so that future
 	// conversions (especially wrappers / lazy) can immediately resolve a $ref.
@@ -493,8 +568,8 @@ func (c *converter) doConvert(schema core.ZodSchema) (*lib.Schema, error) {
 		jsonSchema, err = c.convertUnion(schema)
 	case core.ZodTypeXor:
 		jsonSchema, err = c.convertXor(schema)
-	case core.ZodTypePipe, core.ZodTypePipeline:
-		// Handle pipeline schemas differently depending on IO mode.
+	case core.ZodTypePipe, core.ZodTypePipeline, core.ZodTypeCodec:
+		// Handle pipeline and codec schemas differently depending on IO mode.
 		if c.opts.IO == "input" {
 			if inp, ok := schema.(interface{ Inner() core.ZodSchema }); ok {
 				return c.convert(inp.Inner())
@@ -527,6 +602,10 @@ func (c *converter) doConvert(schema core.ZodSchema) (*lib.Schema, error) {
 		jsonSchema, err = c.convertDiscriminatedUnion(schema)
 	case core.ZodTypeIntersection:
 		jsonSchema, err = c.convertIntersection(schema)
+	case core.ZodTypeWhen:
+		jsonSchema, err = c.convertWhen(schema)
+	case core.ZodTypeNot:
+		jsonSchema, err = c.convertNot(schema)
 	case core.ZodTypeRecord:
 		jsonSchema, err = c.convertRecord(schema)
 	case core.ZodTypeObject, core.ZodTypeStruct:
@@ -567,7 +646,7 @@ func (c *converter) doConvert(schema core.ZodSchema) (*lib.Schema, error) {
 		jsonSchema = &lib.Schema{Type: []string{"string"}, Format: new("time")}
 	case core.ZodTypeISODuration:
 		jsonSchema = &lib.Schema{Type: []string{"string"}, Format: new("duration")}
-	case core.ZodTypeOptional, core.ZodTypeNilable, core.ZodTypeDefault, core.ZodTypePrefault, core.ZodTypeRefine, core.ZodTypeCheck:
+	case core.ZodTypeOptional, core.ZodTypeNilable, core.ZodTypeDefault, core.ZodTypePrefault, core.ZodTypeBrand, core.ZodTypeRefine, core.ZodTypeCheck:
 		if s, ok := schema.(interface{ Inner() core.ZodSchema }); ok {
 			return c.convert(s.Inner())
 		}
@@ -603,6 +682,13 @@ type catchaller interface {
 	Catchall() core.ZodSchema
 }
 
+// dependencyHolder is an interface for object and struct schemas with
+// dependentRequired and dependentSchemas entries.
+type dependencyHolder interface {
+	RequiredDependencies() map[string][]string
+	SchemaDependencies() map[string]core.ZodSchema
+}
+
 // unknownKeysHandler is an interface for schemas that handle unknown keys.
 // We use a generic method signature to avoid circular imports.
 type unknownKeysHandler interface {
@@ -711,9 +797,74 @@ func (c *converter) convertObjectFromShape(schema core.ZodSchema, shape core.Obj
 		jsonSchema.AdditionalProperties = booleanSchema(resolveUnknownKeysMode(schema))
 	}
 
+	if err := c.applyObjectKeySchemas(jsonSchema, schema); err != nil {
+		return nil, err
+	}
+	if err := c.applyObjectDependencies(jsonSchema, schema); err != nil {
+		return nil, err
+	}
 	return jsonSchema, nil
 }
 
+// applyObjectDependencies exports object and struct dependencies as
+// dependentRequired and dependentSchemas.
+func (c *converter) applyObjectDependencies(jsonSchema *lib.Schema, schema core.ZodSchema) error {
+	s, ok := schema.(dependencyHolder)
+	if !ok {
+		return nil
+	}
+	if deps := s.RequiredDependencies(); len(deps) > 0 {
+		jsonSchema.DependentRequired = deps
+	}
+	deps := s.SchemaDependencies()
+	for _, key := range slices.Sorted(maps.Keys(deps)) {
+		c.path = append(c.path, "dependentSchemas", key)
+		converted, err := c.convert(deps[key])
+		c.path = c.path[:len(c.path)-2]
+		if err != nil {
+			return err
+		}
+		if jsonSchema.DependentSchemas == nil {
+			jsonSchema.DependentSchemas = make(map[string]*lib.Schema, len(deps))
+		}
+		jsonSchema.DependentSchemas[key] = converted
+	}
+	return nil
+}
+
+// applyObjectKeySchemas exports object pattern properties as
+// patternProperties and the key schema as propertyNames.
+func (c *converter) applyObjectKeySchemas(jsonSchema *lib.Schema, schema core.ZodSchema) error {
+	if s, ok := schema.(interface{ Patterns() []types.ObjectPattern }); ok {
+		if patterns := s.Patterns(); len(patterns) > 0 {
+			patternProps := make(lib.SchemaMap, len(patterns))
+			for _, pattern := range patterns {
+				source := pattern.Pattern.String()
+				c.path = append(c.path, "patternProperties", source)
+				converted, err := c.convert(pattern.Schema)
+				c.path = c.path[:len(c.path)-2]
+				if err != nil {
+					return err
+				}
+				patternProps[source] = converted
+			}
+			jsonSchema.PatternProperties = &patternProps
+		}
+	}
+	if s, ok := schema.(interface{ PropertyNamesSchema() core.ZodSchema }); ok {
+		if keySchema := s.PropertyNamesSchema(); keySchema != nil {
+			c.path = append(c.path, "propertyNames")
+			converted, err := c.convert(keySchema)
+			c.path = c.path[:len(c.path)-1]
+			if err != nil {
+				return err
+			}
+			jsonSchema.PropertyNames = converted
+		}
+	}
+	return nil
+}
+
 func (c *converter) convertArray(schema core.ZodSchema) (*lib.Schema, error) {
 	jsonSchema := &lib.Schema{Type: []string{"array"}}
 
@@ -766,9 +917,58 @@ func (c *converter) convertArray(schema core.ZodSchema) (*lib.Schema, error) {
 		return nil, ErrUnhandledArrayLike
 	}
 
+	if err := c.applyArrayChecks(jsonSchema, schema.Internals().Checks); err != nil {
+		return nil, err
+	}
 	return jsonSchema, nil
 }
 
+// applyArrayChecks exports Unique checks as uniqueItems and Contains checks as
+// contains/minContains/maxContains. Additional Contains checks are conjoined
+// through allOf, since a schema carries a single contains keyword. UniqueBy
+// keys are Go functions and are not exported.
+func (c *converter) applyArrayChecks(jsonSchema *lib.Schema, checks []core.ZodCheck) error {
+	for _, check := range checks {
+		ci := check.Zod()
+		if ci == nil || ci.Def == nil {
+			continue
+		}
+		if ci.Def.Check == "unique" {
+			if _, keyed := ci.Def.Params["key"]; !keyed {
+				jsonSchema.UniqueItems = new(true)
+			}
+			continue
+		}
+		if ci.Def.Check != "contains" {
+			continue
+		}
+		inner, ok := ci.Def.Params["schema"].(core.ZodSchema)
+		if !ok {
+			continue
+		}
+		c.path = append(c.path, "contains")
+		converted, err := c.convert(inner)
+		c.path = c.path[:len(c.path)-1]
+		if err != nil {
+			return err
+		}
+
+		target := jsonSchema
+		if jsonSchema.Contains != nil {
+			target = &lib.Schema{}
+			jsonSchema.AllOf = append(jsonSchema.AllOf, target)
+		}
+		target.Contains = converted
+		if minimum, ok := ci.Def.Params["minimum"].(int); ok && minimum != 1 {
+			target.MinContains = new(float64(minimum))
+		}
+		if maximum, ok := ci.Def.Params["maximum"].(int); ok {
+			target.MaxContains = new(float64(maximum))
+		}
+	}
+	return nil
+}
+
 // convertTuple handles ZodTuple -> JSON Schema array with prefixItems
 func (c *converter) convertTuple(schema core.ZodSchema) (*lib.Schema, error) {
 	tupleSchema, ok := schema.(interface {
@@ -821,6 +1021,9 @@ func (c *converter) convertTuple(schema core.ZodSchema) (*lib.Schema, error) {
 		jsonSchema.MaxItems = new(float64(len(items)))
 	}
 
+	if err := c.applyArrayChecks(jsonSchema, schema.Internals().Checks); err != nil {
+		return nil, err
+	}
 	return jsonSchema, nil
 }
 
@@ -982,11 +1185,15 @@ func (c *converter) convertUnion(schema core.ZodSchema) (*lib.Schema, error) {
 		if err != nil {
 			return nil, err
 		}
-		anyOf = append(anyOf, s)
+		anyOf = append(anyOf, c.openBranch(schema, mem, s))
 		c.path = c.path[:len(c.path)-1]
 	}
 
-	return &lib.Schema{AnyOf: anyOf}, nil
+	jsonSchema := &lib.Schema{AnyOf: anyOf}
+	if err := c.applyUnevaluated(jsonSchema, schema); err != nil {
+		return nil, err
+	}
+	return jsonSchema, nil
 }
 
 // convertXor handles ZodXor -> JSON Schema oneOf (exclusive union - exactly one must match)
@@ -1008,11 +1215,15 @@ func (c *converter) convertXor(schema core.ZodSchema) (*lib.Schema, error) {
 		if err != nil {
 			return nil, err
 		}
-		oneOf = append(oneOf, converted)
+		oneOf = append(oneOf, c.openBranch(schema, opt, converted))
 		c.path = c.path[:len(c.path)-1]
 	}
 
-	return &lib.Schema{OneOf: oneOf}, nil
+	jsonSchema := &lib.Schema{OneOf: oneOf}
+	if err := c.applyUnevaluated(jsonSchema, schema); err != nil {
+		return nil, err
+	}
+	return jsonSchema, nil
 }
 
 // isNullSchema checks if a schema represents null/nil type.
@@ -1038,7 +1249,137 @@ func (c *converter) convertIntersection(schema core.ZodSchema) (*lib.Schema, err
 		return nil, err
 	}
 
-	return &lib.Schema{AllOf: []*lib.Schema{leftSchema, rightSchema}}, nil
+	jsonSchema := &lib.Schema{AllOf: []*lib.Schema{
+		c.openBranch(schema, inter.Left(), leftSchema),
+		c.openBranch(schema, inter.Right(), rightSchema),
+	}}
+	if err := c.applyUnevaluated(jsonSchema, schema); err != nil {
+		return nil, err
+	}
+	return jsonSchema, nil
+}
+
+// unevaluatedHolder is an interface for composites closed over the keys and
+// items their branches did not evaluate.
+type unevaluatedHolder interface {
+	UnevaluatedPropertiesSchema() core.ZodSchema
+	UnevaluatedItemsSchema() core.ZodSchema
+}
+
+// applyUnevaluated exports the unevaluated schemas of a composite as
+// unevaluatedProperties and unevaluatedItems.
+func (c *converter) applyUnevaluated(jsonSchema *lib.Schema, schema core.ZodSchema) error {
+	u, ok := schema.(unevaluatedHolder)
+	if !ok {
+		return nil
+	}
+	for _, keyword := range []struct {
+		name   string
+		schema core.ZodSchema
+		target **lib.Schema
+	}{
+		{"unevaluatedProperties", u.UnevaluatedPropertiesSchema(), &jsonSchema.UnevaluatedProperties},
+		{"unevaluatedItems", u.UnevaluatedItemsSchema(), &jsonSchema.UnevaluatedItems},
+	} {
+		if keyword.schema == nil {
+			continue
+		}
+		c.path = append(c.path, keyword.name)
+		converted, err := c.convert(keyword.schema)
+		c.path = c.path[:len(c.path)-1]
+		if err != nil {
+			return err
+		}
+		*keyword.target = converted
+	}
+	return nil
+}
+
+// openBranch drops the additionalProperties: false a strip-mode object
+// branch exports when its composite has unevaluatedProperties. A strip
+// object only evaluates its own keys, so the keys of the other branches must
+// reach unevaluatedProperties instead of being rejected by the branch.
+func (c *converter) openBranch(composite, branch core.ZodSchema, converted *lib.Schema) *lib.Schema {
+	u, ok := composite.(unevaluatedHolder)
+	if !ok || u.UnevaluatedPropertiesSchema() == nil {
+		return converted
+	}
+	return openStripObject(branch, converted)
+}
+
+// openStripObject drops the additionalProperties: false that converted, the
+// export of the strip-mode object branch, carries. A strip object accepts
+// unknown keys, so the exported branch must too.
+func openStripObject(branch core.ZodSchema, converted *lib.Schema) *lib.Schema {
+	if converted == nil || converted.Ref != "" {
+		return converted
+	}
+	uk, ok := branch.(interface{ UnknownKeys() types.ObjectMode })
+	if !ok || uk.UnknownKeys() != types.ObjectModeStrip {
+		return converted
+	}
+	if s, ok := branch.(catchaller); ok && s.Catchall() != nil {
+		return converted
+	}
+	ap := converted.AdditionalProperties
+	if ap == nil || ap.Boolean == nil || *ap.Boolean {
+		return converted
+	}
+	opened := *converted
+	opened.AdditionalProperties = nil
+	return &opened
+}
+
+// convertWhen handles ZodWhen -> JSON Schema if/then/else
+func (c *converter) convertWhen(schema core.ZodSchema) (*lib.Schema, error) {
+	when, ok := schema.(interface {
+		Condition() core.ZodSchema
+		Then() core.ZodSchema
+		Else() core.ZodSchema
+	})
+	if !ok {
+		return nil, ErrWhenInvalid
+	}
+
+	jsonSchema := &lib.Schema{}
+	for _, branch := range []struct {
+		keyword string
+		schema  core.ZodSchema
+		target  **lib.Schema
+	}{
+		{"if", when.Condition(), &jsonSchema.If},
+		{"then", when.Then(), &jsonSchema.Then},
+		{"else", when.Else(), &jsonSchema.Else},
+	} {
+		if branch.schema == nil {
+			continue
+		}
+		c.path = append(c.path, branch.keyword)
+		converted, err := c.convert(branch.schema)
+		if err != nil {
+			return nil, err
+		}
+		// Every branch sees the whole instance, keys of the others included.
+		*branch.target = openStripObject(branch.schema, converted)
+		c.path = c.path[:len(c.path)-1]
+	}
+	return jsonSchema, nil
+}
+
+// convertNot handles ZodNot -> JSON Schema not
+func (c *converter) convertNot(schema core.ZodSchema) (*lib.Schema, error) {
+	not, ok := schema.(interface{ Inner() core.ZodSchema })
+	if !ok {
+		return nil, ErrNotInvalid
+	}
+
+	c.path = append(c.path, "not")
+	inner, err := c.convert(not.Inner())
+	if err != nil {
+		return nil, err
+	}
+	c.path = c.path[:len(c.path)-1]
+	return &lib.Schema{Not: inner}, nil
 }
 
 // convertRecord handles ZodRecord -> JSON Schema object with additionalProperties
@@ -3,6 +3,7 @@ package jsonschema
 import (
 	"maps"
 	"math"
+	"time"
 
 	lib "github.com/kaptinlin/jsonschema"
 
@@ -186,10 +187,22 @@ func (c *converter) applyBag(js *lib.Schema, bag map[string]any) {
 			if mimes, ok := v.([]string); ok && len(mimes) == 1 {
 				js.ContentMediaType = new(mimes[0])
 			}
+		case "formatMinimum", "formatMaximum", "formatExclusiveMinimum", "formatExclusiveMaximum":
+			if t, ok := v.(time.Time); ok {
+				setExtraKeyword(js, k, t.Format(time.RFC3339Nano))
+			}
 		}
 	}
 }
 
+// setExtraKeyword records an annotation keyword that lib.Schema has no field for.
+func setExtraKeyword(js *lib.Schema, key string, value any) {
+	if js.Extra == nil {
+		js.Extra = make(map[string]any)
+	}
+	js.Extra[key] = value
+}
+
 // toFloat converts numeric types to float64.
 func toFloat(v any) (float64, bool) {
 	switch x := v.(type) {
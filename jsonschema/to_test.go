@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-json-experiment/json"
 	lib "github.com/kaptinlin/jsonschema"
@@ -249,6 +250,7 @@ func TestToJSONSchema_OptionValidation(t *testing.T) {
 			Cycles:          CyclesRef,
 			Reused:          ReusedInline,
 			IO:              IOOutput,
+			Target:          TargetDraft202012,
 		})
 		require.NoError(t, err)
 		require.NotNil(t, got)
@@ -286,6 +288,12 @@ func TestToJSONSchema_OptionValidation(t *testing.T) {
 			wantErr:  ErrInvalidJSONSchemaOption,
 			contains: "IO",
 		},
+		{
+			name:     "invalid target",
+			options:  Options{Target: "draft-04"},
+			wantErr:  ErrInvalidJSONSchemaOption,
+			contains: "Target",
+		},
 	}
 
 	for _, tt := range tests {
@@ -971,6 +979,33 @@ func TestToJSONSchema_NumberConstraints(t *testing.T) {
 	}
 }
 
+func TestToJSONSchema_TimeRangeConstraints(t *testing.T) {
+	lower := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	upper := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		schema   core.ZodSchema
+		expected string
+	}{
+		{"After", types.Time().After(lower), `{"type":"string","format":"time","formatExclusiveMinimum":"2020-01-01T00:00:00Z"}`},
+		{"Before", types.TimePtr().Before(upper), `{"type":"string","format":"time","formatExclusiveMaximum":"2030-01-01T00:00:00Z"}`},
+		{"Between", types.Time().Between(lower, upper), `{"type":"string","format":"time","formatMinimum":"2020-01-01T00:00:00Z","formatMaximum":"2030-01-01T00:00:00Z"}`},
+		{"StricterBoundWins", types.Time().After(lower).Between(lower.AddDate(1, 0, 0), upper), `{"type":"string","format":"time","formatMinimum":"2021-01-01T00:00:00Z","formatMaximum":"2030-01-01T00:00:00Z"}`},
+		{"PastHasNoStaticBound", types.Time().Past(), `{"type":"string","format":"time"}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			js, err := ToJSONSchema(c.schema)
+			require.NoError(t, err)
+			jsonSchemaBytes, err := json.Marshal(js)
+			require.NoError(t, err)
+			assertJSONEquals(t, c.expected, string(jsonSchemaBytes))
+		})
+	}
+}
+
 // =============================================================================
 // Slices
 // =============================================================================
@@ -1055,6 +1090,66 @@ func TestToJSONSchema_Arrays(t *testing.T) {
 	})
 }
 
+func TestToJSONSchema_UniqueItems(t *testing.T) {
+	t.Run("slice unique", func(t *testing.T) {
+		schema := types.Slice[string](types.String()).Unique()
+		expected := `{"type":"array","items":{"type":"string"},"uniqueItems":true}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("tuple unique", func(t *testing.T) {
+		schema := types.Tuple(types.Int(), types.Int()).Unique()
+		expected := `{"type":"array","prefixItems":[{"type":"integer"},{"type":"integer"}],"minItems":2,"maxItems":2,"uniqueItems":true}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("unique by key is not exported", func(t *testing.T) {
+		schema := types.Slice[string](types.String()).UniqueBy(func(s string) any { return len(s) })
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		assert.Nil(t, js.UniqueItems)
+	})
+}
+
+func TestToJSONSchema_Contains(t *testing.T) {
+	t.Run("slice contains with default bounds", func(t *testing.T) {
+		schema := types.Slice[string](types.String()).Contains(types.Literal("admin"), 1, -1)
+		expected := `{"type":"array","items":{"type":"string"},"contains":{"type":"string","const":"admin"}}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("bounds and additional contains checks", func(t *testing.T) {
+		schema := types.Array([]any{types.Int(), types.Int()}).
+			Contains(types.Int().Positive(), 0, 1).
+			Contains(types.Int().Negative(), 2, 2)
+		expected := `{"type":"array","prefixItems":[{"type":"integer"},{"type":"integer"}],"minItems":2,"maxItems":2,` +
+			`"contains":{"type":"integer","exclusiveMinimum":0},"minContains":0,"maxContains":1,` +
+			`"allOf":[{"contains":{"type":"integer","exclusiveMaximum":0},"minContains":2,"maxContains":2}]}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("unrepresentable contains schema fails", func(t *testing.T) {
+		_, err := ToJSONSchema(types.Slice[any](types.Any()).Contains(types.BigInt(), 1, -1))
+		require.ErrorIs(t, err, ErrUnrepresentableType)
+	})
+}
+
 // =============================================================================
 // UNIONS
 // =============================================================================
@@ -1089,6 +1184,17 @@ func TestToJSONSchema_Unions(t *testing.T) {
 		assert.NoError(t, err)
 		assertJSONEquals(t, expected, string(jsonSchemaBytes))
 	})
+
+	t.Run("Unevaluated items", func(t *testing.T) {
+		schema := types.Union([]any{types.Slice[string](types.String()), types.String()}).
+			UnevaluatedItems(types.Int())
+		expected := `{"anyOf":[{"type":"array","items":{"type":"string"}},{"type":"string"}],"unevaluatedItems":{"type":"integer","minimum":-9223372036854775808,"maximum":9223372036854775807}}`
+		js, err := ToJSONSchema(schema)
+		assert.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		assert.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
 }
 
 // =============================================================================
@@ -1108,6 +1214,67 @@ func TestToJSONSchema_Intersections(t *testing.T) {
 		assert.NoError(t, err)
 		assertJSONEquals(t, expected, string(jsonSchemaBytes))
 	})
+
+	t.Run("Unevaluated properties", func(t *testing.T) {
+		schema := types.Intersection(
+			types.Object(core.ObjectSchema{"name": types.String()}),
+			types.Object(core.ObjectSchema{"age": types.Float()}).Strict(),
+		).Unevaluated(types.Never())
+		expected := `{"allOf":[{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]},{"type":"object","properties":{"age":{"type":"number"}},"required":["age"],"additionalProperties":false}],"unevaluatedProperties":{"not":true},"unevaluatedItems":{"not":true}}`
+		js, err := ToJSONSchema(schema)
+		assert.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		assert.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+}
+
+// =============================================================================
+// CONDITIONALS
+// =============================================================================
+
+func TestToJSONSchema_When(t *testing.T) {
+	t.Run("if then else", func(t *testing.T) {
+		schema := types.When(types.String(), types.String().Min(3), types.Bool())
+		expected := `{"if":{"type":"string"},"then":{"type":"string","minLength":3},"else":{"type":"boolean"}}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("missing branches are omitted", func(t *testing.T) {
+		schema := types.When(types.String(), nil, types.Bool())
+		expected := `{"if":{"type":"string"},"else":{"type":"boolean"}}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("unrepresentable branch fails", func(t *testing.T) {
+		_, err := ToJSONSchema(types.When(types.String(), types.BigInt(), nil))
+		require.ErrorIs(t, err, ErrUnrepresentableType)
+	})
+}
+
+func TestToJSONSchema_Not(t *testing.T) {
+	t.Run("not", func(t *testing.T) {
+		schema := types.Not(types.String().Describe("any string"))
+		expected := `{"not":{"type":"string","description":"any string"}}`
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		require.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("unrepresentable inner schema fails", func(t *testing.T) {
+		_, err := ToJSONSchema(types.Not(types.BigInt()))
+		require.ErrorIs(t, err, ErrUnrepresentableType)
+	})
 }
 
 // =============================================================================
@@ -1413,6 +1580,69 @@ func TestToJSONSchema_Objects(t *testing.T) {
 		assert.NoError(t, err)
 		assertJSONEquals(t, expected, string(jsonSchemaBytes))
 	})
+
+	t.Run("Object with Pattern Properties and Property Names", func(t *testing.T) {
+		schema := types.Object(core.ObjectSchema{
+			"name": types.String(),
+		}).PatternProperties(map[*regexp.Regexp]core.ZodSchema{
+			regexp.MustCompile("^x-"): types.String(),
+		}).PropertyNames(types.String().Max(32))
+		expected := `{
+			"type": "object",
+			"properties": {
+				"name": {"type": "string"}
+			},
+			"required": ["name"],
+			"patternProperties": {
+				"^x-": {"type": "string"}
+			},
+			"propertyNames": {"type": "string", "maxLength": 32},
+			"additionalProperties": false
+		}`
+		js, err := ToJSONSchema(schema)
+		assert.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		assert.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
+
+	t.Run("Object with Dependencies", func(t *testing.T) {
+		schema := types.Object(core.ObjectSchema{
+			"credit_card":     types.String().Optional(),
+			"billing_address": types.String().Optional(),
+		}).DependentRequired(map[string][]string{
+			"credit_card": {"billing_address"},
+		}).DependentSchemas(map[string]core.ZodSchema{
+			"billing_address": types.Object(core.ObjectSchema{
+				"billing_address": types.String().Min(3),
+			}).Passthrough(),
+		})
+		expected := `{
+			"type": "object",
+			"properties": {
+				"credit_card": {"type": "string"},
+				"billing_address": {"type": "string"}
+			},
+			"dependentRequired": {
+				"credit_card": ["billing_address"]
+			},
+			"dependentSchemas": {
+				"billing_address": {
+					"type": "object",
+					"properties": {
+						"billing_address": {"type": "string", "minLength": 3}
+					},
+					"required": ["billing_address"]
+				}
+			},
+			"additionalProperties": false
+		}`
+		js, err := ToJSONSchema(schema)
+		assert.NoError(t, err)
+		jsonSchemaBytes, err := json.Marshal(js)
+		assert.NoError(t, err)
+		assertJSONEquals(t, expected, string(jsonSchemaBytes))
+	})
 }
 
 // =============================================================================
@@ -2006,6 +2236,25 @@ func TestToJSONSchema_Structs(t *testing.T) {
 		assert.NoError(t, err)
 		assertJSONEquals(t, expected, string(jsonSchemaBytes))
 	})
+
+	t.Run("struct dependencies export field keys", func(t *testing.T) {
+		type Payment struct {
+			BillingAddress string `json:"billing_address"`
+			BillingName    string `json:"billing_name"`
+		}
+		schema := types.Struct[Payment](core.StructSchema{
+			"billing_address": types.String().Optional(),
+			"billing_name":    types.String().Optional(),
+		}).DependentRequired(map[string][]string{
+			"BillingAddress": {"BillingName"},
+		}).DependentSchemas(map[string]core.ZodSchema{
+			"BillingName": types.Struct[Payment](),
+		})
+		js, err := ToJSONSchema(schema)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"billing_address": {"billing_name"}}, js.DependentRequired)
+		assert.Contains(t, js.DependentSchemas, "billing_name")
+	})
 }
 
 // =============================================================================
@@ -2261,6 +2510,67 @@ func TestToJSONSchemaTransformIO(t *testing.T) {
 	assert.Contains(t, string(inputJSON), `"type":"string"`)
 }
 
+func TestToJSONSchemaCodecIO(t *testing.T) {
+	codec := core.NewZodCodec(types.String().Regex(regexp.MustCompile(`^\d+$`)), types.Int(),
+		func(s string, _ *core.RefinementContext) (int, error) { return strconv.Atoi(s) },
+		func(n int, _ *core.RefinementContext) (string, error) { return strconv.Itoa(n), nil },
+	)
+
+	inputSchema, err := ToJSONSchema(codec, Options{IO: IOInput})
+	require.NoError(t, err)
+	inputJSON, err := json.Marshal(inputSchema)
+	require.NoError(t, err)
+	assert.Contains(t, string(inputJSON), `"type":"string"`)
+	assert.Contains(t, string(inputJSON), `"pattern":"^\\d+$"`)
+
+	outputSchema, err := ToJSONSchema(codec, Options{IO: IOOutput})
+	require.NoError(t, err)
+	outputJSON, err := json.Marshal(outputSchema)
+	require.NoError(t, err)
+	assert.Contains(t, string(outputJSON), `"type":"integer"`)
+	assert.NotContains(t, string(outputJSON), `"pattern"`)
+}
+
+type brandedSKU string
+
+func TestToJSONSchemaBrandUsesInnerSchema(t *testing.T) {
+	schema := types.Brand[brandedSKU](types.String().Min(4))
+
+	result, err := ToJSONSchema(schema)
+	require.NoError(t, err)
+	resultJSON, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.Contains(t, string(resultJSON), `"type":"string"`)
+	assert.Contains(t, string(resultJSON), `"minLength":4`)
+}
+
+func TestToJSONSchemaReadonly(t *testing.T) {
+	schema := types.Object(core.ObjectSchema{
+		"id":   types.String(),
+		"tags": types.Slice[string](types.String()).Readonly(),
+	})
+
+	result, err := ToJSONSchema(schema.Readonly())
+	require.NoError(t, err)
+	require.NotNil(t, result.ReadOnly)
+	assert.True(t, *result.ReadOnly)
+
+	props := *result.Properties
+	require.NotNil(t, props["tags"].ReadOnly)
+	assert.True(t, *props["tags"].ReadOnly)
+	assert.Nil(t, props["id"].ReadOnly)
+}
+
+func TestToJSONSchemaTemplateLiteral(t *testing.T) {
+	schema := types.TemplateLiteral("order-", types.Int(), "-", types.Enum("eu", "us"))
+
+	result, err := ToJSONSchema(schema)
+	require.NoError(t, err)
+	require.NotNil(t, result.Pattern)
+	assert.Equal(t, `^(?:order-)(?:-?[0-9]+)(?:-)(?:eu|us)$`, *result.Pattern)
+	assert.Equal(t, []string{"string"}, []string(result.Type))
+}
+
 func TestToJSONSchemaPassthroughSchemas(t *testing.T) {
 	Internal := types.Struct[map[string]any](core.ObjectSchema{
 		"num": types.Number(),
@@ -2499,13 +2809,6 @@ func TestToJSONSchemaRejectsRegistryAtCompileTime(t *testing.T) {
 	assert.Contains(t, string(output), "does not implement")
 }
 
-func TestToJSONSchemaRejectsTargetOptionAtCompileTime(t *testing.T) {
-	cmd := exec.Command("go", "test", "-vet=off", "./testdata/target_option_compile_fail")
-	output, err := cmd.CombinedOutput()
-	require.Error(t, err, "fixture unexpectedly compiled:\n%s", output)
-	assert.Contains(t, string(output), "unknown field Target")
-}
-
 func TestToJSONSchemaRegistryRejectsDuplicateIDBeforeConversion(t *testing.T) {
 	registry := core.NewRegistry[core.GlobalMeta]().
 		Add(types.String(), core.GlobalMeta{ID: "shared"}).
@@ -2868,3 +3171,30 @@ func TestToJSONSchema_FromStructFieldNameTag(t *testing.T) {
 		assertJSONEquals(t, `{"properties":{"user_name":{"type":"string","minLength":3}}}`, string(b))
 	})
 }
+
+func TestToJSONSchemaBundleReferencesRegisteredSchemas(t *testing.T) {
+	name := types.String().Min(1)
+	registry := core.NewRegistry[core.GlobalMeta]().Add(name, core.GlobalMeta{ID: "name"})
+
+	bundle, err := ToJSONSchemaBundle(registry, []core.ZodSchema{
+		types.Slice[string](name),
+		types.Int(),
+		name,
+	}, Options{Target: TargetOpenAPI31})
+	require.NoError(t, err)
+	require.Len(t, bundle.Schemas, 3)
+
+	data, err := json.Marshal(bundle.Schemas)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "array", "items": {"$ref": "#/components/schemas/name"}},
+		{"type": "integer", "minimum": -9223372036854775808, "maximum": 9223372036854775807},
+		{"$ref": "#/components/schemas/name"}
+	]`, string(data))
+	defs, err := json.Marshal(bundle.Defs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": {"type": "string", "minLength": 1}}`, string(defs))
+
+	_, err = ToJSONSchemaBundle(nil, nil)
+	require.ErrorIs(t, err, ErrInvalidRegistrySchemaID)
+}
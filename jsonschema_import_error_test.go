@@ -12,18 +12,18 @@ import (
 )
 
 func TestJSONSchemaImportErrorIsAvailableFromRoot(t *testing.T) {
-	schema := &lib.Schema{Not: &lib.Schema{Boolean: new(true)}}
+	schema := &lib.Schema{ContentSchema: &lib.Schema{Boolean: new(true)}}
 
 	_, err := gozod.FromJSONSchema(schema)
 	var importErr *gozod.JSONSchemaImportError
 	require.True(t, errors.As(err, &importErr))
-	assert.Equal(t, "not", importErr.Keyword)
-	assert.Equal(t, "/not", importErr.Pointer)
+	assert.Equal(t, "contentSchema", importErr.Keyword)
+	assert.Equal(t, "/contentSchema", importErr.Pointer)
 	assert.ErrorIs(t, err, gozod.ErrUnsupportedJSONSchemaKeyword)
 }
 
 func TestJSONSchemaLossyImportIsAvailableFromRoot(t *testing.T) {
-	document := &lib.Schema{Not: &lib.Schema{Boolean: new(true)}}
+	document := &lib.Schema{ContentSchema: &lib.Schema{Boolean: new(true)}}
 
 	schema, losses, err := gozod.FromJSONSchemaLossy(document)
 	require.NoError(t, err)
@@ -31,8 +31,8 @@ func TestJSONSchemaLossyImportIsAvailableFromRoot(t *testing.T) {
 	require.Len(t, losses, 1)
 
 	loss := losses[0]
-	assert.Equal(t, "not", loss.Keyword)
-	assert.Equal(t, "/not", loss.Pointer)
+	assert.Equal(t, "contentSchema", loss.Keyword)
+	assert.Equal(t, "/contentSchema", loss.Pointer)
 	assert.ErrorIs(t, loss, gozod.ErrUnsupportedJSONSchemaKeyword)
 	var typedLoss gozod.JSONSchemaImportLossError
 	assert.ErrorAs(t, loss, &typedLoss)
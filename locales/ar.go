@@ -23,6 +23,16 @@ var SizableAr = map[string]issues.SizingInfo{
 	"map":    {Unit: "مدخل", Verb: "أن يحوي"},
 }
 
+// FieldRelationsAr maps Arabic cross-field relation translations.
+var FieldRelationsAr = map[string]string{
+	"eq":  "مساويًا لـ",
+	"ne":  "مختلفًا عن",
+	"gt":  "أكبر من",
+	"gte": "أكبر من أو مساويًا لـ",
+	"lt":  "أصغر من",
+	"lte": "أصغر من أو مساويًا لـ",
+}
+
 // FormatNounsAr maps Arabic format noun translations.
 var FormatNounsAr = map[string]string{
 	"regex":            "مدخل",
@@ -167,6 +177,10 @@ func formatAr(raw core.ZodRawIssue) string {
 		return "مدخل غير مقبول"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("عنصر مكرر عند الفهرس %v (ظهر أولاً عند الفهرس %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "عنصر غير مقبول"
@@ -204,6 +218,20 @@ func formatAr(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "تم اكتشاف مؤشر فارغ"
 
+	case core.Canceled:
+		return "تم إلغاء التحقق"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "المدخل يطابق مخططًا محظورًا: " + description
+		}
+		return "المدخل يطابق مخططًا محظورًا"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("مدخل غير مقبول: يجب أن يكون %[1]s %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsAr[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintAr(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("أصغر من اللازم: يفترض أن تحتوي المصفوفة على %s%s عناصر مطابقة", adj, thresholdStr)
+		}
+		return fmt.Sprintf("أكبر من اللازم: يفترض أن تحتوي المصفوفة على %s%s عناصر مطابقة", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("أصغر من اللازم: يفترض لـ %s أن يكون %s %s %s", origin, adj, thresholdStr, sizing.Unit)
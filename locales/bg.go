@@ -23,6 +23,16 @@ var SizableBg = map[string]issues.SizingInfo{
 	"map":    {Unit: "записа", Verb: "да съдържа"},
 }
 
+// FieldRelationsBg maps Bulgarian cross-field relation translations.
+var FieldRelationsBg = map[string]string{
+	"eq":  "равно на",
+	"ne":  "различно от",
+	"gt":  "по-голямо от",
+	"gte": "по-голямо или равно на",
+	"lt":  "по-малко от",
+	"lte": "по-малко или равно на",
+}
+
 // FormatNounsBg maps Bulgarian format noun translations with gender.
 var FormatNounsBg = map[string]string{
 	"regex":            "вход",
@@ -184,6 +194,10 @@ func formatBg(raw core.ZodRawIssue) string {
 		return "Невалиден вход"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Дублиран елемент на индекс %v (за първи път на индекс %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Невалиден елемент"
@@ -221,6 +235,20 @@ func formatBg(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Открит нулев указател"
 
+	case core.Canceled:
+		return "Валидацията е прекратена"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Входът съвпада със забранена схема: " + description
+		}
+		return "Входът съвпада със забранена схема"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Невалиден вход: %[1]s трябва да бъде %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsBg[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -274,6 +302,13 @@ func formatSizeConstraintBg(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Твърде малко: очаква се масивът да съдържа %s%s съвпадащи елемента", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Твърде голямо: очаква се масивът да съдържа %s%s съвпадащи елемента", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Твърде малко: очаква се %s да съдържа %s%s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableCs = map[string]issues.SizingInfo{
 	"map":    {Unit: "záznamů", Verb: "mít"},
 }
 
+// FieldRelationsCs maps Czech cross-field relation translations.
+var FieldRelationsCs = map[string]string{
+	"eq":  "rovno",
+	"ne":  "odlišné od",
+	"gt":  "větší než",
+	"gte": "větší nebo rovno",
+	"lt":  "menší než",
+	"lte": "menší nebo rovno",
+}
+
 // FormatNounsCs maps Czech format noun translations.
 var FormatNounsCs = map[string]string{
 	"regex":            "regulární výraz",
@@ -167,6 +177,10 @@ func formatCs(raw core.ZodRawIssue) string {
 		return "Neplatný vstup"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Duplicitní prvek na indexu %v (poprvé na indexu %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Neplatný prvek"
@@ -204,6 +218,20 @@ func formatCs(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Zjištěn nulový ukazatel"
 
+	case core.Canceled:
+		return "Validace byla zrušena"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Vstup odpovídá zakázanému schématu: " + description
+		}
+		return "Vstup odpovídá zakázanému schématu"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Neplatný vstup: %[1]s musí být %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsCs[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintCs(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Hodnota je příliš malá: pole musí obsahovat %s%s odpovídajících prvků", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Hodnota je příliš velká: pole musí obsahovat %s%s odpovídajících prvků", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Hodnota je příliš malá: %s musí %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
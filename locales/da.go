@@ -24,6 +24,16 @@ var SizableDa = map[string]issues.SizingInfo{
 	"map":    {Unit: "poster", Verb: "indeholdt"},
 }
 
+// FieldRelationsDa maps Danish cross-field relation translations.
+var FieldRelationsDa = map[string]string{
+	"eq":  "lig med",
+	"ne":  "forskellig fra",
+	"gt":  "større end",
+	"gte": "større end eller lig med",
+	"lt":  "mindre end",
+	"lte": "mindre end eller lig med",
+}
+
 // FormatNounsDa maps Danish format noun translations.
 var FormatNounsDa = map[string]string{
 	"regex":            "input",
@@ -168,6 +178,10 @@ func formatDa(raw core.ZodRawIssue) string {
 		return "Ugyldigt input: matcher ingen af de tilladte typer"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Dubleret element ved indeks %v (først set ved indeks %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Ugyldigt element"
@@ -205,6 +219,20 @@ func formatDa(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null-pointer opdaget"
 
+	case core.Canceled:
+		return "Validering annulleret"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Input matcher et forbudt skema: " + description
+		}
+		return "Input matcher et forbudt skema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Ugyldigt input: %[1]s skal være %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsDa[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintDa(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("For lille: forventede at listen indeholder %s%s matchende elementer", adj, thresholdStr)
+		}
+		return fmt.Sprintf("For stor: forventede at listen indeholder %s%s matchende elementer", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("For lille: forventede %s %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
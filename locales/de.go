@@ -24,6 +24,16 @@ var SizableDe = map[string]issues.SizingInfo{
 	"map":    {Unit: "Einträge", Verb: "haben"},
 }
 
+// FieldRelationsDe maps German cross-field relation translations.
+var FieldRelationsDe = map[string]string{
+	"eq":  "gleich",
+	"ne":  "verschieden von",
+	"gt":  "größer als",
+	"gte": "größer als oder gleich",
+	"lt":  "kleiner als",
+	"lte": "kleiner als oder gleich",
+}
+
 // FormatNounsDe maps German format noun translations.
 var FormatNounsDe = map[string]string{
 	"regex":            "Eingabe",
@@ -168,6 +178,10 @@ func formatDe(raw core.ZodRawIssue) string {
 		return "Ungültige Eingabe"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Doppeltes Element an Index %v (zuerst an Index %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Ungültiges Element"
@@ -205,6 +219,20 @@ func formatDe(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null-Zeiger erkannt"
 
+	case core.Canceled:
+		return "Validierung abgebrochen"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Eingabe entspricht einem verbotenen Schema: " + description
+		}
+		return "Eingabe entspricht einem verbotenen Schema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Ungültige Eingabe: %[1]s muss %[2]s %[3]s sein",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsDe[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintDe(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Zu klein: erwartet, dass das Array %s%s passende Elemente enthält", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Zu groß: erwartet, dass das Array %s%s passende Elemente enthält", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Zu klein: erwartet, dass %s %s%s %s hat", origin, adj, thresholdStr, sizing.Unit)
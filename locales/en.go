@@ -101,6 +101,10 @@ func formatEn(raw core.ZodRawIssue) string {
 		if origin == "" {
 			return "Invalid element"
 		}
+		if origin == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Duplicate element at index %v (first seen at index %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		return fmt.Sprintf("Invalid value in %s", origin)
 
 	case core.MissingRequired:
@@ -135,6 +139,20 @@ func formatEn(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Nil pointer encountered"
 
+	case core.Canceled:
+		return "Validation canceled"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Input matches a forbidden schema: " + description
+		}
+		return "Input matches a forbidden schema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Invalid input: expected %s to be %s %s",
+			mapx.StringOr(raw.Properties, "field_name", ""), issues.FieldRelations[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -178,6 +196,14 @@ func formatSizeConstraintEn(raw core.ZodRawIssue, isTooSmall bool) string {
 	sizing := issues.Sizing(origin)
 	thresholdStr := issues.FormatThreshold(threshold)
 
+	// Contains bounds the count of matching elements, not the array length
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Too small: expected array to contain %s%s matching items", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Too big: expected array to contain %s%s matching items", adj, thresholdStr)
+	}
+
 	// Special handling for file size validation to match expected format
 	if origin == "file" {
 		if isTooSmall {
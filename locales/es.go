@@ -23,6 +23,16 @@ var SizableEs = map[string]issues.SizingInfo{
 	"map":    {Unit: "entradas", Verb: "tener"},
 }
 
+// FieldRelationsEs maps Spanish cross-field relation translations.
+var FieldRelationsEs = map[string]string{
+	"eq":  "igual a",
+	"ne":  "distinto de",
+	"gt":  "mayor que",
+	"gte": "mayor o igual que",
+	"lt":  "menor que",
+	"lte": "menor o igual que",
+}
+
 // FormatNounsEs maps Spanish format noun translations.
 var FormatNounsEs = map[string]string{
 	"regex":            "entrada",
@@ -182,6 +192,10 @@ func formatEs(raw core.ZodRawIssue) string {
 		return "Entrada inválida"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Elemento duplicado en el índice %v (visto primero en el índice %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		origin = getTypeNameEs(origin)
 		if origin == "" {
@@ -220,6 +234,20 @@ func formatEs(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Se encontró un puntero nulo"
 
+	case core.Canceled:
+		return "Validación cancelada"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "La entrada coincide con un esquema prohibido: " + description
+		}
+		return "La entrada coincide con un esquema prohibido"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Entrada inválida: %[1]s debe ser %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsEs[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -274,6 +302,13 @@ func formatSizeConstraintEs(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if mapx.StringOr(raw.Properties, "origin", "") == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Demasiado pequeño: se esperaba que el arreglo contuviera %s%s elementos coincidentes", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Demasiado grande: se esperaba que el arreglo contuviera %s%s elementos coincidentes", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Demasiado pequeño: se esperaba que %s tuviera %s%s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -24,6 +24,16 @@ var SizableFa = map[string]issues.SizingInfo{
 	"map":    {Unit: "ورودی", Verb: "داشته باشد"},
 }
 
+// Persian cross-field relation mappings
+var FieldRelationsFa = map[string]string{
+	"eq":  "برابر با",
+	"ne":  "متفاوت از",
+	"gt":  "بزرگ‌تر از",
+	"gte": "بزرگ‌تر یا برابر با",
+	"lt":  "کوچک‌تر از",
+	"lte": "کوچک‌تر یا برابر با",
+}
+
 // Persian format noun mappings
 var FormatNounsFa = map[string]string{
 	"regex":            "ورودی",
@@ -168,6 +178,10 @@ func formatFa(raw core.ZodRawIssue) string {
 		return "ورودی نامعتبر"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("عنصر تکراری در اندیس %v (نخستین بار در اندیس %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "عنصر نامعتبر"
@@ -205,6 +219,20 @@ func formatFa(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "اشاره‌گر تهی یافت شد"
 
+	case core.Canceled:
+		return "اعتبارسنجی لغو شد"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "ورودی با یک طرح‌واره ممنوع مطابقت دارد: " + description
+		}
+		return "ورودی با یک طرح‌واره ممنوع مطابقت دارد"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("ورودی نامعتبر: %[1]s باید %[2]s %[3]s باشد",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsFa[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintFa(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("خیلی کوچک: آرایه باید %s%s عنصر منطبق داشته باشد", adj, thresholdStr)
+		}
+		return fmt.Sprintf("خیلی بزرگ: آرایه باید %s%s عنصر منطبق داشته باشد", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("خیلی کوچک: %s باید %s%s %s باشد", origin, adj, thresholdStr, sizing.Unit)
@@ -33,6 +33,16 @@ var SizableFi = map[string]finnishSizingInfo{
 	"date":   {Unit: "", Subject: "päivämäärän"},
 }
 
+// FieldRelationsFi maps Finnish cross-field relation translations.
+var FieldRelationsFi = map[string]string{
+	"eq":  "sama kuin",
+	"ne":  "eri kuin",
+	"gt":  "suurempi kuin",
+	"gte": "suurempi tai yhtä suuri kuin",
+	"lt":  "pienempi kuin",
+	"lte": "pienempi tai yhtä suuri kuin",
+}
+
 // FormatNounsFi maps Finnish format noun translations.
 var FormatNounsFi = map[string]string{
 	"regex":            "säännöllinen lauseke",
@@ -173,6 +183,10 @@ func formatFi(raw core.ZodRawIssue) string {
 		return "Virheellinen unioni"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Toistuva alkio indeksissä %v (ensimmäisen kerran indeksissä %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		return "Virheellinen arvo joukossa"
 
 	case core.MissingRequired:
@@ -206,6 +220,20 @@ func formatFi(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Tyhjä osoitin havaittu"
 
+	case core.Canceled:
+		return "Validointi peruutettu"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Syöte vastaa kiellettyä skeemaa: " + description
+		}
+		return "Syöte vastaa kiellettyä skeemaa"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Virheellinen syöte: %[1]s on oltava %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsFi[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -254,6 +282,13 @@ func formatSizeConstraintFi(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if mapx.StringOr(raw.Properties, "origin", "") == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Liian pieni: taulukossa täytyy olla %s%s vastaavaa alkiota", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Liian suuri: taulukossa täytyy olla %s%s vastaavaa alkiota", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		subject := sizing.Subject
 		if isTooSmall {
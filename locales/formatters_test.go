@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
 )
 
 func TestDefaultLocaleFormattersProduceMessages(t *testing.T) {
@@ -74,3 +75,58 @@ func TestEnglishPublicFormatterHelpers(t *testing.T) {
 	assert.NotEmpty(t, config.LocaleError(issue))
 	assert.Equal(t, config.LocaleError(issue), FormatMessageEn(issue))
 }
+
+func TestDefaultLocaleFormattersTranslateContainsBounds(t *testing.T) {
+	issues := []core.ZodRawIssue{
+		{Code: core.TooSmall, Properties: map[string]any{"origin": "contains", "minimum": 2, "inclusive": true}},
+		{Code: core.TooBig, Properties: map[string]any{"origin": "contains", "maximum": 3, "inclusive": true}},
+	}
+
+	for locale, formatter := range DefaultLocales {
+		t.Run(locale, func(t *testing.T) {
+			small, big := formatter(issues[0]), formatter(issues[1])
+			assert.NotContains(t, small, "contains")
+			assert.NotContains(t, big, "contains")
+			assert.Contains(t, small, "2")
+			assert.Contains(t, big, "3")
+			assert.NotEqual(t, small, big)
+		})
+	}
+}
+
+func TestDefaultLocaleFormattersTranslateDuplicateElements(t *testing.T) {
+	duplicate := core.ZodRawIssue{
+		Code: core.InvalidElement,
+		Properties: map[string]any{
+			"origin": "unique",
+			"index":  3,
+			"params": map[string]any{"firstIndex": 1},
+		},
+	}
+
+	for locale, formatter := range DefaultLocales {
+		t.Run(locale, func(t *testing.T) {
+			message := formatter(duplicate)
+			assert.NotContains(t, message, "unique")
+			assert.Contains(t, message, "3")
+			assert.Contains(t, message, "1")
+		})
+	}
+}
+
+func TestDefaultLocaleFormattersTranslateFieldComparisons(t *testing.T) {
+	for locale, formatter := range DefaultLocales {
+		t.Run(locale, func(t *testing.T) {
+			for relation := range issues.FieldRelations {
+				raw := issues.CreateInvalidFieldComparisonIssue("ends_at", relation, "starts_at", 1)
+				message := formatter(raw)
+				assert.Contains(t, message, "ends_at")
+				assert.Contains(t, message, "starts_at")
+				assert.NotContains(t, message, "%!")
+				if locale != "en" {
+					assert.NotContains(t, message, issues.FieldRelations[relation])
+				}
+			}
+		})
+	}
+}
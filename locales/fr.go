@@ -24,6 +24,16 @@ var SizableFr = map[string]issues.SizingInfo{
 	"map":    {Unit: "entrées", Verb: "avoir"},
 }
 
+// FieldRelationsFr maps French cross-field relation translations.
+var FieldRelationsFr = map[string]string{
+	"eq":  "égal à",
+	"ne":  "différent de",
+	"gt":  "supérieur à",
+	"gte": "supérieur ou égal à",
+	"lt":  "inférieur à",
+	"lte": "inférieur ou égal à",
+}
+
 // FormatNounsFr maps French format noun translations.
 var FormatNounsFr = map[string]string{
 	"regex":            "entrée",
@@ -168,6 +178,10 @@ func formatFr(raw core.ZodRawIssue) string {
 		return "Entrée invalide"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Élément en double à l'index %v (vu d'abord à l'index %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Élément invalide"
@@ -205,6 +219,20 @@ func formatFr(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Pointeur nul rencontré"
 
+	case core.Canceled:
+		return "Validation annulée"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "L'entrée correspond à un schéma interdit: " + description
+		}
+		return "L'entrée correspond à un schéma interdit"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Entrée invalide : %[1]s doit être %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsFr[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintFr(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Trop petit : le tableau doit contenir %s%s éléments correspondants", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Trop grand : le tableau doit contenir %s%s éléments correspondants", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Trop petit : %s doit %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
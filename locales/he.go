@@ -61,6 +61,16 @@ var SizableHe = map[string]hebrewSizingInfo{
 	"number": {Unit: "", ShortLabel: "קטן", LongLabel: "גדול"},
 }
 
+// FieldRelationsHe maps Hebrew cross-field relation translations.
+var FieldRelationsHe = map[string]string{
+	"eq":  "שווה ל",
+	"ne":  "שונה מ",
+	"gt":  "גדול מ",
+	"gte": "גדול או שווה ל",
+	"lt":  "קטן מ",
+	"lte": "קטן או שווה ל",
+}
+
 // FormatNounsHe maps Hebrew format noun translations with gender.
 var FormatNounsHe = map[string]hebrewTypeInfo{
 	"regex":            {Label: "קלט", Gender: "m"},
@@ -203,6 +213,10 @@ func formatHe(raw core.ZodRawIssue) string {
 		return "קלט לא תקין"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("פריט כפול באינדקס %v (הופיע לראשונה באינדקס %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "ערך לא תקין"
@@ -240,6 +254,20 @@ func formatHe(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "זוהה מצביע ריק"
 
+	case core.Canceled:
+		return "האימות בוטל"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "הקלט תואם לסכמה אסורה: " + description
+		}
+		return "הקלט תואם לסכמה אסורה"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("קלט לא תקין: %[1]s חייב להיות %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsHe[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -364,6 +392,14 @@ func formatSizeConstraintHe(raw core.ZodRawIssue, isTooSmall bool) string {
 	if isTooSmall {
 		sizeLabel = "קטן"
 	}
+
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("קטן מדי: המערך צריך להכיל %s%s פריטים תואמים", adj, thresholdStr)
+		}
+		return fmt.Sprintf("גדול מדי: המערך צריך להכיל %s%s פריטים תואמים", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			sizeLabel = sizing.ShortLabel
@@ -23,6 +23,16 @@ var SizableHu = map[string]issues.SizingInfo{
 	"map":    {Unit: "bejegyzés", Verb: "legyen"},
 }
 
+// FieldRelationsHu maps Hungarian cross-field relation translations.
+var FieldRelationsHu = map[string]string{
+	"eq":  "egyenlő ezzel:",
+	"ne":  "eltérő ettől:",
+	"gt":  "nagyobb, mint",
+	"gte": "nagyobb vagy egyenlő, mint",
+	"lt":  "kisebb, mint",
+	"lte": "kisebb vagy egyenlő, mint",
+}
+
 // FormatNounsHu maps Hungarian format noun translations.
 var FormatNounsHu = map[string]string{
 	"regex":            "bemenet",
@@ -167,6 +177,10 @@ func formatHu(raw core.ZodRawIssue) string {
 		return "Érvénytelen bemenet"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Ismétlődő elem a(z) %v. indexen (először a(z) %v. indexen)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Érvénytelen elem"
@@ -204,6 +218,20 @@ func formatHu(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null mutató észlelve"
 
+	case core.Canceled:
+		return "Az ellenőrzés megszakítva"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "A bemenet egy tiltott sémának felel meg: " + description
+		}
+		return "A bemenet egy tiltott sémának felel meg"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Érvénytelen bemenet: %[1]s értéke legyen %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsHu[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintHu(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Túl kicsi: a tömbnek %s%s egyező elemet kell tartalmaznia", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Túl nagy: a tömbnek %s%s egyező elemet kell tartalmaznia", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Túl kicsi: a bemeneti érték %s mérete túl kicsi %s%s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableID = map[string]issues.SizingInfo{
 	"map":    {Unit: "entri", Verb: "memiliki"},
 }
 
+// FieldRelationsID maps Indonesian cross-field relation translations.
+var FieldRelationsID = map[string]string{
+	"eq":  "sama dengan",
+	"ne":  "berbeda dari",
+	"gt":  "lebih besar dari",
+	"gte": "lebih besar dari atau sama dengan",
+	"lt":  "lebih kecil dari",
+	"lte": "lebih kecil dari atau sama dengan",
+}
+
 // FormatNounsID maps Indonesian format noun translations.
 var FormatNounsID = map[string]string{
 	"regex":            "input",
@@ -163,6 +173,10 @@ func formatID(raw core.ZodRawIssue) string {
 		return "Input tidak valid"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Elemen duplikat pada indeks %v (pertama terlihat pada indeks %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Elemen tidak valid"
@@ -200,6 +214,20 @@ func formatID(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Pointer null terdeteksi"
 
+	case core.Canceled:
+		return "Validasi dibatalkan"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Input cocok dengan skema yang dilarang: " + description
+		}
+		return "Input cocok dengan skema yang dilarang"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Input tidak valid: %[1]s harus %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsID[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -253,6 +281,13 @@ func formatSizeConstraintID(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Terlalu kecil: diharapkan array berisi %s%s elemen yang cocok", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Terlalu besar: diharapkan array berisi %s%s elemen yang cocok", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Terlalu kecil: diharapkan %s memiliki %s%s %s", origin, adj, thresholdStr, sizing.Unit)
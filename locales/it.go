@@ -24,6 +24,16 @@ var SizableIt = map[string]issues.SizingInfo{
 	"map":    {Unit: "voci", Verb: "avere"},
 }
 
+// FieldRelationsIt maps Italian cross-field relation translations.
+var FieldRelationsIt = map[string]string{
+	"eq":  "uguale a",
+	"ne":  "diverso da",
+	"gt":  "maggiore di",
+	"gte": "maggiore o uguale a",
+	"lt":  "minore di",
+	"lte": "minore o uguale a",
+}
+
 // FormatNounsIt maps Italian format noun translations.
 var FormatNounsIt = map[string]string{
 	"regex":            "input",
@@ -168,6 +178,10 @@ func formatIt(raw core.ZodRawIssue) string {
 		return "Input non valido"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Elemento duplicato all'indice %v (visto per la prima volta all'indice %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Elemento non valido"
@@ -205,6 +219,20 @@ func formatIt(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Puntatore nullo rilevato"
 
+	case core.Canceled:
+		return "Validazione annullata"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "L'input corrisponde a uno schema vietato: " + description
+		}
+		return "L'input corrisponde a uno schema vietato"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Input non valido: %[1]s deve essere %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsIt[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintIt(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Troppo piccolo: l'array deve contenere %s%s elementi corrispondenti", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Troppo grande: l'array deve contenere %s%s elementi corrispondenti", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Troppo piccolo: %s deve avere %s%s %s", origin, adj, thresholdStr, sizing.Unit)
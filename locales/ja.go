@@ -23,6 +23,16 @@ var SizableJa = map[string]issues.SizingInfo{
 	"map":    {Unit: "エントリ", Verb: "である"},
 }
 
+// FieldRelationsJa maps Japanese cross-field relation translations.
+var FieldRelationsJa = map[string]string{
+	"eq":  "と等しい",
+	"ne":  "と異なる",
+	"gt":  "より大きい",
+	"gte": "以上である",
+	"lt":  "より小さい",
+	"lte": "以下である",
+}
+
 // FormatNounsJa maps Japanese format noun translations.
 var FormatNounsJa = map[string]string{
 	"regex":            "入力値",
@@ -167,6 +177,10 @@ func formatJa(raw core.ZodRawIssue) string {
 		return "無効な入力"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("インデックス %v の要素が重複しています (最初はインデックス %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "無効な要素"
@@ -204,6 +218,20 @@ func formatJa(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "nilポインタが検出されました"
 
+	case core.Canceled:
+		return "検証がキャンセルされました"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "入力が禁止されたスキーマに一致します: " + description
+		}
+		return "入力が禁止されたスキーマに一致します"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("無効な入力: %[1]sは%[3]s%[2]s必要があります",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsJa[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintJa(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("小さすぎる値: 配列は一致する要素を%[2]s個%[1]s含む必要があります", adj, thresholdStr)
+		}
+		return fmt.Sprintf("大きすぎる値: 配列は一致する要素を%[2]s個%[1]s含む必要があります", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("小さすぎる値: %sは%s%s%s必要があります", origin, thresholdStr, sizing.Unit, adj)
@@ -23,6 +23,16 @@ var SizableKo = map[string]issues.SizingInfo{
 	"map":    {Unit: "개", Verb: ""},
 }
 
+// FieldRelationsKo maps Korean cross-field relation translations.
+var FieldRelationsKo = map[string]string{
+	"eq":  "와(과) 같아야",
+	"ne":  "와(과) 달라야",
+	"gt":  "보다 커야",
+	"gte": "보다 크거나 같아야",
+	"lt":  "보다 작아야",
+	"lte": "보다 작거나 같아야",
+}
+
 // FormatNounsKo maps Korean format noun translations.
 var FormatNounsKo = map[string]string{
 	"regex":            "입력",
@@ -163,6 +173,10 @@ func formatKo(raw core.ZodRawIssue) string {
 		return "잘못된 입력"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("인덱스 %v의 요소가 중복되었습니다 (처음 나타난 인덱스 %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "잘못된 요소"
@@ -200,6 +214,20 @@ func formatKo(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "nil 포인터가 발견되었습니다"
 
+	case core.Canceled:
+		return "검증이 취소되었습니다"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "입력이 금지된 스키마와 일치합니다: " + description
+		}
+		return "입력이 금지된 스키마와 일치합니다"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("잘못된 입력: %[1]s은(는) %[3]s%[2]s 합니다",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsKo[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintKo(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("배열의 일치 항목이 너무 적습니다: %[2]s개 %[1]s%[3]s", adj, thresholdStr, suffix)
+		}
+		return fmt.Sprintf("배열의 일치 항목이 너무 많습니다: %[2]s개 %[1]s%[3]s", adj, thresholdStr, suffix)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("%s이(가) 너무 작습니다: %s%s %s%s", origin, thresholdStr, sizing.Unit, adj, suffix)
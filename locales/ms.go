@@ -23,6 +23,16 @@ var SizableMs = map[string]issues.SizingInfo{
 	"map":    {Unit: "entri", Verb: "mempunyai"},
 }
 
+// FieldRelationsMs maps Malay cross-field relation translations.
+var FieldRelationsMs = map[string]string{
+	"eq":  "sama dengan",
+	"ne":  "berbeza daripada",
+	"gt":  "lebih besar daripada",
+	"gte": "lebih besar daripada atau sama dengan",
+	"lt":  "lebih kecil daripada",
+	"lte": "lebih kecil daripada atau sama dengan",
+}
+
 // FormatNounsMs maps Malay format noun translations.
 var FormatNounsMs = map[string]string{
 	"regex":            "input",
@@ -163,6 +173,10 @@ func formatMs(raw core.ZodRawIssue) string {
 		return "Input tidak sah"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Elemen pendua pada indeks %v (mula-mula dilihat pada indeks %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Elemen tidak sah"
@@ -200,6 +214,20 @@ func formatMs(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Penunjuk null dikesan"
 
+	case core.Canceled:
+		return "Pengesahan dibatalkan"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Input sepadan dengan skema yang dilarang: " + description
+		}
+		return "Input sepadan dengan skema yang dilarang"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Input tidak sah: %[1]s mesti %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsMs[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -253,6 +281,13 @@ func formatSizeConstraintMs(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Terlalu kecil: dijangka tatasusunan mengandungi %s%s elemen yang sepadan", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Terlalu besar: dijangka tatasusunan mengandungi %s%s elemen yang sepadan", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Terlalu kecil: dijangka %s %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
@@ -24,6 +24,16 @@ var SizableNl = map[string]issues.SizingInfo{
 	"map":    {Unit: "items", Verb: "heeft"},
 }
 
+// FieldRelationsNl maps Dutch cross-field relation translations.
+var FieldRelationsNl = map[string]string{
+	"eq":  "gelijk aan",
+	"ne":  "verschillend van",
+	"gt":  "groter dan",
+	"gte": "groter dan of gelijk aan",
+	"lt":  "kleiner dan",
+	"lte": "kleiner dan of gelijk aan",
+}
+
 // FormatNounsNl maps Dutch format noun translations.
 var FormatNounsNl = map[string]string{
 	"regex":            "invoer",
@@ -190,6 +200,10 @@ func formatNl(raw core.ZodRawIssue) string {
 		return "Ongeldige invoer"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Dubbel element op index %v (eerst gezien op index %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Ongeldig element"
@@ -227,6 +241,20 @@ func formatNl(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null pointer aangetroffen"
 
+	case core.Canceled:
+		return "Validatie geannuleerd"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Invoer komt overeen met een verboden schema: " + description
+		}
+		return "Invoer komt overeen met een verboden schema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Ongeldige invoer: %[1]s moet %[2]s %[3]s zijn",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsNl[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -279,6 +307,10 @@ func formatSizeConstraintNl(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		return fmt.Sprintf("Te %[3]s: verwacht dat de array %[1]s%[2]s overeenkomende elementen bevat", adj, thresholdStr, sizeAdj)
+	}
+
 	if sizing != nil {
 		return fmt.Sprintf("Te %s: verwacht dat %s %s%s %s %s", sizeAdj, origin, adj, thresholdStr, sizing.Unit, sizing.Verb)
 	}
@@ -24,6 +24,16 @@ var SizableNo = map[string]issues.SizingInfo{
 	"map":    {Unit: "oppføringer", Verb: "å inneholde"},
 }
 
+// FieldRelationsNo maps Norwegian cross-field relation translations.
+var FieldRelationsNo = map[string]string{
+	"eq":  "lik",
+	"ne":  "forskjellig fra",
+	"gt":  "større enn",
+	"gte": "større enn eller lik",
+	"lt":  "mindre enn",
+	"lte": "mindre enn eller lik",
+}
+
 // FormatNounsNo maps Norwegian format noun translations.
 var FormatNounsNo = map[string]string{
 	"regex":            "input",
@@ -168,6 +178,10 @@ func formatNo(raw core.ZodRawIssue) string {
 		return "Ugyldig input"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Duplisert element på indeks %v (først sett på indeks %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Ugyldig element"
@@ -205,6 +219,20 @@ func formatNo(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null-peker oppdaget"
 
+	case core.Canceled:
+		return "Validering avbrutt"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Inndata samsvarer med et forbudt skjema: " + description
+		}
+		return "Inndata samsvarer med et forbudt skjema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Ugyldig input: %[1]s må være %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsNo[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintNo(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("For lite(n): forventet at listen inneholder %s%s samsvarende elementer", adj, thresholdStr)
+		}
+		return fmt.Sprintf("For stor(t): forventet at listen inneholder %s%s samsvarende elementer", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("For lite(n): forventet %s til å ha %s%s %s", origin, adj, thresholdStr, sizing.Unit)
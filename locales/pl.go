@@ -24,6 +24,16 @@ var SizablePl = map[string]issues.SizingInfo{
 	"map":    {Unit: "wpisów", Verb: "mieć"},
 }
 
+// FieldRelationsPl maps Polish cross-field relation translations.
+var FieldRelationsPl = map[string]string{
+	"eq":  "równe",
+	"ne":  "różne od",
+	"gt":  "większe niż",
+	"gte": "większe lub równe",
+	"lt":  "mniejsze niż",
+	"lte": "mniejsze lub równe",
+}
+
 // FormatNounsPl maps Polish format noun translations.
 var FormatNounsPl = map[string]string{
 	"regex":            "wyrażenie",
@@ -168,6 +178,10 @@ func formatPl(raw core.ZodRawIssue) string {
 		return "Nieprawidłowe dane wejściowe"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Zduplikowany element na indeksie %v (po raz pierwszy na indeksie %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Nieprawidłowy element"
@@ -205,6 +219,20 @@ func formatPl(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Napotkano pusty wskaźnik"
 
+	case core.Canceled:
+		return "Walidacja anulowana"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Dane wejściowe pasują do zabronionego schematu: " + description
+		}
+		return "Dane wejściowe pasują do zabronionego schematu"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Nieprawidłowe dane wejściowe: %[1]s musi być %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsPl[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintPl(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Za mała wartość: oczekiwano, że tablica będzie zawierać %s%s pasujących elementów", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Za duża wartość: oczekiwano, że tablica będzie zawierać %s%s pasujących elementów", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Za mała wartość: oczekiwano, że %s będzie mieć %s%s %s", origin, adj, thresholdStr, sizing.Unit)
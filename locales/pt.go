@@ -24,6 +24,16 @@ var SizablePt = map[string]issues.SizingInfo{
 	"map":    {Unit: "entradas", Verb: "ter"},
 }
 
+// FieldRelationsPt maps Portuguese cross-field relation translations.
+var FieldRelationsPt = map[string]string{
+	"eq":  "igual a",
+	"ne":  "diferente de",
+	"gt":  "maior que",
+	"gte": "maior ou igual a",
+	"lt":  "menor que",
+	"lte": "menor ou igual a",
+}
+
 // FormatNounsPt maps Portuguese format noun translations.
 var FormatNounsPt = map[string]string{
 	"regex":            "padrão",
@@ -168,6 +178,10 @@ func formatPt(raw core.ZodRawIssue) string {
 		return "Entrada inválida"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Elemento duplicado no índice %v (visto primeiro no índice %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Elemento inválido"
@@ -205,6 +219,20 @@ func formatPt(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Ponteiro nulo encontrado"
 
+	case core.Canceled:
+		return "Validação cancelada"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "A entrada corresponde a um esquema proibido: " + description
+		}
+		return "A entrada corresponde a um esquema proibido"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Entrada inválida: %[1]s deve ser %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsPt[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintPt(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Muito pequeno: esperado que o array contivesse %s%s itens correspondentes", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Muito grande: esperado que o array contivesse %s%s itens correspondentes", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Muito pequeno: esperado que %s tivesse %s%s %s", origin, adj, thresholdStr, sizing.Unit)
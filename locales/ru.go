@@ -54,6 +54,16 @@ var SizableRu = map[string]RussianSizable{
 	"map":    {UnitOne: "запись", UnitFew: "записи", UnitMany: "записей", Verb: "иметь"},
 }
 
+// FieldRelationsRu maps Russian cross-field relation translations.
+var FieldRelationsRu = map[string]string{
+	"eq":  "равно",
+	"ne":  "не равно",
+	"gt":  "больше, чем",
+	"gte": "больше или равно",
+	"lt":  "меньше, чем",
+	"lte": "меньше или равно",
+}
+
 // FormatNounsRu maps Russian format noun translations.
 var FormatNounsRu = map[string]string{
 	"regex":            "ввод",
@@ -198,6 +208,10 @@ func formatRu(raw core.ZodRawIssue) string {
 		return "Неверные входные данные"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Повторяющийся элемент с индексом %v (впервые встречен с индексом %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Неверный элемент"
@@ -235,6 +249,20 @@ func formatRu(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Обнаружен нулевой указатель"
 
+	case core.Canceled:
+		return "Проверка отменена"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Входные данные соответствуют запрещённой схеме: " + description
+		}
+		return "Входные данные соответствуют запрещённой схеме"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Неверные входные данные: %[1]s должно быть %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsRu[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -288,6 +316,13 @@ func formatSizeConstraintRu(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Слишком маленькое значение: ожидалось, что массив будет содержать %s%s подходящих элементов", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Слишком большое значение: ожидалось, что массив будет содержать %s%s подходящих элементов", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		// Get the numeric value for plural form
 		thresholdInt := 0
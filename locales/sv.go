@@ -24,6 +24,16 @@ var SizableSv = map[string]issues.SizingInfo{
 	"map":    {Unit: "poster", Verb: "att innehålla"},
 }
 
+// FieldRelationsSv maps Swedish cross-field relation translations.
+var FieldRelationsSv = map[string]string{
+	"eq":  "lika med",
+	"ne":  "skild från",
+	"gt":  "större än",
+	"gte": "större än eller lika med",
+	"lt":  "mindre än",
+	"lte": "mindre än eller lika med",
+}
+
 // FormatNounsSv maps Swedish format noun translations.
 var FormatNounsSv = map[string]string{
 	"regex":            "reguljärt uttryck",
@@ -168,6 +178,10 @@ func formatSv(raw core.ZodRawIssue) string {
 		return "Ogiltig input"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Duplicerat element på index %v (först sett på index %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Ogiltigt element"
@@ -205,6 +219,20 @@ func formatSv(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Null-pekare upptäckt"
 
+	case core.Canceled:
+		return "Validering avbruten"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Indata matchar ett förbjudet schema: " + description
+		}
+		return "Indata matchar ett förbjudet schema"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Ogiltig input: %[1]s måste vara %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsSv[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintSv(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("För lite(t): förväntade att listan innehåller %s%s matchande element", adj, thresholdStr)
+		}
+		return fmt.Sprintf("För stor(t): förväntade att listan innehåller %s%s matchande element", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("För lite(t): förväntade %s %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
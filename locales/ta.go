@@ -23,6 +23,16 @@ var SizableTa = map[string]issues.SizingInfo{
 	"map":    {Unit: "உள்ளீடுகள்", Verb: "கொண்டிருக்க வேண்டும்"},
 }
 
+// FieldRelationsTa maps Tamil cross-field relation translations.
+var FieldRelationsTa = map[string]string{
+	"eq":  "க்கு சமமாக",
+	"ne":  "இலிருந்து வேறுபட்டதாக",
+	"gt":  "ஐ விட பெரியதாக",
+	"gte": "ஐ விட பெரியதாக அல்லது சமமாக",
+	"lt":  "ஐ விட சிறியதாக",
+	"lte": "ஐ விட சிறியதாக அல்லது சமமாக",
+}
+
 // FormatNounsTa maps Tamil format noun translations.
 var FormatNounsTa = map[string]string{
 	"regex":            "உள்ளீடு",
@@ -168,6 +178,10 @@ func formatTa(raw core.ZodRawIssue) string {
 		return "தவறான உள்ளீடு"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("குறியீடு %v இல் நகல் உறுப்பு (முதலில் குறியீடு %v இல் காணப்பட்டது)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "தவறான உறுப்பு"
@@ -205,6 +219,20 @@ func formatTa(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "வெற்று சுட்டி கண்டறியப்பட்டது"
 
+	case core.Canceled:
+		return "சரிபார்ப்பு ரத்து செய்யப்பட்டது"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "உள்ளீடு தடைசெய்யப்பட்ட திட்டத்துடன் பொருந்துகிறது: " + description
+		}
+		return "உள்ளீடு தடைசெய்யப்பட்ட திட்டத்துடன் பொருந்துகிறது"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("தவறான உள்ளீடு: %[1]s %[3]s %[2]s இருக்க வேண்டும்",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsTa[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintTa(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("மிகச் சிறியது: அணி %s%s பொருந்தும் உறுப்புகளைக் கொண்டிருக்க வேண்டும்", adj, thresholdStr)
+		}
+		return fmt.Sprintf("மிக பெரியது: அணி %s%s பொருந்தும் உறுப்புகளைக் கொண்டிருக்க வேண்டும்", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("மிகச் சிறியது: எதிர்பார்க்கப்பட்டது %s %s%s %s ஆக இருக்க வேண்டும்", origin, adj, thresholdStr, sizing.Unit)
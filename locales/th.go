@@ -23,6 +23,16 @@ var SizableTh = map[string]issues.SizingInfo{
 	"map":    {Unit: "รายการ", Verb: "ควรมี"},
 }
 
+// FieldRelationsTh maps Thai cross-field relation translations.
+var FieldRelationsTh = map[string]string{
+	"eq":  "เท่ากับ",
+	"ne":  "ไม่เท่ากับ",
+	"gt":  "มากกว่า",
+	"gte": "มากกว่าหรือเท่ากับ",
+	"lt":  "น้อยกว่า",
+	"lte": "น้อยกว่าหรือเท่ากับ",
+}
+
 // FormatNounsTh maps Thai format noun translations.
 var FormatNounsTh = map[string]string{
 	"regex":            "ข้อมูลที่ป้อน",
@@ -163,6 +173,10 @@ func formatTh(raw core.ZodRawIssue) string {
 		return "ข้อมูลไม่ถูกต้อง: ไม่ตรงกับรูปแบบยูเนียนที่กำหนดไว้"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("สมาชิกซ้ำที่ตำแหน่ง %v (พบครั้งแรกที่ตำแหน่ง %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "รายการไม่ถูกต้อง"
@@ -200,6 +214,20 @@ func formatTh(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "พบตัวชี้ที่ไม่มีค่า (null pointer)"
 
+	case core.Canceled:
+		return "การตรวจสอบถูกยกเลิก"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "ข้อมูลตรงกับสคีมาที่ห้ามใช้: " + description
+		}
+		return "ข้อมูลตรงกับสคีมาที่ห้ามใช้"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("ข้อมูลไม่ถูกต้อง: %[1]s ต้อง%[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsTh[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -253,6 +281,13 @@ func formatSizeConstraintTh(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("น้อยกว่ากำหนด: อาร์เรย์ควรมีสมาชิกที่ตรงเงื่อนไข%s %s รายการ", adj, thresholdStr)
+		}
+		return fmt.Sprintf("เกินกำหนด: อาร์เรย์ควรมีสมาชิกที่ตรงเงื่อนไข%s %s รายการ", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("น้อยกว่ากำหนด: %s ควรมี%s %s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableTr = map[string]issues.SizingInfo{
 	"map":    {Unit: "girdi", Verb: "olmalı"},
 }
 
+// FieldRelationsTr maps Turkish cross-field relation translations.
+var FieldRelationsTr = map[string]string{
+	"eq":  "değerine eşit",
+	"ne":  "değerinden farklı",
+	"gt":  "değerinden büyük",
+	"gte": "değerinden büyük veya ona eşit",
+	"lt":  "değerinden küçük",
+	"lte": "değerinden küçük veya ona eşit",
+}
+
 // FormatNounsTr maps Turkish format noun translations.
 var FormatNounsTr = map[string]string{
 	"regex":            "girdi",
@@ -167,6 +177,10 @@ func formatTr(raw core.ZodRawIssue) string {
 		return "Geçersiz değer"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("%v dizinindeki öğe yineleniyor (ilk olarak %v dizininde görüldü)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Geçersiz öğe"
@@ -204,6 +218,20 @@ func formatTr(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Boş işaretçi algılandı"
 
+	case core.Canceled:
+		return "Doğrulama iptal edildi"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Girdi yasaklanmış bir şemayla eşleşiyor: " + description
+		}
+		return "Girdi yasaklanmış bir şemayla eşleşiyor"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Geçersiz değer: %[1]s, %[3]s %[2]s olmalı",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsTr[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintTr(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Çok küçük: dizinin %s%s eşleşen öğe içermesi bekleniyordu", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Çok büyük: dizinin %s%s eşleşen öğe içermesi bekleniyordu", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Çok küçük: beklenen %s %s%s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableUk = map[string]issues.SizingInfo{
 	"map":    {Unit: "записів", Verb: "матиме"},
 }
 
+// FieldRelationsUk maps Ukrainian cross-field relation translations.
+var FieldRelationsUk = map[string]string{
+	"eq":  "рівним",
+	"ne":  "відмінним від",
+	"gt":  "більшим за",
+	"gte": "більшим або рівним",
+	"lt":  "меншим за",
+	"lte": "меншим або рівним",
+}
+
 // FormatNounsUk maps Ukrainian format noun translations.
 var FormatNounsUk = map[string]string{
 	"regex":            "вхідні дані",
@@ -167,6 +177,10 @@ func formatUk(raw core.ZodRawIssue) string {
 		return "Неправильні вхідні дані"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Дублікат елемента з індексом %v (вперше з індексом %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Неправильний елемент"
@@ -204,6 +218,20 @@ func formatUk(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Виявлено нульовий вказівник"
 
+	case core.Canceled:
+		return "Перевірку скасовано"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Вхідні дані відповідають забороненій схемі: " + description
+		}
+		return "Вхідні дані відповідають забороненій схемі"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Неправильні вхідні дані: %[1]s має бути %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsUk[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -257,6 +285,13 @@ func formatSizeConstraintUk(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Занадто мале: очікується, що масив міститиме %s%s відповідних елементів", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Занадто велике: очікується, що масив міститиме %s%s відповідних елементів", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Занадто мале: очікується, що %s %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableUr = map[string]issues.SizingInfo{
 	"map":    {Unit: "اندراجات", Verb: "ہونا"},
 }
 
+// FieldRelationsUr maps Urdu cross-field relation translations.
+var FieldRelationsUr = map[string]string{
+	"eq":  "کے برابر",
+	"ne":  "سے مختلف",
+	"gt":  "سے بڑا",
+	"gte": "سے بڑا یا برابر",
+	"lt":  "سے چھوٹا",
+	"lte": "سے چھوٹا یا برابر",
+}
+
 // FormatNounsUr maps Urdu format noun translations.
 var FormatNounsUr = map[string]string{
 	"regex":            "ان پٹ",
@@ -168,6 +178,10 @@ func formatUr(raw core.ZodRawIssue) string {
 		return "غلط ان پٹ"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("انڈیکس %v پر دہرایا گیا عنصر (پہلی بار انڈیکس %v پر)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "غلط عنصر"
@@ -205,6 +219,20 @@ func formatUr(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "نل پوائنٹر پایا گیا"
 
+	case core.Canceled:
+		return "توثیق منسوخ کر دی گئی"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "ان پٹ ایک ممنوعہ اسکیما سے مطابقت رکھتا ہے: " + description
+		}
+		return "ان پٹ ایک ممنوعہ اسکیما سے مطابقت رکھتا ہے"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("غلط ان پٹ: %[1]s کو %[3]s %[2]s ہونا چاہیے",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsUr[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -258,6 +286,13 @@ func formatSizeConstraintUr(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("بہت چھوٹا: array میں %s%s مماثل عناصر ہونے متوقع تھے", adj, thresholdStr)
+		}
+		return fmt.Sprintf("بہت بڑا: array میں %s%s مماثل عناصر ہونے متوقع تھے", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("بہت چھوٹا: %s کے %s%s %s ہونے متوقع تھے", origin, adj, thresholdStr, sizing.Unit)
@@ -23,6 +23,16 @@ var SizableVi = map[string]issues.SizingInfo{
 	"map":    {Unit: "mục", Verb: "có"},
 }
 
+// FieldRelationsVi maps Vietnamese cross-field relation translations.
+var FieldRelationsVi = map[string]string{
+	"eq":  "bằng",
+	"ne":  "khác",
+	"gt":  "lớn hơn",
+	"gte": "lớn hơn hoặc bằng",
+	"lt":  "nhỏ hơn",
+	"lte": "nhỏ hơn hoặc bằng",
+}
+
 // FormatNounsVi maps Vietnamese format noun translations.
 var FormatNounsVi = map[string]string{
 	"regex":            "đầu vào",
@@ -163,6 +173,10 @@ func formatVi(raw core.ZodRawIssue) string {
 		return "Đầu vào không hợp lệ"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("Phần tử trùng lặp tại chỉ mục %v (xuất hiện lần đầu tại chỉ mục %v)", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "Phần tử không hợp lệ"
@@ -200,6 +214,20 @@ func formatVi(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "Phát hiện con trỏ null"
 
+	case core.Canceled:
+		return "Đã hủy xác thực"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "Đầu vào khớp với một lược đồ bị cấm: " + description
+		}
+		return "Đầu vào khớp với một lược đồ bị cấm"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("Đầu vào không hợp lệ: %[1]s phải %[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsVi[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -253,6 +281,13 @@ func formatSizeConstraintVi(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("Quá nhỏ: mong đợi mảng chứa %s%s phần tử khớp", adj, thresholdStr)
+		}
+		return fmt.Sprintf("Quá lớn: mong đợi mảng chứa %s%s phần tử khớp", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("Quá nhỏ: mong đợi %s %s %s%s %s", origin, sizing.Verb, adj, thresholdStr, sizing.Unit)
@@ -76,6 +76,16 @@ func getSizingZh(origin string) *issues.SizingInfo {
 	return nil
 }
 
+// FieldRelationsZh maps Chinese cross-field relation translations.
+var FieldRelationsZh = map[string]string{
+	"eq":  "等于",
+	"ne":  "不等于",
+	"gt":  "大于",
+	"gte": "大于或等于",
+	"lt":  "小于",
+	"lte": "小于或等于",
+}
+
 // FormatNounsZh maps Chinese format noun translations.
 var FormatNounsZh = map[string]string{
 	"regex":            "输入",
@@ -230,6 +240,10 @@ func formatZhCN(raw core.ZodRawIssue) string {
 		return "无效输入"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("索引 %v 处的元素重复（首次出现在索引 %v）", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "元素无效"
@@ -268,6 +282,20 @@ func formatZhCN(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "遇到空指针"
 
+	case core.Canceled:
+		return "验证已取消"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "输入匹配了被禁止的模式: " + description
+		}
+		return "输入匹配了被禁止的模式"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("无效输入: %[1]s 必须%[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsZh[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -311,6 +339,13 @@ func formatSizeConstraintZh(raw core.ZodRawIssue, isTooSmall bool) string {
 	sizing := getSizingZh(origin)
 	thresholdStr := issues.FormatThreshold(threshold)
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("数值过小：期望数组包含 %s%s 个匹配元素", adj, thresholdStr)
+		}
+		return fmt.Sprintf("数值过大：期望数组包含 %s%s 个匹配元素", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("数值过小：期望 %s %s%s %s", origin, adj, thresholdStr, sizing.Unit)
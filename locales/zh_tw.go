@@ -23,6 +23,16 @@ var SizableZhTw = map[string]issues.SizingInfo{
 	"map":    {Unit: "項目", Verb: "擁有"},
 }
 
+// FieldRelationsZhTw maps Traditional Chinese cross-field relation translations.
+var FieldRelationsZhTw = map[string]string{
+	"eq":  "等於",
+	"ne":  "不等於",
+	"gt":  "大於",
+	"gte": "大於或等於",
+	"lt":  "小於",
+	"lte": "小於或等於",
+}
+
 // FormatNounsZhTw maps Traditional Chinese format noun translations.
 var FormatNounsZhTw = map[string]string{
 	"regex":            "輸入",
@@ -163,6 +173,10 @@ func formatZhTw(raw core.ZodRawIssue) string {
 		return "無效的輸入值"
 
 	case core.InvalidElement:
+		if mapx.StringOr(raw.Properties, "origin", "") == "unique" {
+			params, _ := raw.Properties["params"].(map[string]any)
+			return fmt.Sprintf("索引 %v 處的元素重複（首次出現在索引 %v）", raw.Properties["index"], params["firstIndex"])
+		}
 		origin := mapx.StringOr(raw.Properties, "origin", "")
 		if origin == "" {
 			return "無效的元素"
@@ -200,6 +214,20 @@ func formatZhTw(raw core.ZodRawIssue) string {
 	case core.NilPointer:
 		return "偵測到空指標"
 
+	case core.Canceled:
+		return "驗證已取消"
+
+	case core.InvalidNot:
+		if description := mapx.StringOr(raw.Properties, "description", ""); description != "" {
+			return "輸入符合了被禁止的模式: " + description
+		}
+		return "輸入符合了被禁止的模式"
+
+	case core.InvalidFieldComparison:
+		relation, other := issues.FieldComparisonParams(raw)
+		return fmt.Sprintf("無效的輸入值: %[1]s 必須%[2]s %[3]s",
+			mapx.StringOr(raw.Properties, "field_name", ""), FieldRelationsZhTw[relation], other)
+
 	case core.Custom:
 		message := mapx.StringOr(raw.Properties, "message", "")
 		if message != "" {
@@ -253,6 +281,13 @@ func formatSizeConstraintZhTw(raw core.ZodRawIssue, isTooSmall bool) string {
 		}
 	}
 
+	if origin == "contains" {
+		if isTooSmall {
+			return fmt.Sprintf("數值過小：預期陣列包含 %s%s 個相符元素", adj, thresholdStr)
+		}
+		return fmt.Sprintf("數值過大：預期陣列包含 %s%s 個相符元素", adj, thresholdStr)
+	}
+
 	if sizing != nil {
 		if isTooSmall {
 			return fmt.Sprintf("數值過小：預期 %s 應為 %s%s %s", origin, adj, thresholdStr, sizing.Unit)
@@ -0,0 +1,456 @@
+// Package openapi generates OpenAPI 3.1 documents from GoZod schema registries.
+package openapi
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-json-experiment/json"
+	lib "github.com/kaptinlin/jsonschema"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/jsonschema"
+)
+
+// Version is the OpenAPI version emitted by Generate.
+const Version = "3.1.0"
+
+const (
+	componentsRefPrefix = "#/components/schemas/"
+	defaultContentType  = "application/json"
+)
+
+// Document generation errors.
+var (
+	ErrInvalidOperation  = errors.New("invalid operation")
+	ErrInvalidParameters = errors.New("invalid operation parameters")
+	ErrInvalidResponse   = errors.New("invalid operation response")
+)
+
+// Operation describes one API operation to include in the document.
+type Operation struct {
+	// Method is the HTTP method, such as "GET" or "post". It is case-insensitive.
+	Method string
+	// Path is the templated path, such as "/users/{id}".
+	Path string
+
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+
+	// Params holds one object schema per parameter location.
+	Params Params
+	// Request describes the request body. Nil means the operation has none.
+	Request *Request
+	// Responses maps status codes ("200", "4XX", "default") to responses.
+	Responses map[string]Response
+}
+
+// Params holds the object schemas whose properties become the parameters of
+// an operation. Each property is one parameter; required properties become
+// required parameters.
+type Params struct {
+	Path   core.ZodSchema
+	Query  core.ZodSchema
+	Header core.ZodSchema
+	Cookie core.ZodSchema
+}
+
+// Request describes an operation's request body.
+type Request struct {
+	Description string
+	// ContentType defaults to "application/json".
+	ContentType string
+	// Schema is the body schema. The body is required unless Schema is optional.
+	Schema core.ZodSchema
+}
+
+// Response describes one operation response.
+type Response struct {
+	// Description defaults to the HTTP status text of a numeric status code,
+	// the status class of a range such as "4XX", or "Default response".
+	Description string
+	// ContentType defaults to "application/json".
+	ContentType string
+	// Schema is the body schema. Nil means the response has no body.
+	Schema core.ZodSchema
+}
+
+// Options configures document generation.
+type Options struct {
+	Info    Info
+	Servers []Server
+
+	// Unrepresentable and IO are passed to the JSON Schema converter.
+	Unrepresentable jsonschema.UnrepresentableMode
+	IO              jsonschema.IOMode
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components,omitempty"`
+}
+
+// Info is the OpenAPI Info Object.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Server is the OpenAPI Server Object.
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem is the OpenAPI Path Item Object.
+type PathItem struct {
+	Get     *OperationObject `json:"get,omitempty"`
+	Put     *OperationObject `json:"put,omitempty"`
+	Post    *OperationObject `json:"post,omitempty"`
+	Delete  *OperationObject `json:"delete,omitempty"`
+	Options *OperationObject `json:"options,omitempty"`
+	Head    *OperationObject `json:"head,omitempty"`
+	Patch   *OperationObject `json:"patch,omitempty"`
+	Trace   *OperationObject `json:"trace,omitempty"`
+}
+
+// OperationObject is the OpenAPI Operation Object.
+type OperationObject struct {
+	Tags        []string                   `json:"tags,omitempty"`
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	OperationID string                     `json:"operationId,omitempty"`
+	Parameters  []*ParameterObject         `json:"parameters,omitempty"`
+	RequestBody *RequestBodyObject         `json:"requestBody,omitempty"`
+	Responses   map[string]*ResponseObject `json:"responses,omitempty"`
+	Deprecated  bool                       `json:"deprecated,omitzero"`
+}
+
+// ParameterObject is the OpenAPI Parameter Object.
+type ParameterObject struct {
+	Name     string      `json:"name"`
+	In       string      `json:"in"`
+	Required bool        `json:"required,omitzero"`
+	Schema   *lib.Schema `json:"schema"`
+}
+
+// RequestBodyObject is the OpenAPI Request Body Object.
+type RequestBodyObject struct {
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content"`
+	Required    bool                  `json:"required,omitzero"`
+}
+
+// ResponseObject is the OpenAPI Response Object.
+type ResponseObject struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the OpenAPI Media Type Object.
+type MediaType struct {
+	Schema *lib.Schema `json:"schema"`
+}
+
+// Components is the OpenAPI Components Object.
+type Components struct {
+	Schemas map[string]*lib.Schema `json:"schemas,omitempty"`
+}
+
+// MarshalJSON encodes the document with map keys in sorted order, so equal
+// documents always encode to equal bytes.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	type alias Document
+	return json.Marshal((*alias)(d), json.Deterministic(true))
+}
+
+var (
+	pathTemplatePattern = regexp.MustCompile(`\{([^{}]+)\}`)
+	statusPattern       = regexp.MustCompile(`^[1-5]([0-9]{2}|XX)$`)
+)
+
+// Generate builds an OpenAPI 3.1 document for operations. Every schema in the
+// registry is emitted under components/schemas by its ID, and operation
+// schemas reference registered schemas instead of inlining them. Schemas are
+// converted in one pass, so the document is the same on every run.
+func Generate(
+	registry *core.Registry[core.GlobalMeta],
+	operations []Operation,
+	opts ...Options,
+) (*Document, error) {
+	var options Options
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if registry == nil {
+		registry = core.NewRegistry[core.GlobalMeta]()
+	}
+
+	// Collect every operation schema, in order, for one bundle conversion.
+	var schemas []core.ZodSchema
+	for i := range operations {
+		op := &operations[i]
+		if err := validateOperation(op); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, op.Params.Path, op.Params.Query, op.Params.Header, op.Params.Cookie)
+		if op.Request != nil {
+			schemas = append(schemas, op.Request.Schema)
+		}
+		for _, status := range slices.Sorted(maps.Keys(op.Responses)) {
+			schemas = append(schemas, op.Responses[status].Schema)
+		}
+	}
+	if err := validateOperationSet(operations); err != nil {
+		return nil, err
+	}
+
+	bundle, err := jsonschema.ToJSONSchemaBundle(registry, schemas, jsonschema.Options{
+		Unrepresentable: options.Unrepresentable,
+		IO:              options.IO,
+		Target:          jsonschema.TargetOpenAPI31,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    options.Info,
+		Servers: options.Servers,
+		Paths:   make(map[string]*PathItem),
+	}
+	if len(bundle.Defs) > 0 {
+		doc.Components = &Components{Schemas: bundle.Defs}
+	}
+
+	next := bundle.Schemas
+	take := func() *lib.Schema {
+		s := next[0]
+		next = next[1:]
+		return s
+	}
+	for i := range operations {
+		op := &operations[i]
+		object := &OperationObject{
+			Tags:        op.Tags,
+			Summary:     op.Summary,
+			Description: op.Description,
+			OperationID: op.OperationID,
+			Deprecated:  op.Deprecated,
+		}
+		for _, in := range []string{"path", "query", "header", "cookie"} {
+			params, err := parameters(in, take(), bundle.Defs)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s %s", err, op.Method, op.Path)
+			}
+			object.Parameters = append(object.Parameters, params...)
+		}
+		if err := checkPathParameters(op, object.Parameters); err != nil {
+			return nil, err
+		}
+		if op.Request != nil {
+			object.RequestBody = &RequestBodyObject{
+				Description: op.Request.Description,
+				Content:     content(op.Request.ContentType, take()),
+				Required:    !op.Request.Schema.Internals().IsOptional(),
+			}
+		}
+		if len(op.Responses) > 0 {
+			object.Responses = make(map[string]*ResponseObject, len(op.Responses))
+		}
+		for _, status := range slices.Sorted(maps.Keys(op.Responses)) {
+			response := op.Responses[status]
+			object.Responses[status] = &ResponseObject{
+				Description: responseDescription(status, response.Description),
+				Content:     content(response.ContentType, take()),
+			}
+		}
+
+		item := doc.Paths[op.Path]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[op.Path] = item
+		}
+		*item.method(op.Method) = object
+	}
+	return doc, nil
+}
+
+// validateOperation checks the descriptor fields Generate relies on.
+func validateOperation(op *Operation) error {
+	if !strings.HasPrefix(op.Path, "/") {
+		return fmt.Errorf("%w: path %q must start with /", ErrInvalidOperation, op.Path)
+	}
+	if (&PathItem{}).method(op.Method) == nil {
+		return fmt.Errorf("%w: unsupported method %q for %s", ErrInvalidOperation, op.Method, op.Path)
+	}
+	if op.Request != nil && op.Request.Schema == nil {
+		return fmt.Errorf("%w: request body schema is nil for %s %s", ErrInvalidOperation, op.Method, op.Path)
+	}
+	for status := range op.Responses {
+		if status != "default" && !statusPattern.MatchString(status) {
+			return fmt.Errorf("%w: status %q for %s %s", ErrInvalidResponse, status, op.Method, op.Path)
+		}
+	}
+	return nil
+}
+
+// validateOperationSet rejects operations that would overwrite each other.
+func validateOperationSet(operations []Operation) error {
+	routes := make(map[string]struct{}, len(operations))
+	ids := make(map[string]struct{}, len(operations))
+	for _, op := range operations {
+		route := strings.ToUpper(op.Method) + " " + op.Path
+		if _, exists := routes[route]; exists {
+			return fmt.Errorf("%w: duplicate route %s", ErrInvalidOperation, route)
+		}
+		routes[route] = struct{}{}
+		if op.OperationID == "" {
+			continue
+		}
+		if _, exists := ids[op.OperationID]; exists {
+			return fmt.Errorf("%w: duplicate operationId %q", ErrInvalidOperation, op.OperationID)
+		}
+		ids[op.OperationID] = struct{}{}
+	}
+	return nil
+}
+
+// method returns the field of the path item that holds method, or nil for
+// an unsupported method.
+func (p *PathItem) method(method string) **OperationObject {
+	switch strings.ToUpper(method) {
+	case http.MethodGet:
+		return &p.Get
+	case http.MethodPut:
+		return &p.Put
+	case http.MethodPost:
+		return &p.Post
+	case http.MethodDelete:
+		return &p.Delete
+	case http.MethodOptions:
+		return &p.Options
+	case http.MethodHead:
+		return &p.Head
+	case http.MethodPatch:
+		return &p.Patch
+	case http.MethodTrace:
+		return &p.Trace
+	default:
+		return nil
+	}
+}
+
+// parameters turns a converted parameter object schema into one parameter
+// per property, ordered by name.
+func parameters(in string, schema *lib.Schema, defs map[string]*lib.Schema) ([]*ParameterObject, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	schema = resolve(schema, defs)
+	if schema.Properties == nil {
+		return nil, fmt.Errorf("%w: %s parameters must be an object schema", ErrInvalidParameters, in)
+	}
+	properties := *schema.Properties
+	params := make([]*ParameterObject, 0, len(properties))
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		required := slices.Contains(schema.Required, name)
+		if in == "path" && !required {
+			return nil, fmt.Errorf("%w: path parameter %q must be required", ErrInvalidParameters, name)
+		}
+		params = append(params, &ParameterObject{
+			Name:     name,
+			In:       in,
+			Required: required,
+			Schema:   properties[name],
+		})
+	}
+	return params, nil
+}
+
+// resolve follows component references to the schema they name.
+func resolve(schema *lib.Schema, defs map[string]*lib.Schema) *lib.Schema {
+	for range len(defs) {
+		name, ok := strings.CutPrefix(schema.Ref, componentsRefPrefix)
+		if !ok || defs[name] == nil {
+			break
+		}
+		schema = defs[name]
+	}
+	return schema
+}
+
+// checkPathParameters matches the path template against the path parameters.
+func checkPathParameters(op *Operation, params []*ParameterObject) error {
+	declared := make(map[string]bool)
+	for _, param := range params {
+		if param.In == "path" {
+			declared[param.Name] = false
+		}
+	}
+	for _, match := range pathTemplatePattern.FindAllStringSubmatch(op.Path, -1) {
+		name := match[1]
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("%w: %s %s has no path parameter %q", ErrInvalidParameters, op.Method, op.Path, name)
+		}
+		declared[name] = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(declared)) {
+		if !declared[name] {
+			return fmt.Errorf("%w: path parameter %q is not in %s", ErrInvalidParameters, name, op.Path)
+		}
+	}
+	return nil
+}
+
+// content returns the media type map for a body, or nil when there is none.
+func content(contentType string, schema *lib.Schema) map[string]*MediaType {
+	if schema == nil {
+		return nil
+	}
+	return map[string]*MediaType{cmp.Or(contentType, defaultContentType): {Schema: schema}}
+}
+
+// statusClassDescriptions describes each status class, keyed by its first
+// digit, for ranges such as "4XX" and codes without a status text.
+var statusClassDescriptions = map[byte]string{
+	'1': "Informational response",
+	'2': "Successful response",
+	'3': "Redirection",
+	'4': "Client error",
+	'5': "Server error",
+}
+
+// responseDescription defaults an empty description to the status text, the
+// status class description, or "Default response" for the default response,
+// since OpenAPI requires every response to have a description.
+func responseDescription(status, description string) string {
+	if description != "" {
+		return description
+	}
+	if status == "default" {
+		return "Default response"
+	}
+	if code, err := strconv.Atoi(status); err == nil {
+		if text := http.StatusText(code); text != "" {
+			return text
+		}
+	}
+	return statusClassDescriptions[status[0]]
+}
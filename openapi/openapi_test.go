@@ -0,0 +1,277 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/types"
+)
+
+func marshalDocument(t *testing.T, doc *Document) string {
+	t.Helper()
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestGenerate(t *testing.T) {
+	user := types.Object(core.ObjectSchema{
+		"id":   types.String(),
+		"name": types.String().Min(1),
+	})
+	problem := types.Object(core.ObjectSchema{"message": types.String()})
+	registry := core.NewRegistry[core.GlobalMeta]().
+		Add(user, core.GlobalMeta{ID: "User"}).
+		Add(problem, core.GlobalMeta{ID: "Problem"})
+
+	operations := []Operation{
+		{
+			Method:      "post",
+			Path:        "/users",
+			OperationID: "createUser",
+			Tags:        []string{"users"},
+			Request:     &Request{Schema: user},
+			Responses: map[string]Response{
+				"201":     {Schema: user},
+				"default": {Description: "Failure", Schema: problem},
+			},
+		},
+		{
+			Method:      "GET",
+			Path:        "/users/{id}",
+			OperationID: "getUser",
+			Summary:     "Fetch a user",
+			Params: Params{
+				Path:  types.Object(core.ObjectSchema{"id": types.String()}),
+				Query: types.Object(core.ObjectSchema{"expand": types.Bool().Optional()}),
+			},
+			Responses: map[string]Response{
+				"200": {Schema: types.Object(core.ObjectSchema{"user": user})},
+				"404": {},
+			},
+		},
+	}
+
+	doc, err := Generate(registry, operations, Options{
+		Info:    Info{Title: "Users", Version: "1.0.0"},
+		Servers: []Server{{URL: "https://api.example.com"}},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"openapi": "3.1.0",
+		"info": {"title": "Users", "version": "1.0.0"},
+		"servers": [{"url": "https://api.example.com"}],
+		"paths": {
+			"/users": {
+				"post": {
+					"tags": ["users"],
+					"operationId": "createUser",
+					"requestBody": {
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}},
+						"required": true
+					},
+					"responses": {
+						"201": {
+							"description": "Created",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}}
+						},
+						"default": {
+							"description": "Failure",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Problem"}}}
+						}
+					}
+				}
+			},
+			"/users/{id}": {
+				"get": {
+					"summary": "Fetch a user",
+					"operationId": "getUser",
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}},
+						{"name": "expand", "in": "query", "schema": {"type": "boolean"}}
+					],
+					"responses": {
+						"200": {
+							"description": "OK",
+							"content": {"application/json": {"schema": {
+								"type": "object",
+								"properties": {"user": {"$ref": "#/components/schemas/User"}},
+								"required": ["user"],
+								"additionalProperties": false
+							}}}
+						},
+						"404": {"description": "Not Found"}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"Problem": {
+					"type": "object",
+					"properties": {"message": {"type": "string"}},
+					"required": ["message"],
+					"additionalProperties": false
+				},
+				"User": {
+					"type": "object",
+					"properties": {"id": {"type": "string"}, "name": {"type": "string", "minLength": 1}},
+					"required": ["name", "id"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`, marshalDocument(t, doc))
+
+	t.Run("is deterministic", func(t *testing.T) {
+		again, err := Generate(registry, operations, Options{
+			Info:    Info{Title: "Users", Version: "1.0.0"},
+			Servers: []Server{{URL: "https://api.example.com"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, marshalDocument(t, doc), marshalDocument(t, again))
+	})
+}
+
+func TestGenerateWithoutRegistry(t *testing.T) {
+	doc, err := Generate(nil, []Operation{{
+		Method:  "PUT",
+		Path:    "/settings",
+		Request: &Request{ContentType: "application/merge-patch+json", Schema: types.Map(types.String(), types.String()).Optional()},
+		Responses: map[string]Response{
+			"204": {},
+		},
+	}}, Options{Info: Info{Title: "Settings", Version: "2"}})
+	require.NoError(t, err)
+	assert.Nil(t, doc.Components)
+	assert.False(t, doc.Paths["/settings"].Put.RequestBody.Required)
+	assert.Contains(t, doc.Paths["/settings"].Put.RequestBody.Content, "application/merge-patch+json")
+	assert.Equal(t, "No Content", doc.Paths["/settings"].Put.Responses["204"].Description)
+}
+
+func TestGenerateDefaultsResponseDescriptions(t *testing.T) {
+	doc, err := Generate(nil, []Operation{{
+		Method: "GET",
+		Path:   "/items",
+		Responses: map[string]Response{
+			"200":     {},
+			"299":     {},
+			"4XX":     {},
+			"5XX":     {},
+			"default": {},
+		},
+	}})
+	require.NoError(t, err)
+	responses := doc.Paths["/items"].Get.Responses
+	assert.Equal(t, "OK", responses["200"].Description)
+	assert.Equal(t, "Successful response", responses["299"].Description)
+	assert.Equal(t, "Client error", responses["4XX"].Description)
+	assert.Equal(t, "Server error", responses["5XX"].Description)
+	assert.Equal(t, "Default response", responses["default"].Description)
+}
+
+func TestGenerateUsesRegisteredParameterObjects(t *testing.T) {
+	params := types.Object(core.ObjectSchema{"page": types.Int().Optional()})
+	registry := core.NewRegistry[core.GlobalMeta]().Add(params, core.GlobalMeta{ID: "Paging"})
+
+	doc, err := Generate(registry, []Operation{{
+		Method: "GET",
+		Path:   "/items",
+		Params: Params{Query: params},
+	}})
+	require.NoError(t, err)
+	require.Len(t, doc.Paths["/items"].Get.Parameters, 1)
+	assert.Equal(t, "page", doc.Paths["/items"].Get.Parameters[0].Name)
+	assert.Contains(t, doc.Components.Schemas, "Paging")
+}
+
+func TestGenerateRejectsInvalidOperations(t *testing.T) {
+	id := types.Object(core.ObjectSchema{"id": types.String()})
+	tests := []struct {
+		name       string
+		operations []Operation
+		target     error
+		contains   string
+	}{
+		{
+			name:       "relative path",
+			operations: []Operation{{Method: "GET", Path: "users"}},
+			target:     ErrInvalidOperation,
+			contains:   "must start with /",
+		},
+		{
+			name:       "unsupported method",
+			operations: []Operation{{Method: "CONNECT", Path: "/users"}},
+			target:     ErrInvalidOperation,
+			contains:   `unsupported method "CONNECT"`,
+		},
+		{
+			name:       "duplicate route",
+			operations: []Operation{{Method: "get", Path: "/users"}, {Method: "GET", Path: "/users"}},
+			target:     ErrInvalidOperation,
+			contains:   "duplicate route GET /users",
+		},
+		{
+			name: "duplicate operation ID",
+			operations: []Operation{
+				{Method: "GET", Path: "/a", OperationID: "same"},
+				{Method: "GET", Path: "/b", OperationID: "same"},
+			},
+			target:   ErrInvalidOperation,
+			contains: `duplicate operationId "same"`,
+		},
+		{
+			name:       "nil request schema",
+			operations: []Operation{{Method: "POST", Path: "/users", Request: &Request{}}},
+			target:     ErrInvalidOperation,
+			contains:   "request body schema is nil",
+		},
+		{
+			name:       "invalid status",
+			operations: []Operation{{Method: "GET", Path: "/users", Responses: map[string]Response{"ok": {}}}},
+			target:     ErrInvalidResponse,
+			contains:   `status "ok"`,
+		},
+		{
+			name:       "undeclared path parameter",
+			operations: []Operation{{Method: "GET", Path: "/users/{id}"}},
+			target:     ErrInvalidParameters,
+			contains:   `no path parameter "id"`,
+		},
+		{
+			name:       "unused path parameter",
+			operations: []Operation{{Method: "GET", Path: "/users", Params: Params{Path: id}}},
+			target:     ErrInvalidParameters,
+			contains:   `path parameter "id" is not in /users`,
+		},
+		{
+			name: "optional path parameter",
+			operations: []Operation{{
+				Method: "GET",
+				Path:   "/users/{id}",
+				Params: Params{Path: types.Object(core.ObjectSchema{"id": types.String().Optional()})},
+			}},
+			target:   ErrInvalidParameters,
+			contains: `path parameter "id" must be required`,
+		},
+		{
+			name:       "non-object parameters",
+			operations: []Operation{{Method: "GET", Path: "/users", Params: Params{Query: types.String()}}},
+			target:     ErrInvalidParameters,
+			contains:   "query parameters must be an object schema",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Generate(nil, tt.operations)
+			assert.Nil(t, doc)
+			require.ErrorIs(t, err, tt.target)
+			assert.ErrorContains(t, err, tt.contains)
+		})
+	}
+}
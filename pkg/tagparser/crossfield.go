@@ -0,0 +1,107 @@
+package tagparser
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FieldComparison is a compiled eqfield, nefield, gtfield, gtefield, ltfield
+// or ltefield rule: the field under Field compared by Op with the field under
+// Other. Both are field keys.
+type FieldComparison struct {
+	Field string
+	Op    RuleOp
+	Other string
+}
+
+// FieldCondition is one compiled required_if condition: the field under Field
+// holds Value, compiled for that field's type.
+type FieldCondition struct {
+	Field string
+	Value any
+}
+
+// CrossFieldPlan holds the rules of a struct that relate fields to their
+// siblings, keyed by field key.
+type CrossFieldPlan struct {
+	// Comparisons lists the comparison rules in field order.
+	Comparisons []FieldComparison
+	// RequiredIf maps a field to the conditions under which it is required.
+	RequiredIf map[string][]FieldCondition
+}
+
+// CompileCrossFieldPlan collects the cross-field rules of a struct's fields.
+// Named fields are resolved by Go field name or field key. A comparison needs
+// a sibling of the same field family; required_if takes field and value pairs,
+// as in required_if=Status active, and requires the field when every pair
+// holds.
+func CompileCrossFieldPlan(fields []FieldInfo) (CrossFieldPlan, error) {
+	var plan CrossFieldPlan
+	for _, field := range fields {
+		for _, rule := range field.Rules {
+			definition, ok := ruleDefinitions[rule.Name]
+			if !ok {
+				continue
+			}
+			fail := func(err error) (CrossFieldPlan, error) {
+				return CrossFieldPlan{}, &CompileError{Field: field.Name, Rule: rawRule(rule), Err: err}
+			}
+			switch definition.op {
+			case RuleEqField, RuleNeField, RuleGTField, RuleGTEField, RuleLTField, RuleLTEField:
+				if len(rule.Params) != 1 {
+					return fail(ErrInvalidArity)
+				}
+				other, ok := siblingField(fields, rule.Params[0])
+				if !ok {
+					return fail(fmt.Errorf("%w: %s", ErrUnknownField, rule.Params[0]))
+				}
+				if family := fieldFamily(other.Type); family != fieldFamily(field.Type) {
+					return fail(fmt.Errorf("%w: %s is %v", ErrInapplicableRule, other.Name, other.Type))
+				}
+				plan.Comparisons = append(plan.Comparisons, FieldComparison{
+					Field: field.FieldKey,
+					Op:    definition.op,
+					Other: other.FieldKey,
+				})
+			case RuleRequiredIf:
+				if len(rule.Params) == 0 || len(rule.Params)%2 != 0 {
+					return fail(ErrInvalidArity)
+				}
+				conditions := make([]FieldCondition, 0, len(rule.Params)/2)
+				for i := 0; i < len(rule.Params); i += 2 {
+					other, ok := siblingField(fields, rule.Params[i])
+					if !ok {
+						return fail(fmt.Errorf("%w: %s", ErrUnknownField, rule.Params[i]))
+					}
+					family := fieldFamily(other.Type)
+					if !slices.Contains(scalarFamilies, family) {
+						return fail(fmt.Errorf("%w: %s is %v", ErrInapplicableRule, other.Name, other.Type))
+					}
+					value, err := compileScalarValue(rule.Params[i+1], family, other.Type)
+					if err != nil {
+						return fail(fmt.Errorf("%w: %w", ErrInvalidOperand, err))
+					}
+					conditions = append(conditions, FieldCondition{Field: other.FieldKey, Value: value})
+				}
+				if plan.RequiredIf == nil {
+					plan.RequiredIf = make(map[string][]FieldCondition)
+				}
+				plan.RequiredIf[field.FieldKey] = conditions
+			default:
+				// Other rules describe the field alone.
+			}
+		}
+	}
+	return plan, nil
+}
+
+// siblingField finds the field named by Go field name or field key.
+func siblingField(fields []FieldInfo, name string) (FieldInfo, bool) {
+	i := slices.IndexFunc(fields, func(f FieldInfo) bool {
+		return f.Name == name || f.FieldKey == name
+	})
+	if i < 0 {
+		return FieldInfo{}, false
+	}
+	return fields[i], true
+}
@@ -0,0 +1,160 @@
+package tagparser
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// StructField is a field of a struct as validation sees it: an exported field
+// the struct declares, or one promoted from an anonymous struct embed. Index
+// is the full path for reflect.Value.FieldByIndex and Key the name the
+// field-name tag gives the field.
+type StructField struct {
+	reflect.StructField
+	Key FieldNameResult
+}
+
+// FieldCandidate is a field competing for a key in the field set of a struct:
+// the key, the number of anonymous embeds it is promoted through, and whether
+// the field-name tag names it explicitly.
+type FieldCandidate struct {
+	Key    string
+	Depth  int
+	Tagged bool
+}
+
+// DominantFields returns, in order, the indexes of the candidates that
+// encoding/json keeps: for each key the shallowest candidate, or the only
+// tagged one when several share that depth. A key that stays ambiguous keeps
+// no candidate.
+func DominantFields(candidates []FieldCandidate) []int {
+	byKey := make(map[string][]int, len(candidates))
+	for i, candidate := range candidates {
+		byKey[candidate.Key] = append(byKey[candidate.Key], i)
+	}
+	keep := make([]bool, len(candidates))
+	for _, group := range byKey {
+		depth := candidates[group[0]].Depth
+		for _, i := range group {
+			depth = min(depth, candidates[i].Depth)
+		}
+		var shallowest, tagged []int
+		for _, i := range group {
+			if candidates[i].Depth != depth {
+				continue
+			}
+			shallowest = append(shallowest, i)
+			if candidates[i].Tagged {
+				tagged = append(tagged, i)
+			}
+		}
+		switch {
+		case len(shallowest) == 1:
+			keep[shallowest[0]] = true
+		case len(tagged) == 1:
+			keep[tagged[0]] = true
+		}
+	}
+	indexes := make([]int, 0, len(byKey))
+	for i, kept := range keep {
+		if kept {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// VisibleFields returns the fields of the struct type typ keyed by the
+// field-name tag, promoting the exported fields of anonymous struct embeds as
+// encoding/json does. An embed the field-name tag names stays a single field,
+// and embedded pointers to unexported struct types are ignored because their
+// fields cannot be set. Fields the tag skips are kept, marked Skip, only when
+// the struct declares them itself.
+func VisibleFields(fieldNameTag string, typ reflect.Type) []StructField {
+	structType, ok := normalizedStructType(typ)
+	if !ok {
+		return nil
+	}
+	if strings.TrimSpace(fieldNameTag) == "" {
+		fieldNameTag = defaultFieldNameTag
+	}
+
+	type embed struct {
+		typ   reflect.Type
+		index []int
+	}
+	var (
+		visible    []StructField
+		promoted   []StructField
+		candidates []FieldCandidate
+	)
+	visited := make(map[reflect.Type]bool)
+	current := []embed{{typ: structType}}
+	for depth := 0; len(current) > 0; depth++ {
+		var next []embed
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			for f := range e.typ.Fields() {
+				f.Index = append(slices.Clone(e.index), f.Index...)
+				key := FieldName(fieldNameTag, f)
+				tagged := NamedByTag(fieldNameTag, f)
+				if f.Anonymous && !key.Skip && !tagged {
+					if embedded, ok := embeddedStructType(f); ok {
+						next = append(next, embed{typ: embedded, index: f.Index})
+						continue
+					}
+				}
+				if !f.IsExported() {
+					continue
+				}
+				if key.Skip {
+					if depth == 0 {
+						visible = append(visible, StructField{StructField: f, Key: key})
+					}
+					continue
+				}
+				promoted = append(promoted, StructField{StructField: f, Key: key})
+				candidates = append(candidates, FieldCandidate{Key: key.Name, Depth: depth, Tagged: tagged})
+			}
+		}
+		// An embed repeated at one depth is walked each time, so its fields
+		// collide as in encoding/json; one seen at a shallower depth is not.
+		for _, e := range current {
+			visited[e.typ] = true
+		}
+		current = next
+	}
+
+	for _, i := range DominantFields(candidates) {
+		visible = append(visible, promoted[i])
+	}
+	slices.SortFunc(visible, func(a, b StructField) int {
+		return slices.Compare(a.Index, b.Index)
+	})
+	return visible
+}
+
+// NamedByTag reports whether the field-name tag of f gives the field an
+// explicit name, which keeps an anonymous embed from being flattened and
+// settles conflicts between promoted fields.
+func NamedByTag(fieldNameTag string, f reflect.StructField) bool {
+	name, _, _ := strings.Cut(f.Tag.Get(fieldNameTag), ",")
+	name = strings.TrimSpace(name)
+	return name != "" && name != "-"
+}
+
+// embeddedStructType returns the struct type whose fields the anonymous
+// field f promotes.
+func embeddedStructType(f reflect.StructField) (reflect.Type, bool) {
+	embedded := f.Type
+	if embedded.Kind() == reflect.Pointer {
+		if !f.IsExported() {
+			return nil, false
+		}
+		embedded = embedded.Elem()
+	}
+	return embedded, embedded.Kind() == reflect.Struct
+}
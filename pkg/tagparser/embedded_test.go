@@ -0,0 +1,137 @@
+package tagparser_test
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+type embeddedIdentity struct {
+	ID   string `json:"id" gozod:"required"`
+	Name string `json:"name" gozod:"min=2"`
+}
+
+type EmbeddedAudit struct {
+	By    string `json:"by"`
+	Title string `json:"title"`
+}
+
+type EmbeddedNote struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type EmbeddedSecret struct {
+	Token string `json:"token" gozod:"required"`
+}
+
+type embeddedPrivate struct {
+	Hidden string `json:"hidden"`
+}
+
+type embeddedRecord struct {
+	embeddedIdentity
+	*EmbeddedAudit
+	EmbeddedNote
+	EmbeddedSecret `gozod:"-"`
+	Named          EmbeddedNote `json:"named"`
+	*embeddedPrivate
+	Name    string `json:"name"`
+	Skipped string `json:"-"`
+}
+
+func TestVisibleFieldsMatchesJSON(t *testing.T) {
+	record := embeddedRecord{
+		embeddedIdentity: embeddedIdentity{ID: "1", Name: "inner"},
+		EmbeddedAudit:    &EmbeddedAudit{By: "ada", Title: "audit"},
+		EmbeddedNote:     EmbeddedNote{Title: "note", Body: "text"},
+		EmbeddedSecret:   EmbeddedSecret{Token: "t"},
+		Named:            EmbeddedNote{Title: "n"},
+		Name:             "outer",
+	}
+	encoded, err := json.Marshal(record)
+	require.NoError(t, err)
+	var jsonKeys map[string]any
+	require.NoError(t, json.Unmarshal(encoded, &jsonKeys))
+
+	var keys []string
+	for _, field := range tagparser.VisibleFields("json", reflect.TypeFor[embeddedRecord]()) {
+		if field.Key.Skip {
+			assert.Equal(t, "Skipped", field.Name)
+			continue
+		}
+		keys = append(keys, field.Key.Name)
+		value := reflect.ValueOf(record).FieldByIndex(field.Index).Interface()
+		assert.Equal(t, jsonKeys[field.Key.Name], normalizeJSON(t, value), field.Key.Name)
+	}
+	assert.Equal(t, []string{"id", "by", "body", "token", "named", "name"}, keys)
+	assert.ElementsMatch(t, keys, slices.Collect(maps.Keys(jsonKeys)))
+}
+
+func TestParseStructTagsPromotesEmbeddedFields(t *testing.T) {
+	fields, err := tagparser.New().ParseStructTags(reflect.TypeFor[embeddedRecord]())
+	require.NoError(t, err)
+
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.FieldKey
+	}
+	assert.Equal(t, []string{"id", "by", "body", "named", "name"}, names)
+	assert.True(t, fields[0].Required)
+}
+
+func TestDominantFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []tagparser.FieldCandidate
+		want       []int
+	}{
+		{
+			name: "shallowest wins",
+			candidates: []tagparser.FieldCandidate{
+				{Key: "id", Depth: 1},
+				{Key: "id", Depth: 0},
+			},
+			want: []int{1},
+		},
+		{
+			name: "tagged wins at equal depth",
+			candidates: []tagparser.FieldCandidate{
+				{Key: "id", Depth: 1},
+				{Key: "id", Depth: 1, Tagged: true},
+			},
+			want: []int{1},
+		},
+		{
+			name: "ambiguous key is dropped",
+			candidates: []tagparser.FieldCandidate{
+				{Key: "id", Depth: 1, Tagged: true},
+				{Key: "id", Depth: 1, Tagged: true},
+				{Key: "name", Depth: 2},
+			},
+			want: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tagparser.DominantFields(tt.candidates))
+		})
+	}
+}
+
+func normalizeJSON(t *testing.T, value any) any {
+	t.Helper()
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+	var normalized any
+	require.NoError(t, json.Unmarshal(encoded, &normalized))
+	return normalized
+}
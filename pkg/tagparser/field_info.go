@@ -1,11 +1,139 @@
 package tagparser
 
 import (
+	"fmt"
 	"reflect"
 	"slices"
 	"strings"
 )
 
+// SetRules splits the rules parsed from the field's tag at its element scope
+// markers into Rules, KeyRules, and ElemRules, and derives Required and
+// Nilable from the field's own rules. "dive" takes no operand; the operand of
+// "keys" or "values" is the first rule of its scope, so "keys=min=2" reads as
+// "keys,min=2".
+func (f *FieldInfo) SetRules(rules []TagRule) error {
+	rules, err := f.expandScopeOperands(rules)
+	if err != nil {
+		return err
+	}
+	own := rules
+	var keys, elems []TagRule
+	if i := slices.IndexFunc(rules, isScopeMarker); i >= 0 {
+		own, elems = rules[:i:i], rules[i+1:]
+		markers := []TagRule{rules[i]}
+		if rules[i].Name == ScopeKeys {
+			keys, elems = elems, nil
+			if end := slices.IndexFunc(keys, endsKeyScope); end >= 0 {
+				markers = append(markers, keys[end])
+				keys, elems = keys[:end:end], keys[end+1:]
+			}
+		}
+		for _, marker := range markers {
+			if len(marker.Params) > 0 {
+				return &CompileError{Field: f.Name, Rule: rawRule(marker), Err: ErrInvalidArity}
+			}
+		}
+	}
+	f.Rules = own
+	f.KeyRules = keys
+	f.ElemRules = elems
+	f.Required = hasRule(own, "required")
+	f.Nilable = hasRule(own, "nilable")
+	return nil
+}
+
+// expandScopeOperands splits each "keys" or "values" marker with an operand
+// into the bare marker followed by the rule its operand spells.
+func (f *FieldInfo) expandScopeOperands(rules []TagRule) ([]TagRule, error) {
+	if !slices.ContainsFunc(rules, hasScopeOperand) {
+		return rules, nil
+	}
+	expanded := make([]TagRule, 0, len(rules)+1)
+	for _, rule := range rules {
+		if !hasScopeOperand(rule) {
+			expanded = append(expanded, rule)
+			continue
+		}
+		operand, err := parseRule(strings.Join(rule.Params, " "))
+		if err != nil {
+			return nil, &CompileError{Field: f.Name, Rule: rawRule(rule), Err: err}
+		}
+		expanded = append(expanded, TagRule{Name: rule.Name}, operand)
+	}
+	return expanded, nil
+}
+
+func hasScopeOperand(rule TagRule) bool {
+	return (rule.Name == ScopeKeys || rule.Name == ScopeValues) && len(rule.Params) > 0
+}
+
+func isScopeMarker(rule TagRule) bool {
+	return rule.Name == ScopeDive || rule.Name == ScopeKeys || rule.Name == ScopeValues
+}
+
+func endsKeyScope(rule TagRule) bool {
+	return rule.Name == ScopeDive || rule.Name == ScopeValues
+}
+
+// HasElementRules reports whether the field's tag scopes rules to its
+// elements, map values, or map keys.
+func (f FieldInfo) HasElementRules() bool {
+	return len(f.KeyRules) > 0 || len(f.ElemRules) > 0
+}
+
+// ElementField describes the elements of a slice or array field, or the
+// values of a map field, with the rules the field's tag scopes to them. The
+// element is named after the field with a "[]" suffix, or "{}" for map
+// values, and is always required: collections have no absent elements.
+func (f FieldInfo) ElementField() (FieldInfo, error) {
+	collection := f.Type
+	for collection != nil && collection.Kind() == reflect.Pointer {
+		collection = collection.Elem()
+	}
+	suffix := "[]"
+	switch {
+	case collection == nil:
+		return FieldInfo{}, &CompileError{Field: f.Name, Rule: ScopeDive, Err: ErrInapplicableRule}
+	case collection.Kind() == reflect.Map:
+		suffix = "{}"
+	case collection.Kind() != reflect.Slice && collection.Kind() != reflect.Array:
+		return FieldInfo{}, &CompileError{Field: f.Name, Rule: ScopeDive, Err: fmt.Errorf("%w: %v", ErrInapplicableRule, f.Type)}
+	}
+	return f.scopedField(suffix, collection.Elem(), f.ElemRules, ScopeDive)
+}
+
+// KeyField describes the keys of a map field with the rules the field's tag
+// scopes to them, named after the field with a "{key}" suffix.
+func (f FieldInfo) KeyField() (FieldInfo, error) {
+	collection := f.Type
+	for collection != nil && collection.Kind() == reflect.Pointer {
+		collection = collection.Elem()
+	}
+	if collection == nil || collection.Kind() != reflect.Map {
+		return FieldInfo{}, &CompileError{Field: f.Name, Rule: ScopeKeys, Err: fmt.Errorf("%w: %v", ErrInapplicableRule, f.Type)}
+	}
+	return f.scopedField("{key}", collection.Key(), f.KeyRules, ScopeKeys)
+}
+
+func (f FieldInfo) scopedField(suffix string, typ reflect.Type, rules []TagRule, marker string) (FieldInfo, error) {
+	scoped := FieldInfo{Name: f.Name + suffix, Type: typ, FieldKey: f.FieldKey}
+	if err := scoped.SetRules(rules); err != nil {
+		return FieldInfo{}, err
+	}
+	if len(rules) > 0 && fieldFamily(typ) == FieldFamilyStruct {
+		return FieldInfo{}, &CompileError{Field: f.Name, Rule: marker, Err: fmt.Errorf("%w: %v", ErrInapplicableRule, typ)}
+	}
+	scoped.Required = true
+	return scoped, nil
+}
+
+// HasEnumKeys reports whether the field's tag restricts its map keys to an
+// enum, so that a map holds any subset of the allowed keys.
+func (f FieldInfo) HasEnumKeys() bool {
+	return hasRule(f.KeyRules, string(RuleEnum)) || hasRule(f.KeyRules, string(RuleEnumType))
+}
+
 // HasRules reports whether the field has any parsed tag rules.
 func (f FieldInfo) HasRules() bool {
 	return len(f.Rules) > 0
@@ -41,12 +169,13 @@ func (f FieldInfo) EffectiveTypeName() string {
 // Runtime/codegen consumers should prefer helper methods on [FieldInfo]
 // instead of open-coding logic from the raw fields.
 func (f FieldInfo) HasSchemaSpec() bool {
-	return f.Required || f.HasRules()
+	return f.Required || f.HasRules() || f.HasElementRules()
 }
 
 // UsesTimeImport reports whether codegen should import time for this field.
+// Time fields only reference the package when a rule renders a time literal.
 func (f FieldInfo) UsesTimeImport() bool {
-	return f.Type != nil && strings.Contains(f.Type.String(), "time.Time")
+	return f.HasRule("before") || f.HasRule("after")
 }
 
 // EnumRule returns the enum rule if present.
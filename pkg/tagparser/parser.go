@@ -47,16 +47,28 @@ var ErrEmptyRuleName = errors.New("tagparser: empty rule name")
 
 // FieldInfo represents parsed information about a struct field.
 type FieldInfo struct {
-	Name     string       // Go field name
-	Type     reflect.Type // field type
-	TypeName string       // AST type name for circular reference detection
-	FieldKey string       // from the field-name tag, or Go field name
-	GoZodTag string       // raw gozod tag value
-	Rules    []TagRule    // parsed tag rules before helper-level filtering
-	Required bool         // whether the parsed rules include "required"
-	Nilable  bool         // whether the parsed rules include "nilable"
+	Name      string       // Go field name
+	Type      reflect.Type // field type
+	TypeName  string       // AST type name for circular reference detection
+	FieldKey  string       // from the field-name tag, or Go field name
+	GoZodTag  string       // raw gozod tag value
+	Rules     []TagRule    // parsed tag rules of the field itself
+	KeyRules  []TagRule    // rules scoped to map keys by "keys"
+	ElemRules []TagRule    // rules scoped to elements or map values by "dive" or "values"
+	Required  bool         // whether the parsed rules include "required"
+	Nilable   bool         // whether the parsed rules include "nilable"
 }
 
+// Element scope markers. Rules after "dive" apply to the elements of a slice
+// or array, or the values of a map; rules after "keys" apply to map keys until
+// "values" or "dive". A further "dive" among element rules descends one more
+// level, as in gozod:"min=1,dive,min=1,dive,lowercase" on a [][]string.
+const (
+	ScopeDive   = "dive"
+	ScopeKeys   = "keys"
+	ScopeValues = "values"
+)
+
 // defaultFieldNameTag is the struct tag consulted for field names when callers
 // do not select another tag.
 const defaultFieldNameTag = "json"
@@ -122,10 +134,11 @@ func (p *TagParser) ParseStructTagsStrict(typ reflect.Type) ([]FieldInfo, error)
 }
 
 func (p *TagParser) parseStructFields(typ reflect.Type) ([]FieldInfo, error) {
-	fields := make([]FieldInfo, 0, typ.NumField())
+	visible := VisibleFields(p.fieldNameTag, typ)
+	fields := make([]FieldInfo, 0, len(visible))
 
-	for f := range typ.Fields() {
-		if !f.IsExported() {
+	for _, f := range visible {
+		if f.Key.Skip || p.skipsEmbed(typ, f.Index) {
 			continue
 		}
 
@@ -134,15 +147,10 @@ func (p *TagParser) parseStructFields(typ reflect.Type) ([]FieldInfo, error) {
 			continue
 		}
 
-		fieldKey := FieldName(p.fieldNameTag, f)
-		if fieldKey.Skip {
-			continue
-		}
-
 		info := FieldInfo{
 			Name:     f.Name,
 			Type:     f.Type,
-			FieldKey: fieldKey.Name,
+			FieldKey: f.Key.Name,
 			GoZodTag: tag,
 		}
 
@@ -151,9 +159,9 @@ func (p *TagParser) parseStructFields(typ reflect.Type) ([]FieldInfo, error) {
 			if err != nil {
 				return nil, err
 			}
-			info.Rules = rules
-			info.Required = hasRule(rules, "required")
-			info.Nilable = hasRule(rules, "nilable")
+			if err := info.SetRules(rules); err != nil {
+				return nil, err
+			}
 		}
 
 		fields = append(fields, info)
@@ -162,6 +170,17 @@ func (p *TagParser) parseStructFields(typ reflect.Type) ([]FieldInfo, error) {
 	return fields, nil
 }
 
+// skipsEmbed reports whether an anonymous embed on the path to the promoted
+// field at index carries the rule tag "-", which skips all of its fields.
+func (p *TagParser) skipsEmbed(typ reflect.Type, index []int) bool {
+	for depth := 1; depth < len(index); depth++ {
+		if typ.FieldByIndex(index[:depth]).Tag.Get(p.rulesTagName) == "-" {
+			return true
+		}
+	}
+	return false
+}
+
 func normalizedStructType(typ reflect.Type) (reflect.Type, bool) {
 	if typ == nil {
 		return nil, false
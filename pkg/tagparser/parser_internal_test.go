@@ -190,6 +190,13 @@ func TestFieldInfoHelpers(t *testing.T) {
 	assert.Empty(t, timeInfo.RequiredImports())
 
 	timeField := FieldInfo{Type: reflect.TypeFor[time.Time]()}
-	assert.True(t, timeField.UsesTimeImport())
-	assert.Equal(t, []string{"time"}, timeField.RequiredImports())
+	assert.False(t, timeField.UsesTimeImport())
+	assert.Empty(t, timeField.RequiredImports())
+
+	boundedTime := FieldInfo{
+		Type:  reflect.TypeFor[time.Time](),
+		Rules: []TagRule{{Name: "after", Params: []string{"2020-01-01T00:00:00Z"}}},
+	}
+	assert.True(t, boundedTime.UsesTimeImport())
+	assert.Equal(t, []string{"time"}, boundedTime.RequiredImports())
 }
@@ -290,3 +290,103 @@ func findField(fields []tagparser.FieldInfo, name string) *tagparser.FieldInfo {
 	}
 	return nil
 }
+
+func TestTagParser_ElementScopes(t *testing.T) {
+	parser := tagparser.New()
+
+	type TestStruct struct {
+		Tags   []string          `gozod:"required,min=1,dive,min=2,lowercase" json:"tags"`
+		Emails map[string]string `gozod:"keys,min=2,values,email" json:"emails"`
+		Grid   [][]int           `gozod:"dive,min=1,dive,positive" json:"grid"`
+		Name   string            `gozod:"dive,min=2" json:"name"`
+	}
+
+	fields, err := parser.ParseStructTags(reflect.TypeFor[TestStruct]())
+	require.NoError(t, err)
+
+	tags := findField(fields, "Tags")
+	require.NotNil(t, tags)
+	assert.True(t, tags.Required)
+	assert.Equal(t, []tagparser.TagRule{{Name: "required"}, {Name: "min", Params: []string{"1"}}}, tags.Rules)
+	elem, err := tags.ElementField()
+	require.NoError(t, err)
+	assert.Equal(t, "Tags[]", elem.Name)
+	assert.Equal(t, reflect.TypeFor[string](), elem.Type)
+	assert.True(t, elem.Required)
+	assert.Equal(t, []tagparser.TagRule{{Name: "min", Params: []string{"2"}}, {Name: "lowercase"}}, elem.Rules)
+
+	emails := findField(fields, "Emails")
+	require.NotNil(t, emails)
+	assert.Empty(t, emails.Rules)
+	assert.True(t, emails.HasSchemaSpec())
+	key, err := emails.KeyField()
+	require.NoError(t, err)
+	assert.Equal(t, "Emails{key}", key.Name)
+	assert.Equal(t, []tagparser.TagRule{{Name: "min", Params: []string{"2"}}}, key.Rules)
+	value, err := emails.ElementField()
+	require.NoError(t, err)
+	assert.Equal(t, "Emails{}", value.Name)
+	assert.Equal(t, []tagparser.TagRule{{Name: "email"}}, value.Rules)
+
+	grid := findField(fields, "Grid")
+	require.NotNil(t, grid)
+	row, err := grid.ElementField()
+	require.NoError(t, err)
+	assert.Equal(t, []tagparser.TagRule{{Name: "min", Params: []string{"1"}}}, row.Rules)
+	cell, err := row.ElementField()
+	require.NoError(t, err)
+	assert.Equal(t, "Grid[][]", cell.Name)
+	assert.Equal(t, []tagparser.TagRule{{Name: "positive"}}, cell.Rules)
+
+	name := findField(fields, "Name")
+	require.NotNil(t, name)
+	_, err = name.ElementField()
+	require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+	_, err = grid.KeyField()
+	require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+}
+
+func TestTagParser_MapScopeOperandsAreFirstRules(t *testing.T) {
+	parser := tagparser.New()
+
+	type TestStruct struct {
+		Shorthand map[string]string `gozod:"keys=min=2,max=8,values=email"`
+		Markers   map[string]string `gozod:"keys,min=2,max=8,values,email"`
+		Choices   map[string]string `gozod:"values=oneof=red green"`
+	}
+
+	fields, err := parser.ParseStructTags(reflect.TypeFor[TestStruct]())
+	require.NoError(t, err)
+
+	shorthand, markers := findField(fields, "Shorthand"), findField(fields, "Markers")
+	require.NotNil(t, shorthand)
+	require.NotNil(t, markers)
+	assert.Equal(t, markers.KeyRules, shorthand.KeyRules)
+	assert.Equal(t, markers.ElemRules, shorthand.ElemRules)
+	assert.Equal(t, []tagparser.TagRule{{Name: "email"}}, shorthand.ElemRules)
+
+	choices := findField(fields, "Choices")
+	require.NotNil(t, choices)
+	assert.Equal(t, []tagparser.TagRule{{Name: "oneof", Params: []string{"red", "green"}}}, choices.ElemRules)
+
+	type EmptyOperand struct {
+		Limits map[string]int `gozod:"keys==2"`
+	}
+	_, err = parser.ParseStructTags(reflect.TypeFor[EmptyOperand]())
+	var compileErr *tagparser.CompileError
+	require.ErrorAs(t, err, &compileErr)
+	assert.Equal(t, "Limits", compileErr.Field)
+	require.ErrorIs(t, err, tagparser.ErrEmptyRuleName)
+}
+
+func TestTagParser_ElementScopeMarkersTakeNoOperand(t *testing.T) {
+	parser := tagparser.New()
+
+	type TestStruct struct {
+		Tags []string `gozod:"dive=2,min=1"`
+	}
+
+	_, err := parser.ParseStructTags(reflect.TypeFor[TestStruct]())
+	require.ErrorIs(t, err, tagparser.ErrInvalidArity)
+	assert.ErrorContains(t, err, "dive=2")
+}
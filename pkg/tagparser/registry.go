@@ -0,0 +1,80 @@
+package tagparser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+// ErrInvalidRuleRegistration indicates that a custom rule cannot be registered.
+var ErrInvalidRuleRegistration = errors.New("tagparser: invalid rule registration")
+
+// OperandArity states whether a registered rule takes an operand.
+type OperandArity int
+
+// OperandArity values bound the operand of a registered rule.
+const (
+	// OperandNone accepts only the bare rule, as in gozod:"sku".
+	OperandNone OperandArity = iota
+	// OperandOptional accepts the bare rule or one operand.
+	OperandOptional
+	// OperandRequired requires an operand, as in gozod:"prefix=SKU-".
+	OperandRequired
+)
+
+var ruleNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+var customRules = struct {
+	sync.RWMutex
+	definitions map[string]ruleDefinition
+}{definitions: make(map[string]ruleDefinition)}
+
+// RegisterRule adds a custom rule that applies to fields of family. Compiled
+// plans represent it as a [RuleCustom] operation whose operand is the rule's
+// raw parameter text. Registering the same definition again is a no-op.
+func RegisterRule(name string, family FieldFamily, arity OperandArity) error {
+	if !ruleNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid rule name %q", ErrInvalidRuleRegistration, name)
+	}
+	if _, ok := ruleDefinitions[name]; ok {
+		return fmt.Errorf("%w: %q is a built-in rule", ErrInvalidRuleRegistration, name)
+	}
+	if !slices.Contains(valueFamilies, family) && family != FieldFamilyStruct {
+		return fmt.Errorf("%w: rule %q: unknown field family %q", ErrInvalidRuleRegistration, name, family)
+	}
+	definition := ruleDefinition{op: RuleCustom, families: []FieldFamily{family}}
+	switch arity {
+	case OperandNone:
+	case OperandOptional:
+		definition.maxArgs = -1
+	case OperandRequired:
+		definition.minArgs, definition.maxArgs = 1, -1
+	default:
+		return fmt.Errorf("%w: rule %q: unknown operand arity %d", ErrInvalidRuleRegistration, name, arity)
+	}
+
+	customRules.Lock()
+	defer customRules.Unlock()
+	if existing, ok := customRules.definitions[name]; ok {
+		if existing.minArgs == definition.minArgs && existing.maxArgs == definition.maxArgs &&
+			slices.Equal(existing.families, definition.families) {
+			return nil
+		}
+		return fmt.Errorf("%w: rule %q is already registered", ErrInvalidRuleRegistration, name)
+	}
+	customRules.definitions[name] = definition
+	return nil
+}
+
+// lookupRule returns the built-in or registered definition of a rule name.
+func lookupRule(name string) (ruleDefinition, bool) {
+	if definition, ok := ruleDefinitions[name]; ok {
+		return definition, true
+	}
+	customRules.RLock()
+	defer customRules.RUnlock()
+	definition, ok := customRules.definitions[name]
+	return definition, ok
+}
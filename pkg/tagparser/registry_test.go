@@ -0,0 +1,92 @@
+package tagparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+func TestRegisterRule(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, tagparser.RegisterRule("tp_sku", tagparser.FieldFamilyString, tagparser.OperandOptional))
+	require.NoError(t, tagparser.RegisterRule("tp_sku", tagparser.FieldFamilyString, tagparser.OperandOptional))
+
+	tests := []struct {
+		name   string
+		family tagparser.FieldFamily
+		arity  tagparser.OperandArity
+		rule   string
+	}{
+		{name: "tp_sku", family: tagparser.FieldFamilyString, arity: tagparser.OperandRequired, rule: "tp_sku"},
+		{name: "tp_sku", family: tagparser.FieldFamilyFloat, arity: tagparser.OperandOptional, rule: "tp_sku"},
+		{name: "min", family: tagparser.FieldFamilyString, arity: tagparser.OperandRequired, rule: "built-in"},
+		{name: "tp bad", family: tagparser.FieldFamilyString, arity: tagparser.OperandNone, rule: "invalid rule name"},
+		{name: "", family: tagparser.FieldFamilyString, arity: tagparser.OperandNone, rule: "invalid rule name"},
+		{name: "tp_family", family: tagparser.FieldFamilyUnknown, arity: tagparser.OperandNone, rule: "unknown field family"},
+		{name: "tp_arity", family: tagparser.FieldFamilyString, arity: tagparser.OperandArity(7), rule: "unknown operand arity"},
+	}
+	for _, tt := range tests {
+		err := tagparser.RegisterRule(tt.name, tt.family, tt.arity)
+		require.ErrorIs(t, err, tagparser.ErrInvalidRuleRegistration, tt.name)
+		assert.ErrorContains(t, err, tt.rule)
+	}
+}
+
+func TestCompileFieldPlanCompilesRegisteredRules(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, tagparser.RegisterRule("tp_flag", tagparser.FieldFamilyString, tagparser.OperandNone))
+	require.NoError(t, tagparser.RegisterRule("tp_prefix", tagparser.FieldFamilyString, tagparser.OperandRequired))
+	require.NoError(t, tagparser.RegisterRule("tp_scale", tagparser.FieldFamilyFloat, tagparser.OperandOptional))
+
+	field := tagparser.FieldInfo{
+		Name: "Code",
+		Type: reflect.TypeFor[*string](),
+		Rules: []tagparser.TagRule{
+			{Name: "tp_flag"},
+			{Name: "tp_prefix", Params: []string{"SKU", "-"}},
+		},
+	}
+	plan, err := tagparser.CompileFieldPlan(&field)
+	require.NoError(t, err)
+	assert.Equal(t, []tagparser.RulePlan{
+		{Name: "tp_flag", Op: tagparser.RuleCustom, Family: tagparser.FieldFamilyString, Operand: ""},
+		{Name: "tp_prefix", Op: tagparser.RuleCustom, Family: tagparser.FieldFamilyString, Operand: "SKU -"},
+	}, plan.Operations)
+
+	tests := []struct {
+		name  string
+		field tagparser.FieldInfo
+		err   error
+	}{
+		{
+			name:  "operand on a bare rule",
+			field: tagparser.FieldInfo{Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{{Name: "tp_flag", Params: []string{"x"}}}},
+			err:   tagparser.ErrInvalidArity,
+		},
+		{
+			name:  "missing required operand",
+			field: tagparser.FieldInfo{Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{{Name: "tp_prefix"}}},
+			err:   tagparser.ErrMissingOperand,
+		},
+		{
+			name:  "other family",
+			field: tagparser.FieldInfo{Type: reflect.TypeFor[int](), Rules: []tagparser.TagRule{{Name: "tp_scale"}}},
+			err:   tagparser.ErrInapplicableRule,
+		},
+		{
+			name:  "unregistered",
+			field: tagparser.FieldInfo{Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{{Name: "tp_missing"}}},
+			err:   tagparser.ErrUnknownRule,
+		},
+	}
+	for _, tt := range tests {
+		_, err := tagparser.CompileFieldPlan(&tt.field)
+		assert.ErrorIs(t, err, tt.err, tt.name)
+	}
+}
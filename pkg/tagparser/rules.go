@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-json-experiment/json"
 )
@@ -27,6 +28,9 @@ var ErrInvalidOperand = errors.New("tagparser: invalid rule operand")
 // ErrInapplicableRule indicates that a rule has no meaning for the field type.
 var ErrInapplicableRule = errors.New("tagparser: rule is not applicable to field type")
 
+// ErrUnknownField indicates that a rule names a field the struct does not have.
+var ErrUnknownField = errors.New("tagparser: rule references an unknown field")
+
 // CompileError reports why a field's parsed tag cannot become an executable plan.
 type CompileError struct {
 	Field string
@@ -101,12 +105,33 @@ const (
 	RuleISOTime     RuleOp = "iso_time"
 	RuleISODuration RuleOp = "iso_duration"
 	RuleTime        RuleOp = "time"
+	RuleBefore      RuleOp = "before"
+	RuleAfter       RuleOp = "after"
+	RulePast        RuleOp = "past"
+	RuleFuture      RuleOp = "future"
 	RulePositive    RuleOp = "positive"
 	RuleNegative    RuleOp = "negative"
 	RuleFinite      RuleOp = "finite"
 	RuleNonEmpty    RuleOp = "nonempty"
+	RuleUnique      RuleOp = "unique"
 	RuleEnum        RuleOp = "enum"
 	RuleLiteral     RuleOp = "literal"
+	// RuleEnumType restricts a field to the values declared for its named
+	// type. The plan carries no operand: reflection reads the values
+	// registered for the type and code generation its declared constants.
+	RuleEnumType RuleOp = "enum_type"
+	// RuleRequiredWith is a struct-level rule: see DependentRequired.
+	RuleRequiredWith RuleOp = "required_with"
+	// Cross-field rules compare a field with a sibling: see CompileCrossFieldPlan.
+	RuleEqField    RuleOp = "eqfield"
+	RuleNeField    RuleOp = "nefield"
+	RuleGTField    RuleOp = "gtfield"
+	RuleGTEField   RuleOp = "gtefield"
+	RuleLTField    RuleOp = "ltfield"
+	RuleLTEField   RuleOp = "ltefield"
+	RuleRequiredIf RuleOp = "required_if"
+	// RuleCustom is a rule added with RegisterRule; RulePlan.Name names it.
+	RuleCustom RuleOp = "custom"
 )
 
 // RulePlan is the shared semantic plan for a parsed tag rule.
@@ -125,60 +150,114 @@ type ruleDefinition struct {
 }
 
 var ruleDefinitions = map[string]ruleDefinition{
-	"required":     {op: RuleRequired, maxArgs: 0},
-	"optional":     {op: RuleOptional, maxArgs: 0},
-	"coerce":       {op: RuleCoerce, maxArgs: 0, families: scalarAndTimeFamilies},
-	"nilable":      {op: RuleNilable, maxArgs: 0},
-	"min":          unaryRule(RuleMin, lengthAndNumericFamilies),
-	"max":          unaryRule(RuleMax, lengthAndNumericFamilies),
-	"length":       unaryRule(RuleLength, sequenceFamilies),
-	"gt":           unaryRule(RuleGT, numericFamilies),
-	"gte":          unaryRule(RuleGTE, numericFamilies),
-	"lt":           unaryRule(RuleLT, numericFamilies),
-	"lte":          unaryRule(RuleLTE, numericFamilies),
-	"regex":        unaryRule(RuleRegex, stringFamilies),
-	"includes":     unaryRule(RuleIncludes, stringFamilies),
-	"startswith":   unaryRule(RuleStartsWith, stringFamilies),
-	"endswith":     unaryRule(RuleEndsWith, stringFamilies),
-	"multipleof":   unaryRule(RuleMultipleOf, numericFamilies),
-	"default":      variadicRule(RuleDefault, valueFamilies),
-	"prefault":     variadicRule(RulePrefault, valueFamilies),
-	"trim":         noArgRule(RuleTrim, stringFamilies),
-	"lowercase":    noArgRule(RuleLowercase, stringFamilies),
-	"uppercase":    noArgRule(RuleUppercase, stringFamilies),
-	"email":        noArgRule(RuleEmail, stringFamilies),
-	"url":          noArgRule(RuleURL, stringFamilies),
-	"uuid":         noArgRule(RuleUUID, stringFamilies),
-	"ipv4":         noArgRule(RuleIPv4, stringFamilies),
-	"ipv6":         noArgRule(RuleIPv6, stringFamilies),
-	"cidrv4":       noArgRule(RuleCIDRv4, stringFamilies),
-	"cidrv6":       noArgRule(RuleCIDRv6, stringFamilies),
-	"cuid":         noArgRule(RuleCUID, stringFamilies),
-	"cuid2":        noArgRule(RuleCUID2, stringFamilies),
-	"jwt":          noArgRule(RuleJWT, stringFamilies),
-	"iso_datetime": noArgRule(RuleISODateTime, stringFamilies),
-	"iso_date":     noArgRule(RuleISODate, stringFamilies),
-	"iso_time":     noArgRule(RuleISOTime, stringFamilies),
-	"iso_duration": noArgRule(RuleISODuration, stringFamilies),
-	"time":         noArgRule(RuleTime, []FieldFamily{FieldFamilyTime}),
-	"positive":     noArgRule(RulePositive, numericFamilies),
-	"negative":     noArgRule(RuleNegative, numericFamilies),
-	"finite":       noArgRule(RuleFinite, []FieldFamily{FieldFamilyFloat}),
-	"nonempty":     noArgRule(RuleNonEmpty, sequenceFamilies),
-	"enum":         variadicRule(RuleEnum, []FieldFamily{FieldFamilyString, FieldFamilySignedInteger}),
-	"literal":      unaryRule(RuleLiteral, scalarFamilies),
+	"required":      {op: RuleRequired, maxArgs: 0},
+	"optional":      {op: RuleOptional, maxArgs: 0},
+	"coerce":        {op: RuleCoerce, maxArgs: 0, families: scalarAndTimeFamilies},
+	"nilable":       {op: RuleNilable, maxArgs: 0},
+	"min":           unaryRule(RuleMin, lengthAndNumericFamilies),
+	"max":           unaryRule(RuleMax, lengthAndNumericFamilies),
+	"length":        unaryRule(RuleLength, sequenceFamilies),
+	"gt":            unaryRule(RuleGT, numericFamilies),
+	"gte":           unaryRule(RuleGTE, numericFamilies),
+	"lt":            unaryRule(RuleLT, numericFamilies),
+	"lte":           unaryRule(RuleLTE, numericFamilies),
+	"regex":         unaryRule(RuleRegex, stringFamilies),
+	"includes":      unaryRule(RuleIncludes, stringFamilies),
+	"startswith":    unaryRule(RuleStartsWith, stringFamilies),
+	"endswith":      unaryRule(RuleEndsWith, stringFamilies),
+	"multipleof":    unaryRule(RuleMultipleOf, numericFamilies),
+	"default":       variadicRule(RuleDefault, valueFamilies),
+	"prefault":      variadicRule(RulePrefault, valueFamilies),
+	"trim":          noArgRule(RuleTrim, stringFamilies),
+	"lowercase":     noArgRule(RuleLowercase, stringFamilies),
+	"uppercase":     noArgRule(RuleUppercase, stringFamilies),
+	"email":         noArgRule(RuleEmail, stringFamilies),
+	"url":           noArgRule(RuleURL, stringFamilies),
+	"uuid":          noArgRule(RuleUUID, stringFamilies),
+	"ipv4":          noArgRule(RuleIPv4, stringFamilies),
+	"ipv6":          noArgRule(RuleIPv6, stringFamilies),
+	"cidrv4":        noArgRule(RuleCIDRv4, stringFamilies),
+	"cidrv6":        noArgRule(RuleCIDRv6, stringFamilies),
+	"cuid":          noArgRule(RuleCUID, stringFamilies),
+	"cuid2":         noArgRule(RuleCUID2, stringFamilies),
+	"jwt":           noArgRule(RuleJWT, stringFamilies),
+	"iso_datetime":  noArgRule(RuleISODateTime, stringFamilies),
+	"iso_date":      noArgRule(RuleISODate, stringFamilies),
+	"iso_time":      noArgRule(RuleISOTime, stringFamilies),
+	"iso_duration":  noArgRule(RuleISODuration, stringFamilies),
+	"time":          noArgRule(RuleTime, []FieldFamily{FieldFamilyTime}),
+	"before":        unaryRule(RuleBefore, timeFamilies),
+	"after":         unaryRule(RuleAfter, timeFamilies),
+	"past":          noArgRule(RulePast, timeFamilies),
+	"future":        noArgRule(RuleFuture, timeFamilies),
+	"positive":      noArgRule(RulePositive, numericFamilies),
+	"negative":      noArgRule(RuleNegative, numericFamilies),
+	"finite":        noArgRule(RuleFinite, []FieldFamily{FieldFamilyFloat}),
+	"nonempty":      noArgRule(RuleNonEmpty, sequenceFamilies),
+	"unique":        noArgRule(RuleUnique, listFamilies),
+	"enum":          variadicRule(RuleEnum, []FieldFamily{FieldFamilyString, FieldFamilySignedInteger}),
+	"enum_type":     noArgRule(RuleEnumType, enumTypeFamilies),
+	"literal":       unaryRule(RuleLiteral, scalarFamilies),
+	"required_with": variadicRule(RuleRequiredWith, nil),
+	"eqfield":       unaryRule(RuleEqField, valueFamilies),
+	"nefield":       unaryRule(RuleNeField, valueFamilies),
+	"gtfield":       unaryRule(RuleGTField, orderedFamilies),
+	"gtefield":      unaryRule(RuleGTEField, orderedFamilies),
+	"ltfield":       unaryRule(RuleLTField, orderedFamilies),
+	"ltefield":      unaryRule(RuleLTEField, orderedFamilies),
+	"required_if":   variadicRule(RuleRequiredIf, nil),
 }
 
 var (
 	stringFamilies           = []FieldFamily{FieldFamilyString}
+	timeFamilies             = []FieldFamily{FieldFamilyTime}
 	numericFamilies          = []FieldFamily{FieldFamilySignedInteger, FieldFamilyUnsignedInteger, FieldFamilyFloat}
 	scalarFamilies           = []FieldFamily{FieldFamilyString, FieldFamilySignedInteger, FieldFamilyUnsignedInteger, FieldFamilyFloat, FieldFamilyBool}
 	scalarAndTimeFamilies    = append(slices.Clone(scalarFamilies), FieldFamilyTime)
 	sequenceFamilies         = []FieldFamily{FieldFamilyString, FieldFamilySlice, FieldFamilyArray, FieldFamilyMap}
+	listFamilies             = []FieldFamily{FieldFamilySlice, FieldFamilyArray}
+	enumTypeFamilies         = []FieldFamily{FieldFamilyString, FieldFamilySignedInteger, FieldFamilyUnsignedInteger}
+	orderedFamilies          = []FieldFamily{FieldFamilyString, FieldFamilySignedInteger, FieldFamilyUnsignedInteger, FieldFamilyFloat, FieldFamilyTime}
 	lengthAndNumericFamilies = append(slices.Clone(sequenceFamilies), numericFamilies...)
 	valueFamilies            = []FieldFamily{FieldFamilyString, FieldFamilySignedInteger, FieldFamilyUnsignedInteger, FieldFamilyFloat, FieldFamilyBool, FieldFamilySlice, FieldFamilyArray, FieldFamilyMap, FieldFamilyTime}
 )
 
+// DependentRequired collects the required_with rules of a struct's fields.
+// A field tagged required_with=A B is required whenever field A or B is set,
+// so the result maps the field key of each named field to the keys of the
+// fields that require it, in field order. Named fields are resolved by Go
+// field name or field key.
+func DependentRequired(fields []FieldInfo) (map[string][]string, error) {
+	var deps map[string][]string
+	for _, field := range fields {
+		for _, rule := range field.Rules {
+			if rule.Name != string(RuleRequiredWith) {
+				continue
+			}
+			for _, name := range rule.Params {
+				i := slices.IndexFunc(fields, func(f FieldInfo) bool {
+					return f.Name == name || f.FieldKey == name
+				})
+				if i < 0 {
+					return nil, &CompileError{
+						Field: field.Name,
+						Rule:  rawRule(rule),
+						Err:   fmt.Errorf("%w: %s", ErrUnknownField, name),
+					}
+				}
+				if deps == nil {
+					deps = make(map[string][]string)
+				}
+				key := fields[i].FieldKey
+				if !slices.Contains(deps[key], field.FieldKey) {
+					deps[key] = append(deps[key], field.FieldKey)
+				}
+			}
+		}
+	}
+	return deps, nil
+}
+
 func noArgRule(op RuleOp, families []FieldFamily) ruleDefinition {
 	return ruleDefinition{op: op, maxArgs: 0, families: families}
 }
@@ -219,7 +298,7 @@ func CompileFieldPlan(field *FieldInfo) (FieldPlan, error) {
 	rules := field.Rules
 	operations := make([]RulePlan, 0, len(rules))
 	for _, rule := range rules {
-		definition, ok := ruleDefinitions[rule.Name]
+		definition, ok := lookupRule(rule.Name)
 		if !ok {
 			return FieldPlan{}, &CompileError{
 				Field: field.Name,
@@ -250,6 +329,11 @@ func CompileFieldPlan(field *FieldInfo) (FieldPlan, error) {
 			}
 		}
 		operation := RulePlan{Name: rule.Name, Op: definition.op, Family: family}
+		if definition.op == RuleCustom {
+			operation.Operand = strings.Join(rule.Params, " ")
+			operations = append(operations, operation)
+			continue
+		}
 		operand, err := compileOperand(rule, operation.Family, field.Type)
 		if err != nil {
 			return FieldPlan{}, &CompileError{
@@ -322,6 +406,8 @@ func compileOperand(rule TagRule, family FieldFamily, fieldType reflect.Type) (a
 		}
 	case "length":
 		return strconv.Atoi(value)
+	case "before", "after":
+		return time.Parse(time.RFC3339, value)
 	case "regex":
 		return regexp.Compile(value)
 	case "default", "prefault":
@@ -3,6 +3,7 @@ package tagparser_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -184,6 +185,31 @@ func TestCompileFieldPlanRejectsNumericRuleOnBool(t *testing.T) {
 	assert.ErrorContains(t, err, "gte=1")
 }
 
+func TestCompileFieldPlanUniqueAppliesOnlyToLists(t *testing.T) {
+	t.Parallel()
+
+	for _, fieldType := range []reflect.Type{reflect.TypeFor[[]string](), reflect.TypeFor[[2]int]()} {
+		plan, err := tagparser.CompileFieldPlan(&tagparser.FieldInfo{
+			Name:  "Tags",
+			Type:  fieldType,
+			Rules: []tagparser.TagRule{{Name: "unique"}},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, plan.Operations, 1) {
+			assert.Equal(t, tagparser.RuleUnique, plan.Operations[0].Op)
+		}
+	}
+
+	for _, fieldType := range []reflect.Type{reflect.TypeFor[string](), reflect.TypeFor[map[string]int]()} {
+		_, err := tagparser.CompileFieldPlan(&tagparser.FieldInfo{
+			Name:  "Tags",
+			Type:  fieldType,
+			Rules: []tagparser.TagRule{{Name: "unique"}},
+		})
+		assert.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+	}
+}
+
 func TestCompileFieldPlanRejectsInvalidRegex(t *testing.T) {
 	t.Parallel()
 
@@ -243,6 +269,67 @@ func TestCompileFieldPlanParsesNumericOperandForFieldFamily(t *testing.T) {
 	}
 }
 
+func TestCompileFieldPlanParsesTimeBoundOperand(t *testing.T) {
+	t.Parallel()
+
+	field := tagparser.FieldInfo{
+		Name:     "CreatedAt",
+		Type:     reflect.TypeFor[*time.Time](),
+		GoZodTag: "after=2020-01-01T00:00:00Z,past",
+		Rules: []tagparser.TagRule{
+			{Name: "after", Params: []string{"2020-01-01T00:00:00Z"}},
+			{Name: "past"},
+		},
+	}
+
+	plan, err := tagparser.CompileFieldPlan(&field)
+
+	assert.NoError(t, err)
+	if assert.Len(t, plan.Operations, 2) {
+		assert.Equal(t, tagparser.RuleAfter, plan.Operations[0].Op)
+		assert.Equal(t, tagparser.FieldFamilyTime, plan.Operations[0].Family)
+		assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), plan.Operations[0].Operand)
+		assert.Equal(t, tagparser.RulePast, plan.Operations[1].Op)
+	}
+}
+
+func TestCompileFieldPlanRejectsInvalidTimeBound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		field tagparser.FieldInfo
+	}{
+		{
+			name: "non-RFC3339 operand",
+			field: tagparser.FieldInfo{
+				Name:  "CreatedAt",
+				Type:  reflect.TypeFor[time.Time](),
+				Rules: []tagparser.TagRule{{Name: "before", Params: []string{"2020-01-01"}}},
+			},
+		},
+		{
+			name: "non-time field",
+			field: tagparser.FieldInfo{
+				Name:  "Name",
+				Type:  reflect.TypeFor[string](),
+				Rules: []tagparser.TagRule{{Name: "future"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tagparser.CompileFieldPlan(&tt.field)
+
+			assert.Error(t, err)
+			assert.ErrorContains(t, err, tt.field.Name)
+		})
+	}
+}
+
 func TestCompileFieldPlanRejectsCoercionForCompositeFamilies(t *testing.T) {
 	t.Parallel()
 
@@ -289,3 +376,106 @@ func TestCompileFieldPlanParsesStructuredDefault(t *testing.T) {
 		assert.Equal(t, []int{1, 2}, plan.Operations[0].Operand)
 	}
 }
+
+func TestDependentRequired(t *testing.T) {
+	t.Parallel()
+
+	fields := []tagparser.FieldInfo{
+		{Name: "BillingAddress", FieldKey: "billing_address"},
+		{Name: "BillingName", FieldKey: "billing_name", Rules: []tagparser.TagRule{
+			{Name: "required_with", Params: []string{"BillingAddress"}},
+		}},
+		{Name: "BillingZip", FieldKey: "billing_zip", Rules: []tagparser.TagRule{
+			{Name: "required_with", Params: []string{"billing_address", "BillingName"}},
+		}},
+	}
+
+	deps, err := tagparser.DependentRequired(fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"billing_address": {"billing_name", "billing_zip"},
+		"billing_name":    {"billing_zip"},
+	}, deps)
+}
+
+func TestDependentRequiredRejectsUnknownField(t *testing.T) {
+	t.Parallel()
+
+	fields := []tagparser.FieldInfo{
+		{Name: "BillingName", FieldKey: "billing_name", Rules: []tagparser.TagRule{
+			{Name: "required_with", Params: []string{"Billing"}},
+		}},
+	}
+
+	deps, err := tagparser.DependentRequired(fields)
+
+	assert.Nil(t, deps)
+	assert.ErrorIs(t, err, tagparser.ErrUnknownField)
+	assert.ErrorContains(t, err, "BillingName")
+	assert.ErrorContains(t, err, "required_with=Billing")
+}
+
+func TestCompileCrossFieldPlan(t *testing.T) {
+	t.Parallel()
+
+	fields := []tagparser.FieldInfo{
+		{Name: "Password", FieldKey: "password", Type: reflect.TypeFor[string]()},
+		{Name: "Confirm", FieldKey: "confirm", Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{
+			{Name: "eqfield", Params: []string{"Password"}},
+		}},
+		{Name: "Start", FieldKey: "start", Type: reflect.TypeFor[time.Time]()},
+		{Name: "End", FieldKey: "end", Type: reflect.TypeFor[*time.Time](), Rules: []tagparser.TagRule{
+			{Name: "gtfield", Params: []string{"start"}},
+		}},
+		{Name: "Status", FieldKey: "status", Type: reflect.TypeFor[string]()},
+		{Name: "Attempts", FieldKey: "attempts", Type: reflect.TypeFor[int8]()},
+		{Name: "Reason", FieldKey: "reason", Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{
+			{Name: "required_if", Params: []string{"Status", "closed", "attempts", "3"}},
+		}},
+	}
+
+	plan, err := tagparser.CompileCrossFieldPlan(fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []tagparser.FieldComparison{
+		{Field: "confirm", Op: tagparser.RuleEqField, Other: "password"},
+		{Field: "end", Op: tagparser.RuleGTField, Other: "start"},
+	}, plan.Comparisons)
+	assert.Equal(t, map[string][]tagparser.FieldCondition{
+		"reason": {{Field: "status", Value: "closed"}, {Field: "attempts", Value: int64(3)}},
+	}, plan.RequiredIf)
+}
+
+func TestCompileCrossFieldPlanRejectsInvalidRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rule tagparser.TagRule
+		err  error
+	}{
+		{name: "unknown comparison field", rule: tagparser.TagRule{Name: "eqfield", Params: []string{"Missing"}}, err: tagparser.ErrUnknownField},
+		{name: "other family", rule: tagparser.TagRule{Name: "gtfield", Params: []string{"Count"}}, err: tagparser.ErrInapplicableRule},
+		{name: "unpaired condition", rule: tagparser.TagRule{Name: "required_if", Params: []string{"Count"}}, err: tagparser.ErrInvalidArity},
+		{name: "unknown condition field", rule: tagparser.TagRule{Name: "required_if", Params: []string{"Missing", "1"}}, err: tagparser.ErrUnknownField},
+		{name: "invalid condition value", rule: tagparser.TagRule{Name: "required_if", Params: []string{"Count", "many"}}, err: tagparser.ErrInvalidOperand},
+		{name: "non-scalar condition field", rule: tagparser.TagRule{Name: "required_if", Params: []string{"Tags", "a"}}, err: tagparser.ErrInapplicableRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := []tagparser.FieldInfo{
+				{Name: "Count", FieldKey: "count", Type: reflect.TypeFor[int]()},
+				{Name: "Tags", FieldKey: "tags", Type: reflect.TypeFor[[]string]()},
+				{Name: "Name", FieldKey: "name", Type: reflect.TypeFor[string](), Rules: []tagparser.TagRule{tt.rule}},
+			}
+
+			_, err := tagparser.CompileCrossFieldPlan(fields)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.ErrorContains(t, err, "Name")
+		})
+	}
+}
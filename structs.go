@@ -1,6 +1,10 @@
 package gozod
 
-import "github.com/kaptinlin/gozod/types"
+import (
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+	"github.com/kaptinlin/gozod/types"
+)
 
 type FromStructOption = types.FromStructOption
 
@@ -29,3 +33,122 @@ func MustFromStruct[T any](opts ...FromStructOption) *types.ZodStruct[T, T] {
 func MustFromStructPtr[T any](opts ...FromStructOption) *types.ZodStruct[T, *T] {
 	return types.MustFromStructPtr[T](opts...)
 }
+
+// FieldFamily identifies the Go field types a struct tag rule applies to.
+type FieldFamily = tagparser.FieldFamily
+
+const (
+	FieldFamilyString          = tagparser.FieldFamilyString
+	FieldFamilySignedInteger   = tagparser.FieldFamilySignedInteger
+	FieldFamilyUnsignedInteger = tagparser.FieldFamilyUnsignedInteger
+	FieldFamilyFloat           = tagparser.FieldFamilyFloat
+	FieldFamilyBool            = tagparser.FieldFamilyBool
+	FieldFamilySlice           = tagparser.FieldFamilySlice
+	FieldFamilyArray           = tagparser.FieldFamilyArray
+	FieldFamilyMap             = tagparser.FieldFamilyMap
+	FieldFamilyStruct          = tagparser.FieldFamilyStruct
+	FieldFamilyTime            = tagparser.FieldFamilyTime
+)
+
+// TagRuleOperand states whether a registered struct tag rule takes an operand.
+type TagRuleOperand = tagparser.OperandArity
+
+const (
+	TagRuleOperandNone     = tagparser.OperandNone
+	TagRuleOperandOptional = tagparser.OperandOptional
+	TagRuleOperandRequired = tagparser.OperandRequired
+)
+
+type TagRuleFunc = types.TagRuleFunc
+type TagRuleOption = types.TagRuleOption
+
+// ErrInvalidTagRule reports a struct tag rule that cannot be registered.
+var ErrInvalidTagRule = tagparser.ErrInvalidRuleRegistration
+
+// RegisterTagRule adds a struct tag rule, such as gozod:"sku", that FromStruct
+// applies to fields of family.
+func RegisterTagRule(name string, family FieldFamily, fn TagRuleFunc, opts ...TagRuleOption) error {
+	return types.RegisterTagRule(name, family, fn, opts...)
+}
+
+// WithTagRuleOperand sets whether a registered rule takes an operand.
+func WithTagRuleOperand(arity TagRuleOperand) TagRuleOption {
+	return types.WithTagRuleOperand(arity)
+}
+
+// EnumValue is a defined string or integer type that RegisterEnum accepts.
+type EnumValue = types.EnumValue
+
+var (
+	// ErrInvalidEnumRegistration reports an enum type that cannot be registered.
+	ErrInvalidEnumRegistration = types.ErrInvalidEnumRegistration
+	// ErrUnregisteredEnumType reports an enum_type field whose type has no
+	// registered values.
+	ErrUnregisteredEnumType = types.ErrUnregisteredEnumType
+)
+
+// RegisterEnum records the values of T, such as its declared constants, for
+// fields of type T tagged gozod:"enum_type".
+func RegisterEnum[T EnumValue](values ...T) error {
+	return types.RegisterEnum(values...)
+}
+
+var (
+	// ErrInvalidInterfaceRegistration reports an interface type or union that
+	// cannot be registered.
+	ErrInvalidInterfaceRegistration = types.ErrInvalidInterfaceRegistration
+	// ErrUnregisteredInterfaceType reports a struct field of an interface
+	// type with methods and no registered union.
+	ErrUnregisteredInterfaceType = types.ErrUnregisteredInterfaceType
+)
+
+// RegisterInterface records the discriminated union that validates struct
+// fields of the interface type I.
+func RegisterInterface[I any](union *ZodDiscriminatedUnion[any, any]) error {
+	return types.RegisterInterface[I](union)
+}
+
+// InterfaceSchema returns the union registered for the interface type I.
+func InterfaceSchema[I any]() (*ZodDiscriminatedUnion[any, any], error) {
+	return types.InterfaceSchema[I]()
+}
+
+// MustInterfaceSchema returns the union registered for the interface type I
+// and panics when there is none. Generated schemas use it.
+func MustInterfaceSchema[I any]() *ZodDiscriminatedUnion[any, any] {
+	return types.MustInterfaceSchema[I]()
+}
+
+// FieldRelation is how a cross-field rule compares a field with another.
+type FieldRelation = types.FieldRelation
+
+const (
+	FieldEqual        = types.FieldEqual
+	FieldNotEqual     = types.FieldNotEqual
+	FieldGreater      = types.FieldGreater
+	FieldGreaterEqual = types.FieldGreaterEqual
+	FieldLess         = types.FieldLess
+	FieldLessEqual    = types.FieldLessEqual
+)
+
+// FieldComparison is one rule of CompareFields on a struct or object schema.
+type FieldComparison = types.FieldComparison
+
+// FieldCondition is one condition of RequiredIf on a struct or object schema.
+type FieldCondition = types.FieldCondition
+
+// StructFieldIssues collects field issues in reflection-free Parse methods
+// generated by gozodgen -validators.
+type StructFieldIssues = types.StructFieldIssues
+
+func ParseStructField[F any](schema ZodSchema, key string, value F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	return types.ParseStructField(schema, key, value, errs, ctx...)
+}
+
+func ParseStructFieldAs[F, P any](schema ZodSchema, key string, value F, convert func(P) F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	return types.ParseStructFieldAs(schema, key, value, convert, errs, ctx...)
+}
+
+func ParseNestedStructField[F any](parse func(F, ...*core.ParseContext) (F, error), key string, value F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	return types.ParseNestedStructField(parse, key, value, errs, ctx...)
+}
@@ -31,6 +31,7 @@ type (
 	ZodCUID2[T types.StringConstraint]           = types.ZodCUID2[T]
 	ZodGUID[T types.StringConstraint]            = types.ZodGUID[T]
 	ZodULID[T types.StringConstraint]            = types.ZodULID[T]
+	ZodTemplateLiteral[T types.StringConstraint] = types.ZodTemplateLiteral[T]
 	ZodXID[T types.StringConstraint]             = types.ZodXID[T]
 	ZodKSUID[T types.StringConstraint]           = types.ZodKSUID[T]
 	ZodNanoID[T types.StringConstraint]          = types.ZodNanoID[T]
@@ -47,6 +48,8 @@ type (
 	ZodStruct[T any, R any]                      = types.ZodStruct[T, R]
 	ZodUnion[T any, R any]                       = types.ZodUnion[T, R]
 	ZodIntersection[T any, R any]                = types.ZodIntersection[T, R]
+	ZodWhen[T any, R any]                        = types.ZodWhen[T, R]
+	ZodNot[T any, R any]                         = types.ZodNot[T, R]
 	ZodDiscriminatedUnion[T any, R any]          = types.ZodDiscriminatedUnion[T, R]
 	ZodAny[T any, R any]                         = types.ZodAny[T, R]
 	ZodUnknown[T any, R any]                     = types.ZodUnknown[T, R]
@@ -58,4 +61,5 @@ type (
 	ZodLazy[T types.LazyConstraint]              = types.ZodLazy[T]
 	ZodEnum[T comparable, R any]                 = types.ZodEnum[T, R]
 	ZodLiteral[T comparable, R any]              = types.ZodLiteral[T, R]
+	ZodBrand[T any, B any]                       = types.ZodBrand[T, B]
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
@@ -113,6 +114,18 @@ func (z *ZodAny[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodAny[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodAny[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a pointer-typed schema that accepts missing values.
 func (z *ZodAny[T, R]) Optional() *ZodAny[T, *T] {
 	in := z.internals.Clone()
@@ -183,6 +196,22 @@ func (z *ZodAny[T, R]) PrefaultFunc(fn func() T) *ZodAny[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodAny[T, R]) Catch(v T) *ZodAny[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodAny[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodAny[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodAny[T, R]) Meta(meta core.GlobalMeta) *ZodAny[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -214,18 +243,35 @@ func (z *ZodAny[T, R]) Overwrite(transform func(T) T, params ...any) *ZodAny[T,
 
 // Refine applies a custom validation function that matches the schema's output type R.
 func (z *ZodAny[T, R]) Refine(fn func(R) bool, params ...any) *ZodAny[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodAny[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodAny[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodAny[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodAny[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodAny[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodAny[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if r, ok := v.(R); ok {
-			return fn(r)
+			return fn(ctx, r)
 		}
 
 		// For R=*any, wrap the value in a pointer for pointer constraint types.
 		var zero R
 		if _, ok := any(zero).(*any); ok {
 			if v == nil {
-				return fn(any((*any)(nil)).(R))
+				return fn(ctx, any((*any)(nil)).(R))
 			}
-			return fn(any(new(v)).(R))
+			return fn(ctx, any(new(v)).(R))
 		}
 
 		return false
@@ -239,7 +285,7 @@ func (z *ZodAny[T, R]) Refine(fn func(R) bool, params ...any) *ZodAny[T, R] {
 		msg = norm
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	in := z.internals.Clone()
 	in.AddCheck(check)
 	return z.withInternals(in)
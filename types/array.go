@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -60,6 +61,11 @@ func (z *ZodArray[T, R]) IsNilable() bool {
 	return z.internals.IsNilable()
 }
 
+// evaluate reports that an array evaluates every item.
+func (z *ZodArray[T, R]) evaluate(any, *core.ParseContext) evaluation {
+	return evaluation{allItems: true}
+}
+
 // Parsing methods
 
 // Parse validates input and returns the parsed array value.
@@ -153,6 +159,18 @@ func (z *ZodArray[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodArray[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodArray[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Modifier methods
 
 // Optional returns a schema that accepts nil values with pointer constraint.
@@ -234,6 +252,32 @@ func (z *ZodArray[T, R]) PrefaultFunc(fn func() T) *ZodArray[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodArray[T, R]) Catch(v T) *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodArray[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodArray[T, R]) Readonly() *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Metadata methods
 
 // Meta returns a schema with merged metadata.
@@ -276,6 +320,32 @@ func (z *ZodArray[T, R]) NonEmpty(args ...any) *ZodArray[T, R] {
 	return z.Min(1, utils.FirstParam(args...))
 }
 
+// Contains requires between minimum and maximum elements to match schema,
+// like JSON Schema contains/minContains/maxContains. A negative maximum
+// leaves the count unbounded. Failures report the matching element indices
+// in the issue's Params["matched"].
+func (z *ZodArray[T, R]) Contains(schema any, minimum, maximum int, args ...any) *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Contains(containsSchema(schema), minimum, maximum, utils.FirstParam(args...)))
+	return z.withInternals(in)
+}
+
+// Unique requires all elements to be distinct, like JSON Schema
+// uniqueItems. Each duplicate is reported at its index, with the index of the
+// first occurrence in the issue's Params["firstIndex"].
+func (z *ZodArray[T, R]) Unique(args ...any) *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Unique(nil, utils.FirstParam(args...)))
+	return z.withInternals(in)
+}
+
+// UniqueBy is like Unique but compares elements by the value key returns.
+func (z *ZodArray[T, R]) UniqueBy(key func(any) any, args ...any) *ZodArray[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Unique(key, utils.FirstParam(args...)))
+	return z.withInternals(in)
+}
+
 // Schema accessor methods
 
 // Element returns the schema at the given index, or nil if out of range.
@@ -334,15 +404,32 @@ func (z *ZodArray[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds type-safe custom validation.
 func (z *ZodArray[T, R]) Refine(fn func(R) bool, params ...any) *ZodArray[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodArray[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodArray[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodArray[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodArray[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodArray[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodArray[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero R
 		switch any(zero).(type) {
 		case *T:
 			if v == nil {
-				return fn(any((*T)(nil)).(R))
+				return fn(ctx, any((*T)(nil)).(R))
 			}
 			if val, ok := v.(T); ok {
-				return fn(any(&val).(R))
+				return fn(ctx, any(&val).(R))
 			}
 			return false
 		default:
@@ -350,14 +437,14 @@ func (z *ZodArray[T, R]) Refine(fn func(R) bool, params ...any) *ZodArray[T, R]
 				return false
 			}
 			if val, ok := v.(T); ok {
-				return fn(any(val).(R))
+				return fn(ctx, any(val).(R))
 			}
 			return false
 		}
 	}
 
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	return z.withInternals(in)
 }
 
@@ -557,21 +644,20 @@ func (z *ZodArray[T, R]) validate(value []any, chks []core.ZodCheck, ctx *core.P
 	}
 
 	// Validate elements and collect errors.
-	var errs []core.ZodRawIssue
-
-	for i := range min(fixed, actual) {
-		if err := validateElement(value[i], z.internals.Items[i]); err != nil {
-			errs = append(errs, issues.CreateElementValidationIssue(i, "array", value[i], err))
-		}
-	}
-
+	schemas := slices.Clone(z.internals.Items)
 	if hasRest && actual > fixed {
-		for i := fixed; i < actual; i++ {
-			if err := validateElement(value[i], z.internals.Rest); err != nil {
-				errs = append(errs, issues.CreateElementValidationIssue(i, "array rest", value[i], err))
-			}
-		}
+		schemas = append(schemas, z.internals.Rest)
 	}
+	errs := walkIndexed(ctx, value, actual, parallelWorkers(ctx, schemas...), func(i int) []core.ZodRawIssue {
+		schema, origin := z.internals.Rest, "array rest"
+		if i < fixed {
+			schema, origin = z.internals.Items[i], "array"
+		}
+		if err := validateElement(value[i], schema, ctx); err != nil {
+			return []core.ZodRawIssue{issues.CreateElementValidationIssue(i, origin, value[i], err)}
+		}
+		return nil
+	})
 
 	if len(errs) > 0 {
 		return nil, issues.CreateArrayValidationIssues(errs)
@@ -581,14 +667,24 @@ func (z *ZodArray[T, R]) validate(value []any, chks []core.ZodCheck, ctx *core.P
 }
 
 // validateElement validates a single element against its schema.
-func validateElement(value any, schema core.ZodSchema) error {
+func validateElement(value any, schema core.ZodSchema, ctx *core.ParseContext) error {
 	if schema == nil {
 		return nil
 	}
-	_, err := schema.ParseAny(value)
+	_, err := schema.ParseAny(value, ctx)
 	return err
 }
 
+// canceledIssue reports a cancellation issue once the caller context is done.
+// Collection walks call it before each element so large inputs stop early.
+func canceledIssue(ctx *core.ParseContext, input any) (core.ZodRawIssue, bool) {
+	err := ctx.ContextErr()
+	if err == nil {
+		return core.ZodRawIssue{}, false
+	}
+	return issues.CreateCanceledIssue(err, input), true
+}
+
 // Constructor functions
 
 // newZodArrayFromDef constructs a new ZodArray from a definition.
@@ -754,3 +754,45 @@ func TestArray_KeepsElementIssueGroupsIndependent(t *testing.T) {
 	assert.Equal(t, "z", zodErr.Issues[1].Issues[0].Prefix)
 	assert.Equal(t, "q", zodErr.Issues[1].Issues[1].Suffix)
 }
+
+func TestArray_Contains(t *testing.T) {
+	schema := Array([]any{String(), String(), String()}).Contains(Literal("admin"), 1, 1)
+
+	result, err := schema.Parse([]any{"viewer", "admin", "editor"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"viewer", "admin", "editor"}, result)
+
+	_, err = schema.Parse([]any{"admin", "viewer", "admin"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooBig, zodErr.Issues[0].Code)
+	assert.Equal(t, []int{0, 2}, zodErr.Issues[0].Params["matched"])
+
+	_, err = schema.Parse([]any{"viewer", "viewer", "editor"})
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+	assert.Equal(t, []int{}, zodErr.Issues[0].Params["matched"])
+}
+
+func TestArray_Unique(t *testing.T) {
+	schema := Array([]any{Int(), Int(), Int()}).Unique()
+
+	_, err := schema.Parse([]any{1, 2, 3})
+	require.NoError(t, err)
+
+	_, err = schema.Parse([]any{1, 2, 1})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+
+	byParity := Array([]any{Int(), Int()}).UniqueBy(func(v any) any { return v.(int) % 2 })
+	_, err = byParity.Parse([]any{1, 2})
+	require.NoError(t, err)
+	_, err = byParity.Parse([]any{1, 3})
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/kaptinlin/gozod/core"
@@ -131,6 +132,18 @@ func (z *ZodBigInt[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodBigInt[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodBigInt[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse validates input with compile-time type safety.
 func (z *ZodBigInt[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
 	return engine.ParsePrimitiveStrict(
@@ -219,6 +232,24 @@ func (z *ZodBigInt[T]) PrefaultFunc(fn func() *big.Int) *ZodBigInt[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodBigInt[T]) Catch(v *big.Int) *ZodBigInt[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodBigInt[T]) CatchFunc(fn func(core.CatchContext) *big.Int) *ZodBigInt[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // Metadata methods
 
 // Meta returns a schema with merged metadata.
@@ -325,7 +356,24 @@ func (z *ZodBigInt[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine applies a type-safe custom validation function.
 func (z *ZodBigInt[T]) Refine(fn func(T) bool, params ...any) *ZodBigInt[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodBigInt[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodBigInt[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodBigInt[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodBigInt[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodBigInt[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodBigInt[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 
 		switch any(zero).(type) {
@@ -334,15 +382,15 @@ func (z *ZodBigInt[T]) Refine(fn func(T) bool, params ...any) *ZodBigInt[T] {
 				return false
 			}
 			if val, ok := v.(*big.Int); ok {
-				return fn(any(val).(T))
+				return fn(ctx, any(val).(T))
 			}
 			return false
 		case **big.Int:
 			if v == nil {
-				return fn(any((**big.Int)(nil)).(T))
+				return fn(ctx, any((**big.Int)(nil)).(T))
 			}
 			if val, ok := v.(*big.Int); ok {
-				return fn(any(&val).(T))
+				return fn(ctx, any(&val).(T))
 			}
 			return false
 		default:
@@ -350,7 +398,7 @@ func (z *ZodBigInt[T]) Refine(fn func(T) bool, params ...any) *ZodBigInt[T] {
 		}
 	}
 
-	check := checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...))
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel)
 	return z.withCheck(check)
 }
 
@@ -398,6 +446,10 @@ func (z *ZodBigInt[T]) parseNilInput(
 	}
 	r, handled, err := engine.ProcessNilModifiers[*big.Int](nil, ti, core.ZodTypeBigInt, pctx)
 	if err != nil {
+		if fallback, ok := engine.CatchFallback(nil, ti, err, pctx); ok {
+			v, convErr := engine.ConvertToConstraintType[*big.Int, T](fallback, pctx, core.ZodTypeBigInt)
+			return v, nil, true, convErr
+		}
 		return zero, nil, true, err
 	}
 	if !handled {
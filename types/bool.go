@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
@@ -123,6 +124,18 @@ func (z *ZodBool[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, error)
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodBool[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodBool[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil values with pointer constraint.
 func (z *ZodBool[T]) Optional() *ZodBool[*bool] {
 	in := z.internals.Clone()
@@ -197,6 +210,24 @@ func (z *ZodBool[T]) PrefaultFunc(fn func() bool) *ZodBool[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodBool[T]) Catch(v bool) *ZodBool[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodBool[T]) CatchFunc(fn func(core.CatchContext) bool) *ZodBool[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // NonOptional removes the optional flag, returning a bool constraint.
 func (z *ZodBool[T]) NonOptional() *ZodBool[bool] {
 	in := z.internals.Clone()
@@ -253,7 +284,24 @@ func (z *ZodBool[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine applies a custom validation function matching the schema's output type T.
 func (z *ZodBool[T]) Refine(fn func(T) bool, params ...any) *ZodBool[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodBool[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodBool[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodBool[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodBool[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodBool[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodBool[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 		switch any(zero).(type) {
 		case bool:
@@ -261,15 +309,15 @@ func (z *ZodBool[T]) Refine(fn func(T) bool, params ...any) *ZodBool[T] {
 				return false
 			}
 			if b, ok := v.(bool); ok {
-				return fn(any(b).(T))
+				return fn(ctx, any(b).(T))
 			}
 			return false
 		case *bool:
 			if v == nil {
-				return fn(any((*bool)(nil)).(T))
+				return fn(ctx, any((*bool)(nil)).(T))
 			}
 			if b, ok := v.(bool); ok {
-				return fn(any(&b).(T))
+				return fn(ctx, any(&b).(T))
 			}
 			return false
 		default:
@@ -283,7 +331,7 @@ func (z *ZodBool[T]) Refine(fn func(T) bool, params ...any) *ZodBool[T] {
 		msg = sp.Error
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
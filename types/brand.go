@@ -0,0 +1,120 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/kaptinlin/gozod/core"
+)
+
+// ZodBrand validates input with an inner schema and returns its output as the
+// nominal type B. T is the inner output type; B must have the same underlying
+// type, for example type UserID string over String().
+type ZodBrand[T, B any] struct {
+	inner     core.ZodType[T]
+	internals *core.ZodTypeInternals
+}
+
+var _ core.StrictZodType[string, string] = (*ZodBrand[string, string])(nil)
+
+// Brand returns a schema whose successful parse results have the named type B.
+// Input of the inner type T or of B itself is accepted. It panics when T is not
+// convertible to B.
+func Brand[B, T any](schema core.ZodType[T]) *ZodBrand[T, B] {
+	from, to := reflect.TypeFor[T](), reflect.TypeFor[B]()
+	if !from.ConvertibleTo(to) || from.Kind() != to.Kind() {
+		panic(fmt.Sprintf("Brand: %s is not a named form of %s", to, from))
+	}
+	in := schema.Internals().Clone()
+	in.Type = core.ZodTypeBrand
+	return &ZodBrand[T, B]{inner: schema, internals: in}
+}
+
+// Parse validates input with the inner schema and brands the result.
+func (z *ZodBrand[T, B]) Parse(input any, ctx ...*core.ParseContext) (B, error) {
+	v, err := z.inner.Parse(input, ctx...)
+	if err != nil {
+		var zero B
+		return zero, err
+	}
+	return brandValue[T, B](v), nil
+}
+
+// MustParse validates input and panics on failure.
+func (z *ZodBrand[T, B]) MustParse(input any, ctx ...*core.ParseContext) B {
+	result, err := z.Parse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// StrictParse validates an unbranded value and brands the result.
+func (z *ZodBrand[T, B]) StrictParse(input T, ctx ...*core.ParseContext) (B, error) {
+	return z.Parse(input, ctx...)
+}
+
+// MustStrictParse validates an unbranded value and panics on failure.
+func (z *ZodBrand[T, B]) MustStrictParse(input T, ctx ...*core.ParseContext) B {
+	result, err := z.StrictParse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseAny validates input and returns the branded result as any.
+func (z *ZodBrand[T, B]) ParseAny(input any, ctx ...*core.ParseContext) (any, error) {
+	return z.Parse(input, ctx...)
+}
+
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodBrand[T, B]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (B, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodBrand[T, B]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
+// Internals returns the schema's internal configuration.
+func (z *ZodBrand[T, B]) Internals() *core.ZodTypeInternals {
+	return z.internals
+}
+
+// IsOptional reports whether this schema accepts missing values.
+func (z *ZodBrand[T, B]) IsOptional() bool {
+	return z.internals.IsOptional()
+}
+
+// IsNilable reports whether this schema accepts nil values.
+func (z *ZodBrand[T, B]) IsNilable() bool {
+	return z.internals.IsNilable()
+}
+
+// Inner returns the unbranded schema.
+func (z *ZodBrand[T, B]) Inner() core.ZodSchema {
+	return z.inner
+}
+
+// Unwrap returns the unbranded schema with its typed output.
+func (z *ZodBrand[T, B]) Unwrap() core.ZodType[T] {
+	return z.inner
+}
+
+// brandValue converts v to the nominal type B.
+func brandValue[T, B any](v T) B {
+	if b, ok := any(v).(B); ok {
+		return b
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		var zero B
+		return zero
+	}
+	return rv.Convert(reflect.TypeFor[B]()).Interface().(B)
+}
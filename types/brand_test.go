@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/kaptinlin/gozod/types"
+)
+
+type brandUserID string
+
+type brandOrderID string
+
+type brandCount int
+
+func TestBrand_ReturnsNamedType(t *testing.T) {
+	userID := Brand[brandUserID](String().Min(3))
+	orderID := Brand[brandOrderID](String().Min(3))
+
+	got, err := userID.Parse("abc")
+	require.NoError(t, err)
+	assert.Equal(t, brandUserID("abc"), got)
+
+	order, err := orderID.Parse(brandOrderID("xyz"))
+	require.NoError(t, err, "input of the branded type is accepted")
+	assert.Equal(t, brandOrderID("xyz"), order)
+
+	strict, err := userID.StrictParse("def")
+	require.NoError(t, err)
+	assert.Equal(t, brandUserID("def"), strict)
+
+	_, err = userID.Parse("ab")
+	require.Error(t, err, "inner validation still applies")
+
+	count, err := Brand[brandCount](Int().Min(1)).Parse(5)
+	require.NoError(t, err)
+	assert.Equal(t, brandCount(5), count)
+}
+
+func TestBrand_PanicsOnIncompatibleType(t *testing.T) {
+	assert.Panics(t, func() { Brand[brandCount](String()) })
+}
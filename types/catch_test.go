@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+func TestCatch_SubstitutesFallback(t *testing.T) {
+	t.Run("primitive validation failure", func(t *testing.T) {
+		schema := String().Min(3).Catch("fallback")
+
+		got, err := schema.Parse("ab")
+		require.NoError(t, err)
+		assert.Equal(t, "fallback", got)
+
+		got, err = schema.Parse(42)
+		require.NoError(t, err)
+		assert.Equal(t, "fallback", got)
+
+		got, err = schema.Parse("valid")
+		require.NoError(t, err)
+		assert.Equal(t, "valid", got)
+	})
+
+	t.Run("pointer output", func(t *testing.T) {
+		schema := Int().Optional().Catch(7)
+
+		got, err := schema.Parse("nope")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, 7, *got)
+	})
+
+	t.Run("strict parse", func(t *testing.T) {
+		got, err := Int().Min(10).Catch(10).StrictParse(3)
+		require.NoError(t, err)
+		assert.Equal(t, 10, got)
+	})
+
+	t.Run("collection fallback is cloned per parse", func(t *testing.T) {
+		schema := Slice[string](String()).Catch([]string{"a"})
+
+		first, err := schema.Parse([]any{1})
+		require.NoError(t, err)
+		first[0] = "changed"
+
+		second, err := schema.Parse([]any{1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, second)
+	})
+}
+
+func TestCatch_FieldFailureKeepsGoing(t *testing.T) {
+	type Profile struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	schema := Struct[Profile](core.StructSchema{
+		"name": String().Min(1),
+		"age":  Int().Min(0).Catch(0),
+	})
+
+	got, err := schema.Parse(Profile{Name: "ada", Age: -5})
+	require.NoError(t, err)
+	assert.Equal(t, Profile{Name: "ada", Age: 0}, got)
+
+	_, err = schema.Parse(Profile{Age: -5})
+	require.Error(t, err, "fields without Catch still fail")
+
+	object := Object(core.ObjectSchema{
+		"tags": Slice[string](String()).Catch([]string{}),
+	})
+	result, err := object.Parse(map[string]any{"tags": "not-a-slice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, result["tags"])
+}
+
+func TestCatchFunc_ReceivesSwallowedIssues(t *testing.T) {
+	var seen core.CatchContext
+	schema := String().Min(5).Email().CatchFunc(func(ctx core.CatchContext) string {
+		seen = ctx
+		return "unknown"
+	})
+
+	got, err := schema.Parse("ab")
+	require.NoError(t, err)
+	assert.Equal(t, "unknown", got)
+	assert.Equal(t, "ab", seen.Input)
+	require.Len(t, seen.Issues, 2)
+	assert.Equal(t, core.TooSmall, seen.Issues[0].Code)
+	assert.Equal(t, core.InvalidFormat, seen.Issues[1].Code)
+	require.Error(t, seen.Error)
+}
+
+func TestCatch_DoesNotMaskCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	schema := Slice[string](String()).Catch([]string{"fallback"})
+	_, err := schema.ParseWithContext(ctx, []string{"a"})
+	require.Error(t, err)
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+// Compile returns a copy of schema with its validation plan resolved ahead of
+// time. Struct schemas bind every shape entry to its Go field index, object
+// schemas cache how Go struct inputs map to keys, the modifier state that
+// decides whether a field may be absent is read once, and lazy schemas
+// resolve their target. Compilation descends through struct, object, slice
+// and lazy schemas; other schemas are used as they are. Recursive lazy
+// targets are expanded one level deep.
+//
+// The compiled schema parses exactly like schema, reports the same issues,
+// and is safe for concurrent use. Fields are visited in sorted name order.
+// Compile the final schema: deriving a new schema from a compiled one, for
+// example with Optional or Extend, drops the plan.
+func Compile[S core.ZodSchema](schema S) S {
+	c := &compiler{done: make(map[core.ZodSchema]core.ZodSchema)}
+	if compiled, ok := c.compile(schema).(S); ok {
+		return compiled
+	}
+	return schema
+}
+
+// compilable is implemented by schemas that can precompute a plan.
+type compilable interface {
+	compile(c *compiler) core.ZodSchema
+}
+
+// compiler memoizes compiled schemas so shared subschemas compile once.
+type compiler struct {
+	done   map[core.ZodSchema]core.ZodSchema
+	inLazy bool
+}
+
+func (c *compiler) compile(schema core.ZodSchema) core.ZodSchema {
+	if schema == nil {
+		return nil
+	}
+	if compiled, ok := c.done[schema]; ok {
+		return compiled
+	}
+	node, ok := schema.(compilable)
+	if !ok {
+		return schema
+	}
+	// A schema reached again while it compiles refers to itself; keep it.
+	c.done[schema] = schema
+	compiled := node.compile(c)
+	c.done[schema] = compiled
+	return compiled
+}
+
+// compileShape compiles every schema in shape into a new map.
+func (c *compiler) compileShape(shape map[string]core.ZodSchema) map[string]core.ZodSchema {
+	out := make(map[string]core.ZodSchema, len(shape))
+	for name, schema := range shape {
+		out[name] = c.compile(schema)
+	}
+	return out
+}
+
+// =============================================================================
+// SHAPE FIELDS
+// =============================================================================
+
+// shapeField is a shape entry with the modifier state parsing consults.
+type shapeField struct {
+	name   string
+	schema core.ZodSchema
+	// optional reports whether the field may be absent.
+	optional bool
+	// exactOptional reports whether an explicit nil is rejected.
+	exactOptional bool
+	// fallback reports whether an absent field parses nil for a default.
+	fallback bool
+	// get and set are the Go field indexes of a struct schema field; nil
+	// when the struct has no such field or it cannot be set.
+	get, set []int
+}
+
+// resolveShapeFields returns the entries of shape in sorted name order, or
+// in map order when ordered is false. optional decides whether a field may
+// be absent given its partial state.
+func resolveShapeFields(shape map[string]core.ZodSchema, ordered bool, optional func(core.ZodSchema, string) bool) []shapeField {
+	fields := make([]shapeField, 0, len(shape))
+	for name, schema := range shapeFields(shape, ordered) {
+		field := shapeField{name: name, schema: schema, optional: optional(schema, name)}
+		if in := shapeFieldInternals(schema); in != nil {
+			field.exactOptional = in.IsExactOptional()
+			field.fallback = in.NilInputUsesFallback()
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func shapeFieldInternals(schema core.ZodSchema) *core.ZodTypeInternals {
+	if schema == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(schema); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+	return schema.Internals()
+}
+
+// bindStructFields resolves the Go field indexes of fields in structType.
+// A name matches a Go field name first and a field-name tag second.
+func bindStructFields(fields []shapeField, fieldNameTag string, structType reflect.Type) {
+	var bindings []structFieldBinding
+	for i := range fields {
+		field := &fields[i]
+		if f, ok := structType.FieldByName(field.name); ok {
+			field.get = f.Index
+			if f.IsExported() {
+				field.set = f.Index
+				continue
+			}
+		}
+		if bindings == nil {
+			bindings = structFieldBindings(fieldNameTag, structType)
+		}
+		if b, ok := findStructFieldBinding(bindings, field.name); ok {
+			if field.get == nil {
+				field.get = b.index
+			}
+			field.set = b.index
+		}
+	}
+}
+
+// =============================================================================
+// PLANS
+// =============================================================================
+
+// structPlan is the compiled field walk of a struct schema for one Go type.
+type structPlan struct {
+	structType reflect.Type
+	fields     []shapeField
+}
+
+// objectPlan is the compiled field walk of an object schema.
+type objectPlan struct {
+	fields []shapeField
+	// keys caches the exported, tagged fields of Go struct inputs by type.
+	keys sync.Map // reflect.Type -> []objectStructKey
+}
+
+// objectStructKey maps a Go struct field to an object key.
+type objectStructKey struct {
+	index []int
+	name  string
+}
+
+// structKeys returns the object keys of the Go struct type t.
+func (p *objectPlan) structKeys(t reflect.Type, fieldNameTag string) []objectStructKey {
+	if cached, ok := p.keys.Load(t); ok {
+		return cached.([]objectStructKey)
+	}
+	var keys []objectStructKey
+	for _, field := range tagparser.VisibleFields(fieldNameTagOrDefault(fieldNameTag), t) {
+		if field.Key.Skip {
+			continue
+		}
+		keys = append(keys, objectStructKey{index: field.Index, name: field.Key.Name})
+	}
+	cached, _ := p.keys.LoadOrStore(t, keys)
+	return cached.([]objectStructKey)
+}
+
+// =============================================================================
+// COMPILABLE SCHEMAS
+// =============================================================================
+
+func (z *ZodStruct[T, R]) compile(c *compiler) core.ZodSchema {
+	clone := &ZodStruct[T, R]{internals: z.newStructInternals(z.internals.Clone())}
+	clone.internals.Shape = c.compileShape(z.internals.Shape)
+	if structType := reflect.TypeFor[T](); structType.Kind() == reflect.Struct {
+		fields := resolveShapeFields(clone.internals.Shape, true, clone.isFieldOptional)
+		bindStructFields(fields, clone.internals.FieldNameTag, structType)
+		clone.internals.plan = &structPlan{structType: structType, fields: fields}
+	}
+	return clone
+}
+
+// structFields returns the field walk for structType, from the compiled
+// plan when it matches.
+func (z *ZodStruct[T, R]) structFields(structType reflect.Type, ordered bool) []shapeField {
+	if plan := z.internals.plan; plan != nil && plan.structType == structType {
+		return plan.fields
+	}
+	fields := resolveShapeFields(z.internals.Shape, ordered, z.isFieldOptional)
+	bindStructFields(fields, z.internals.FieldNameTag, structType)
+	return fields
+}
+
+func (z *ZodObject[T, R]) compile(c *compiler) core.ZodSchema {
+	in := z.newObjectInternals(z.internals.Clone())
+	in.Shape = c.compileShape(z.internals.Shape)
+	in.Catchall = c.compile(z.internals.Catchall)
+	clone := &ZodObject[T, R]{internals: in}
+	in.plan = &objectPlan{fields: resolveShapeFields(in.Shape, true, clone.isFieldOptional)}
+	return clone
+}
+
+// objectFields returns the field walk, from the compiled plan when present.
+func (z *ZodObject[T, R]) objectFields(ordered bool) []shapeField {
+	if plan := z.internals.plan; plan != nil {
+		return plan.fields
+	}
+	return resolveShapeFields(z.internals.Shape, ordered, z.isFieldOptional)
+}
+
+func (z *ZodSlice[T, R]) compile(c *compiler) core.ZodSchema {
+	element, ok := z.internals.Element.(core.ZodSchema)
+	if !ok {
+		return z
+	}
+	in := z.newSliceInternals(z.internals.Clone())
+	in.Element = c.compile(element)
+	return &ZodSlice[T, R]{internals: in}
+}
+
+func (z *ZodLazy[T]) compile(c *compiler) core.ZodSchema {
+	if c.inLazy {
+		return z
+	}
+	inner := z.resolveInner()
+	if inner == nil {
+		return z
+	}
+	target := core.ZodSchema(inner)
+	if w, ok := inner.(*schemaWrapper); ok {
+		if schema, ok := w.inner.(core.ZodSchema); ok {
+			target = schema
+		}
+	}
+
+	c.inLazy = true
+	compiled := c.compile(target)
+	c.inLazy = false
+
+	clone := &ZodLazy[T]{internals: z.cloneState(z.internals.Clone())}
+	clone.internals.innerType = convertToAnyInterface(compiled)
+	return clone
+}
@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+type compileAddress struct {
+	Street string `json:"street" gozod:"required,min=3"`
+	City   string `json:"city" gozod:"required,min=2"`
+}
+
+type compileContact struct {
+	Kind  string `json:"kind" gozod:"required,enum=email phone"`
+	Value string `json:"value" gozod:"required,min=1"`
+}
+
+type compileUser struct {
+	Name     string           `json:"name" gozod:"required,min=2"`
+	Age      int              `json:"age" gozod:"min=0,max=150"`
+	Nickname *string          `json:"nickname"`
+	Address  compileAddress   `json:"address" gozod:"required"`
+	Contacts []compileContact `json:"contacts" gozod:"required,min=1"`
+}
+
+type compileNode struct {
+	Name     string         `json:"name" gozod:"required,min=1"`
+	Children []*compileNode `json:"children"`
+}
+
+func TestCompile_MatchesUncompiledParse(t *testing.T) {
+	schema := MustFromStruct[compileUser]()
+	compiled := Compile(schema)
+	require.NotSame(t, schema, compiled)
+
+	valid := compileUser{
+		Name:     "Ada",
+		Age:      36,
+		Address:  compileAddress{Street: "1 Loop", City: "London"},
+		Contacts: []compileContact{{Kind: "email", Value: "ada@example.com"}},
+	}
+	invalid := compileUser{
+		Name:     "A",
+		Age:      200,
+		Address:  compileAddress{Street: "1", City: "L"},
+		Contacts: []compileContact{{Kind: "fax", Value: ""}},
+	}
+
+	want, err := schema.Parse(valid)
+	require.NoError(t, err)
+	got, err := compiled.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, wantErr := schema.Parse(invalid)
+	_, gotErr := compiled.Parse(invalid)
+	var wantIssues, gotIssues *issues.ZodError
+	require.True(t, issues.IsZodError(wantErr, &wantIssues))
+	require.True(t, issues.IsZodError(gotErr, &gotIssues))
+	assert.ElementsMatch(t, wantIssues.Issues, gotIssues.Issues)
+}
+
+func TestCompile_ObjectStructInput(t *testing.T) {
+	type Input struct {
+		Name  string `json:"name"`
+		Email string `json:"email,omitempty"`
+		Skip  string `json:"-"`
+	}
+
+	schema := Object(core.ObjectSchema{
+		"name":  String().Min(2),
+		"email": String().Email().Optional(),
+	})
+	compiled := Compile(schema)
+
+	for _, input := range []any{
+		Input{Name: "Ada", Email: "ada@example.com", Skip: "x"},
+		&Input{Name: "Ada"},
+		map[string]any{"name": "A"},
+	} {
+		want, wantErr := schema.Parse(input)
+		got, gotErr := compiled.Parse(input)
+		assert.Equal(t, want, got)
+		assert.Equal(t, wantErr == nil, gotErr == nil)
+	}
+}
+
+func TestCompile_RecursiveLazy(t *testing.T) {
+	schema := MustFromStruct[compileNode]()
+	compiled := Compile(schema)
+
+	tree := compileNode{Name: "root", Children: []*compileNode{
+		{Name: "a", Children: []*compileNode{{Name: "a1"}}},
+		{Name: "b"},
+	}}
+	want, err := schema.Parse(tree)
+	require.NoError(t, err)
+	got, err := compiled.Parse(tree)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	bad := compileNode{Children: []*compileNode{{Name: "a"}}}
+	_, wantErr := schema.Parse(bad)
+	_, gotErr := compiled.Parse(bad)
+	var wantIssues, gotIssues *issues.ZodError
+	require.True(t, issues.IsZodError(wantErr, &wantIssues))
+	require.True(t, issues.IsZodError(gotErr, &gotIssues))
+	assert.ElementsMatch(t, wantIssues.Issues, gotIssues.Issues)
+}
+
+func TestCompile_ConcurrentParse(t *testing.T) {
+	compiled := Compile(MustFromStruct[compileUser]())
+	input := compileUser{
+		Name:     "Ada",
+		Address:  compileAddress{Street: "1 Loop", City: "London"},
+		Contacts: []compileContact{{Kind: "phone", Value: "555"}},
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 50 {
+				got, err := compiled.Parse(input)
+				assert.NoError(t, err)
+				assert.Equal(t, input, got)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestCompile_DerivedSchemaDropsPlan(t *testing.T) {
+	type Pair struct {
+		A string `json:"a"`
+		B string `json:"b"`
+	}
+
+	compiled := Compile(Struct[Pair](core.StructSchema{"a": String().Min(1)}))
+	extended := compiled.Extend(core.StructSchema{"b": String().Min(1)})
+
+	_, err := extended.Parse(Pair{A: "x"})
+	require.Error(t, err, "the extended field is validated")
+	_, err = compiled.Parse(Pair{A: "x"})
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"math"
 	"math/cmplx"
 
@@ -126,6 +127,18 @@ func (z *ZodComplex[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, err
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodComplex[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodComplex[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse validates input with compile-time type safety.
 func (z *ZodComplex[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
 	var zero T
@@ -319,6 +332,45 @@ func (z *ZodComplex[T]) PrefaultFunc(fn func() complex128) *ZodComplex[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodComplex[T]) Catch(v complex128) *ZodComplex[T] {
+	in := z.internals.Clone()
+	var zero T
+	switch any(zero).(type) {
+	case *complex64:
+		in.SetCatchValue(new(complex64(v)))
+	case *complex128:
+		in.SetCatchValue(new(v))
+	case complex64:
+		in.SetCatchValue(complex64(v))
+	default:
+		in.SetCatchValue(v)
+	}
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodComplex[T]) CatchFunc(fn func(core.CatchContext) complex128) *ZodComplex[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		v := fn(ctx)
+		var zero T
+		switch any(zero).(type) {
+		case *complex64:
+			return new(complex64(v))
+		case *complex128:
+			return new(v)
+		case complex64:
+			return complex64(v)
+		default:
+			return v
+		}
+	})
+	return z.withInternals(in)
+}
+
 // Metadata methods.
 
 // Meta returns a schema with merged metadata.
@@ -475,7 +527,24 @@ func (z *ZodComplex[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine applies a custom validation function matching the schema's output type T.
 func (z *ZodComplex[T]) Refine(fn func(T) bool, params ...any) *ZodComplex[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodComplex[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodComplex[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodComplex[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodComplex[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodComplex[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodComplex[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 		switch any(zero).(type) {
 		case complex64:
@@ -486,16 +555,16 @@ func (z *ZodComplex[T]) Refine(fn func(T) bool, params ...any) *ZodComplex[T] {
 			if !ok {
 				return false
 			}
-			return fn(any(c).(T))
+			return fn(ctx, any(c).(T))
 		case *complex64:
 			if v == nil {
-				return fn(any((*complex64)(nil)).(T))
+				return fn(ctx, any((*complex64)(nil)).(T))
 			}
 			c, ok := v.(complex64)
 			if !ok {
 				return false
 			}
-			return fn(any(&c).(T))
+			return fn(ctx, any(&c).(T))
 		case complex128:
 			if v == nil {
 				return false
@@ -504,21 +573,21 @@ func (z *ZodComplex[T]) Refine(fn func(T) bool, params ...any) *ZodComplex[T] {
 			if !ok {
 				return false
 			}
-			return fn(any(c).(T))
+			return fn(ctx, any(c).(T))
 		case *complex128:
 			if v == nil {
-				return fn(any((*complex128)(nil)).(T))
+				return fn(ctx, any((*complex128)(nil)).(T))
 			}
 			c, ok := v.(complex128)
 			if !ok {
 				return false
 			}
-			return fn(any(&c).(T))
+			return fn(ctx, any(&c).(T))
 		default:
 			return false
 		}
 	}
-	check := checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...))
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel)
 	return z.withCheck(check)
 }
 
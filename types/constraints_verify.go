@@ -59,6 +59,8 @@ var (
 	_ core.Describable[*ZodCUID2[*string]]                         = (*ZodCUID2[*string])(nil)
 	_ core.Describable[*ZodULID[string]]                           = (*ZodULID[string])(nil)
 	_ core.Describable[*ZodULID[*string]]                          = (*ZodULID[*string])(nil)
+	_ core.Describable[*ZodTemplateLiteral[string]]                = (*ZodTemplateLiteral[string])(nil)
+	_ core.Describable[*ZodTemplateLiteral[*string]]               = (*ZodTemplateLiteral[*string])(nil)
 	_ core.Describable[*ZodXID[string]]                            = (*ZodXID[string])(nil)
 	_ core.Describable[*ZodXID[*string]]                           = (*ZodXID[*string])(nil)
 	_ core.Describable[*ZodKSUID[string]]                          = (*ZodKSUID[string])(nil)
@@ -101,6 +103,8 @@ var (
 	_ core.Describable[*ZodTuple[[]any, []any]]                    = (*ZodTuple[[]any, []any])(nil)
 	_ core.Describable[*ZodUnion[any, any]]                        = (*ZodUnion[any, any])(nil)
 	_ core.Describable[*ZodUnknown[any, any]]                      = (*ZodUnknown[any, any])(nil)
+	_ core.Describable[*ZodWhen[any, any]]                         = (*ZodWhen[any, any])(nil)
+	_ core.Describable[*ZodNot[any, any]]                          = (*ZodNot[any, any])(nil)
 	_ core.Describable[*ZodXor[any, any]]                          = (*ZodXor[any, any])(nil)
 )
 
@@ -140,6 +144,8 @@ var (
 	_ core.Refineable[*ZodCUID2[*string]]                         = (*ZodCUID2[*string])(nil)
 	_ core.Refineable[*ZodULID[string]]                           = (*ZodULID[string])(nil)
 	_ core.Refineable[*ZodULID[*string]]                          = (*ZodULID[*string])(nil)
+	_ core.Refineable[*ZodTemplateLiteral[string]]                = (*ZodTemplateLiteral[string])(nil)
+	_ core.Refineable[*ZodTemplateLiteral[*string]]               = (*ZodTemplateLiteral[*string])(nil)
 	_ core.Refineable[*ZodXID[string]]                            = (*ZodXID[string])(nil)
 	_ core.Refineable[*ZodXID[*string]]                           = (*ZodXID[*string])(nil)
 	_ core.Refineable[*ZodKSUID[string]]                          = (*ZodKSUID[string])(nil)
@@ -181,6 +187,8 @@ var (
 	_ core.Refineable[*ZodTime[time.Time]]                        = (*ZodTime[time.Time])(nil)
 	_ core.Refineable[*ZodUnion[any, any]]                        = (*ZodUnion[any, any])(nil)
 	_ core.Refineable[*ZodUnknown[any, any]]                      = (*ZodUnknown[any, any])(nil)
+	_ core.Refineable[*ZodWhen[any, any]]                         = (*ZodWhen[any, any])(nil)
+	_ core.Refineable[*ZodNot[any, any]]                          = (*ZodNot[any, any])(nil)
 	_ core.Refineable[*ZodXor[any, any]]                          = (*ZodXor[any, any])(nil)
 )
 
@@ -197,6 +205,8 @@ var (
 	_ core.StrictZodType[any, any]                                 = (*ZodDiscriminatedUnion[any, any])(nil)
 	_ core.StrictZodType[any, any]                                 = (*ZodUnknown[any, any])(nil)
 	_ core.StrictZodType[any, any]                                 = (*ZodXor[any, any])(nil)
+	_ core.StrictZodType[any, any]                                 = (*ZodWhen[any, any])(nil)
+	_ core.StrictZodType[any, any]                                 = (*ZodNot[any, any])(nil)
 	_ core.StrictZodType[string, string]                           = (*ZodString[string])(nil)
 	_ core.StrictZodType[bool, bool]                               = (*ZodBool[bool])(nil)
 	_ core.StrictZodType[int, int]                                 = (*ZodIntegerTyped[int, int])(nil)
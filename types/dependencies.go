@@ -0,0 +1,140 @@
+package types
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// withDependentRequired returns a copy of current with the entries of deps
+// added. An entry for a key already present replaces it.
+func withDependentRequired(current, deps map[string][]string) map[string][]string {
+	merged := maps.Clone(current)
+	if merged == nil {
+		merged = make(map[string][]string, len(deps))
+	}
+	for key, dependents := range deps {
+		merged[key] = slices.Clone(dependents)
+	}
+	return merged
+}
+
+// withDependentSchemas returns a copy of current with the entries of deps
+// added. An entry for a key already present replaces it.
+func withDependentSchemas(current, deps map[string]core.ZodSchema) map[string]core.ZodSchema {
+	merged := maps.Clone(current)
+	if merged == nil {
+		merged = make(map[string]core.ZodSchema, len(deps))
+	}
+	for key, schema := range deps {
+		if schema == nil {
+			panic("DependentSchemas: schema is required")
+		}
+		merged[key] = schema
+	}
+	return merged
+}
+
+// cloneDependentRequired deep-copies a dependentRequired map.
+func cloneDependentRequired(deps map[string][]string) map[string][]string {
+	if deps == nil {
+		return nil
+	}
+	cloned := make(map[string][]string, len(deps))
+	for key, dependents := range deps {
+		cloned[key] = slices.Clone(dependents)
+	}
+	return cloned
+}
+
+// structFieldKey returns the field key of the struct field that name refers
+// to by Go field name or field-name tag, or name itself when no field matches.
+func structFieldKey(bindings []structFieldBinding, name string) string {
+	binding, ok := findStructFieldBinding(bindings, name)
+	if !ok || binding.skipFieldNameTag {
+		return name
+	}
+	return binding.fieldKey
+}
+
+// dependentRequiredFieldKeys returns a copy of deps with every field named by
+// its field key.
+func dependentRequiredFieldKeys(deps map[string][]string, bindings []structFieldBinding) map[string][]string {
+	if deps == nil {
+		return nil
+	}
+	keyed := make(map[string][]string, len(deps))
+	for name, dependents := range deps {
+		key := structFieldKey(bindings, name)
+		for _, dependent := range dependents {
+			keyed[key] = append(keyed[key], structFieldKey(bindings, dependent))
+		}
+	}
+	return keyed
+}
+
+// dependentSchemaFieldKeys returns a copy of deps with every field named by
+// its field key.
+func dependentSchemaFieldKeys(deps map[string]core.ZodSchema, bindings []structFieldBinding) map[string]core.ZodSchema {
+	if deps == nil {
+		return nil
+	}
+	keyed := make(map[string]core.ZodSchema, len(deps))
+	for name, schema := range deps {
+		keyed[structFieldKey(bindings, name)] = schema
+	}
+	return keyed
+}
+
+// dependentRequiredIssues reports a missing_required issue at each absent
+// dependent of every present key, in key order. The issue carries the key
+// that required it in Params["dependency"].
+func dependentRequiredIssues(deps map[string][]string, present func(string) bool) []core.ZodRawIssue {
+	var raws []core.ZodRawIssue
+	for _, key := range slices.Sorted(maps.Keys(deps)) {
+		if !present(key) {
+			continue
+		}
+		for _, dependent := range deps[key] {
+			if !present(dependent) {
+				raws = append(raws, missingDependentIssue(key, dependent))
+			}
+		}
+	}
+	return raws
+}
+
+// missingDependentIssue reports dependent as missing although dependency is
+// present.
+func missingDependentIssue(dependency, dependent string) core.ZodRawIssue {
+	raw := issues.CreateMissingRequiredIssue(dependent, "field")
+	raw.Properties["params"] = map[string]any{"dependency": dependency}
+	raw.Path = []any{dependent}
+	return raw
+}
+
+// dependentSchemaIssues validates value against the schema of every present
+// key, in key order, and returns the issues of the schemas that reject it.
+func dependentSchemaIssues(deps map[string]core.ZodSchema, present func(string) bool, value any, ctx *core.ParseContext) []core.ZodRawIssue {
+	var raws []core.ZodRawIssue
+	for _, key := range slices.Sorted(maps.Keys(deps)) {
+		if !present(key) {
+			continue
+		}
+		_, err := deps[key].ParseAny(value, ctx)
+		if err == nil {
+			continue
+		}
+		if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
+			for _, issue := range zodErr.Issues {
+				raws = append(raws, issues.ConvertZodIssueToRaw(issue))
+			}
+			continue
+		}
+		raws = append(raws, issues.CreateIssue(core.Custom, err.Error(), nil, value))
+	}
+	return raws
+}
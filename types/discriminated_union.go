@@ -2,9 +2,11 @@ package types
 
 import (
 	"cmp"
+	"context"
 	"errors"
 	"fmt"
 	"maps"
+	"reflect"
 	"slices"
 
 	"github.com/kaptinlin/gozod/core"
@@ -117,7 +119,7 @@ func (z *ZodDiscriminatedUnion[T, R]) extractPtr(input any) (*any, bool) {
 }
 
 func (z *ZodDiscriminatedUnion[T, R]) validate(input any, chks []core.ZodCheck, pctx *core.ParseContext) (any, error) {
-	m, ok := input.(map[string]any)
+	m, ok := discriminatedUnionFields(input)
 	if !ok {
 		return nil, issues.CreateInvalidTypeError(core.ZodTypeObject, input, pctx)
 	}
@@ -127,7 +129,7 @@ func (z *ZodDiscriminatedUnion[T, R]) validate(input any, chks []core.ZodCheck,
 		return nil, issues.CreateMissingRequiredError(z.internals.Discriminator, "discriminator field", input, pctx)
 	}
 
-	r, err := z.parseVariant(m, dv, pctx)
+	r, err := z.parseVariant(input, dv, pctx)
 	if err != nil {
 		return nil, err
 	}
@@ -137,14 +139,42 @@ func (z *ZodDiscriminatedUnion[T, R]) validate(input any, chks []core.ZodCheck,
 	return r, nil
 }
 
-// parseVariant dispatches to the schema indexed by the discriminator value.
-func (z *ZodDiscriminatedUnion[T, R]) parseVariant(m map[string]any, dv any, pctx *core.ParseContext) (any, error) {
-	if target, ok := z.internals.DiscMap[dv]; ok {
-		return target.ParseAny(m, pctx)
+// discriminatedUnionFields returns the keyed fields of input: a map as it is,
+// or the fields of a Go struct, so that interface-typed struct fields holding
+// a variant value can be dispatched.
+func discriminatedUnionFields(input any) (map[string]any, bool) {
+	if m, ok := input.(map[string]any); ok {
+		return m, true
+	}
+	rv := reflect.ValueOf(input)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+	return structToMap("", rv), true
+}
+
+// parseVariant dispatches input to the schema indexed by the discriminator
+// value. A discriminator of a defined string type, as Go structs declare it,
+// matches the string literal of its value.
+func (z *ZodDiscriminatedUnion[T, R]) parseVariant(input, dv any, pctx *core.ParseContext) (any, error) {
+	target, ok := z.internals.DiscMap[dv]
+	if !ok {
+		if rv := reflect.ValueOf(dv); rv.Kind() == reflect.String {
+			target, ok = z.internals.DiscMap[rv.String()]
+		}
+	}
+	if ok {
+		return target.ParseAny(input, pctx)
 	}
 	values := slices.Collect(maps.Keys(z.internals.DiscMap))
 	slices.SortFunc(values, compareDiscriminatorValues)
-	return nil, issues.CreateInvalidDiscriminatorError(z.internals.Discriminator, values, m, pctx)
+	return nil, issues.CreateInvalidDiscriminatorError(z.internals.Discriminator, values, input, pctx)
 }
 
 func compareDiscriminatorValues(a, b any) int {
@@ -199,6 +229,18 @@ func (z *ZodDiscriminatedUnion[T, R]) ParseAny(input any, ctx ...*core.ParseCont
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodDiscriminatedUnion[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodDiscriminatedUnion[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil values with pointer constraint.
 func (z *ZodDiscriminatedUnion[T, R]) Optional() *ZodDiscriminatedUnion[T, *T] {
 	in := z.internals.Clone()
@@ -249,6 +291,22 @@ func (z *ZodDiscriminatedUnion[T, R]) PrefaultFunc(fn func() T) *ZodDiscriminate
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodDiscriminatedUnion[T, R]) Catch(v T) *ZodDiscriminatedUnion[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodDiscriminatedUnion[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodDiscriminatedUnion[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodDiscriminatedUnion[T, R]) Meta(meta core.GlobalMeta) *ZodDiscriminatedUnion[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -299,14 +357,31 @@ func (z *ZodDiscriminatedUnion[T, R]) Pipe(target core.ZodType[any]) *core.ZodPi
 
 // Refine applies a custom validation function matching the schema's output type R.
 func (z *ZodDiscriminatedUnion[T, R]) Refine(fn func(R) bool, params ...any) *ZodDiscriminatedUnion[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodDiscriminatedUnion[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodDiscriminatedUnion[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodDiscriminatedUnion[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodDiscriminatedUnion[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodDiscriminatedUnion[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodDiscriminatedUnion[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		cv, ok := convertToDiscriminatedUnionConstraintValue[T, R](v)
 		if !ok {
 			return false
 		}
-		return fn(cv)
+		return fn(ctx, cv)
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny applies a custom validation function that receives the raw value.
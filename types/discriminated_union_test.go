@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -30,6 +31,10 @@ func (s *countingDiscriminatorSchema) ParseAny(input any, _ ...*core.ParseContex
 	return input, nil
 }
 
+func (s *countingDiscriminatorSchema) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return s.ParseAny(input, parseCtx...)
+}
+
 func (s *countingDiscriminatorSchema) Internals() *core.ZodTypeInternals { return &s.internals }
 func (s *countingDiscriminatorSchema) IsOptional() bool                  { return false }
 func (s *countingDiscriminatorSchema) IsNilable() bool                   { return false }
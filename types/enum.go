@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"slices"
@@ -102,6 +103,18 @@ func (z *ZodEnum[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, err
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodEnum[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodEnum[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a new schema that accepts nil, with *T constraint.
 func (z *ZodEnum[T, R]) Optional() *ZodEnum[T, *T] {
 	in := z.internals.Clone()
@@ -182,6 +195,24 @@ func (z *ZodEnum[T, R]) PrefaultFunc(fn func() T) *ZodEnum[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodEnum[T, R]) Catch(v T) *ZodEnum[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodEnum[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodEnum[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodEnum[T, R]) Meta(meta core.GlobalMeta) *ZodEnum[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -258,15 +289,32 @@ func (z *ZodEnum[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 // Refine applies type-safe validation matching the schema's output type R. The
 // callback receives nil for *T schemas when the value is nil (Zod v4 semantics).
 func (z *ZodEnum[T, R]) Refine(fn func(R) bool, params ...any) *ZodEnum[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodEnum[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodEnum[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodEnum[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodEnum[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodEnum[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodEnum[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero R
 		switch any(zero).(type) {
 		case *T:
 			if v == nil {
-				return fn(any((*T)(nil)).(R))
+				return fn(ctx, any((*T)(nil)).(R))
 			}
 			if val, ok := v.(T); ok {
-				return fn(any(&val).(R))
+				return fn(ctx, any(&val).(R))
 			}
 			return false
 		default:
@@ -274,7 +322,7 @@ func (z *ZodEnum[T, R]) Refine(fn func(R) bool, params ...any) *ZodEnum[T, R] {
 				return false
 			}
 			if val, ok := v.(T); ok {
-				return fn(any(val).(R))
+				return fn(ctx, any(val).(R))
 			}
 			return false
 		}
@@ -286,7 +334,7 @@ func (z *ZodEnum[T, R]) Refine(fn func(R) bool, params ...any) *ZodEnum[T, R] {
 		msg = sp.Error
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
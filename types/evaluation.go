@@ -0,0 +1,207 @@
+package types
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// evaluation records which object keys and array items of a value a schema
+// evaluated, in the sense of JSON Schema unevaluatedProperties and
+// unevaluatedItems.
+type evaluation struct {
+	keys     map[string]bool
+	allKeys  bool
+	allItems bool
+}
+
+func (e *evaluation) addKey(key string) {
+	if e.keys == nil {
+		e.keys = make(map[string]bool)
+	}
+	e.keys[key] = true
+}
+
+func (e *evaluation) merge(other evaluation) {
+	e.allKeys = e.allKeys || other.allKeys
+	e.allItems = e.allItems || other.allItems
+	for key := range other.keys {
+		e.addKey(key)
+	}
+}
+
+func (e evaluation) hasKey(key string) bool {
+	return e.allKeys || e.keys[key]
+}
+
+// evaluator is implemented by schemas that evaluate object keys or array
+// items. Schemas that do not implement it evaluate nothing.
+type evaluator interface {
+	evaluate(value any, ctx *core.ParseContext) evaluation
+}
+
+// evaluatedBy reports what schema evaluated of value. Callers only ask for
+// schemas that accepted value.
+func evaluatedBy(schema core.ZodSchema, value any, ctx *core.ParseContext) evaluation {
+	if e, ok := schema.(evaluator); ok {
+		return e.evaluate(value, ctx)
+	}
+	return evaluation{}
+}
+
+// evaluatedByAccepting merges the evaluations of the schemas that accept
+// value, like the evaluations of the passing subschemas of anyOf.
+func evaluatedByAccepting(schemas []core.ZodSchema, value any, ctx *core.ParseContext) evaluation {
+	var e evaluation
+	for _, schema := range schemas {
+		if schema == nil {
+			continue
+		}
+		if _, err := schema.ParseAny(value, ctx); err == nil {
+			e.merge(evaluatedBy(schema, value, ctx))
+		}
+	}
+	return e
+}
+
+// objectKeys returns the keys of a map value with string keys.
+func objectKeys(value any) ([]string, bool) {
+	rv := derefValue(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	keys := make([]string, 0, rv.Len())
+	for _, key := range rv.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	return keys, true
+}
+
+func derefValue(value any) reflect.Value {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// unevaluatedSchemas holds the schemas that close a composite over the
+// object keys and array items none of its branches evaluated.
+type unevaluatedSchemas struct {
+	properties core.ZodSchema
+	items      core.ZodSchema
+}
+
+func (u unevaluatedSchemas) isSet() bool {
+	return u.properties != nil || u.items != nil
+}
+
+// evaluation returns branches extended by what the unevaluated schemas
+// evaluate themselves, which is everything of their family.
+func (u unevaluatedSchemas) evaluation(branches evaluation) evaluation {
+	branches.allKeys = branches.allKeys || u.properties != nil
+	branches.allItems = branches.allItems || u.items != nil
+	return branches
+}
+
+// apply validates the keys or items of input that evaluated does not cover
+// against the unevaluated schemas and adds their parsed values to output.
+// A Never schema reports the keys as unrecognized, like Strict.
+func (u unevaluatedSchemas) apply(evaluated evaluation, input, output any, ctx *core.ParseContext) (any, error) {
+	rv := derefValue(input)
+	switch {
+	case u.properties != nil && !evaluated.allKeys && rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		return u.applyProperties(evaluated, rv, output, ctx)
+	case u.items != nil && !evaluated.allItems && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array):
+		return u.applyItems(rv, output, ctx)
+	}
+	return output, nil
+}
+
+func (u unevaluatedSchemas) applyProperties(evaluated evaluation, rv reflect.Value, output any, ctx *core.ParseContext) (any, error) {
+	result, _ := output.(map[string]any)
+	var (
+		errs    []core.ZodRawIssue
+		unknown []string
+		cloned  bool
+	)
+	closed := u.properties.Internals().Type == core.ZodTypeNever
+	keys, _ := objectKeys(rv.Interface())
+	slices.Sort(keys)
+	for _, key := range keys {
+		if evaluated.hasKey(key) {
+			continue
+		}
+		if closed {
+			unknown = append(unknown, key)
+			continue
+		}
+		val := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key())).Interface()
+		parsed, err := u.properties.ParseAny(val, ctx)
+		if err != nil {
+			collectFieldErrors(err, key, &errs, val)
+			continue
+		}
+		if result != nil {
+			if !cloned {
+				result = maps.Clone(result)
+				cloned = true
+			}
+			result[key] = parsed
+		}
+	}
+	if len(unknown) > 0 {
+		errs = append(errs, issues.CreateIssue(core.UnrecognizedKeys, "", map[string]any{"keys": unknown}, rv.Interface()))
+	}
+	if len(errs) > 0 {
+		return nil, issues.CreateArrayValidationIssues(errs)
+	}
+	if result != nil {
+		return result, nil
+	}
+	return output, nil
+}
+
+func (u unevaluatedSchemas) applyItems(rv reflect.Value, output any, ctx *core.ParseContext) (any, error) {
+	result, _ := output.([]any)
+	var errs []core.ZodRawIssue
+	cloned := false
+	for i := range rv.Len() {
+		element := rv.Index(i).Interface()
+		parsed, err := u.items.ParseAny(element, ctx)
+		if err != nil {
+			errs = append(errs, issues.CreateInvalidElementIssue(i, "unevaluated", element, rawIssuesOf(err, element)))
+			continue
+		}
+		if i < len(result) {
+			if !cloned {
+				result = append([]any(nil), result...)
+				cloned = true
+			}
+			result[i] = parsed
+		}
+	}
+	if len(errs) > 0 {
+		return nil, issues.CreateArrayValidationIssues(errs)
+	}
+	if result != nil {
+		return result, nil
+	}
+	return output, nil
+}
+
+// rawIssuesOf converts the issues of a parse error back to raw issues.
+func rawIssuesOf(err error, input any) []core.ZodRawIssue {
+	var zodErr *issues.ZodError
+	if issues.IsZodError(err, &zodErr) {
+		raws := make([]core.ZodRawIssue, len(zodErr.Issues))
+		for i, issue := range zodErr.Issues {
+			raws[i] = issues.ConvertZodIssueToRaw(issue)
+		}
+		return raws
+	}
+	return []core.ZodRawIssue{issues.CreateIssue(core.Custom, err.Error(), nil, input)}
+}
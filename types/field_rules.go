@@ -0,0 +1,251 @@
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// FieldRelation is how a cross-field rule compares a field with another.
+type FieldRelation string
+
+// FieldRelation values.
+const (
+	FieldEqual        FieldRelation = "eq"
+	FieldNotEqual     FieldRelation = "ne"
+	FieldGreater      FieldRelation = "gt"
+	FieldGreaterEqual FieldRelation = "gte"
+	FieldLess         FieldRelation = "lt"
+	FieldLessEqual    FieldRelation = "lte"
+)
+
+// FieldComparison requires the value of Field to stand in Relation to the
+// value of Other, as in "end after start". The issue is reported at Field.
+type FieldComparison struct {
+	Field    string
+	Relation FieldRelation
+	Other    string
+}
+
+// FieldCondition holds when the value of Field equals Value.
+type FieldCondition struct {
+	Field string
+	Value any
+}
+
+// fieldRelations lists the relations CompareFields accepts.
+var fieldRelations = []FieldRelation{
+	FieldEqual, FieldNotEqual, FieldGreater, FieldGreaterEqual, FieldLess, FieldLessEqual,
+}
+
+// withFieldComparisons returns current with comparisons appended.
+func withFieldComparisons(current []FieldComparison, comparisons []FieldComparison) []FieldComparison {
+	for _, comparison := range comparisons {
+		if !slices.Contains(fieldRelations, comparison.Relation) {
+			panic(fmt.Sprintf("CompareFields: unknown relation %q", comparison.Relation))
+		}
+	}
+	return append(slices.Clone(current), comparisons...)
+}
+
+// withRequiredIf returns a copy of current where field is required under
+// conditions.
+func withRequiredIf(current map[string][]FieldCondition, field string, conditions []FieldCondition) map[string][]FieldCondition {
+	if len(conditions) == 0 {
+		panic("RequiredIf: at least one condition is required")
+	}
+	merged := cloneRequiredIf(current)
+	if merged == nil {
+		merged = make(map[string][]FieldCondition, 1)
+	}
+	merged[field] = slices.Clone(conditions)
+	return merged
+}
+
+// cloneRequiredIf deep-copies a RequiredIf map.
+func cloneRequiredIf(conditions map[string][]FieldCondition) map[string][]FieldCondition {
+	if conditions == nil {
+		return nil
+	}
+	cloned := make(map[string][]FieldCondition, len(conditions))
+	for field, list := range conditions {
+		cloned[field] = slices.Clone(list)
+	}
+	return cloned
+}
+
+// fieldComparisonIssues reports every comparison whose field is set and does
+// not stand in its relation to the other field. lookup returns a field's
+// value and whether it is set.
+func fieldComparisonIssues(comparisons []FieldComparison, lookup func(string) (any, bool)) []core.ZodRawIssue {
+	var raws []core.ZodRawIssue
+	for _, comparison := range comparisons {
+		value, set := lookup(comparison.Field)
+		if !set {
+			continue
+		}
+		other, _ := lookup(comparison.Other)
+		if !fieldRelationHolds(value, comparison.Relation, other) {
+			raws = append(raws, fieldComparisonIssue(comparison, value))
+		}
+	}
+	return raws
+}
+
+// fieldComparisonIssue reports comparison as failing at its field.
+func fieldComparisonIssue(comparison FieldComparison, value any) core.ZodRawIssue {
+	raw := issues.CreateInvalidFieldComparisonIssue(comparison.Field, string(comparison.Relation), comparison.Other, value)
+	raw.Path = []any{comparison.Field}
+	return raw
+}
+
+// requiredIfIssues reports a missing_required issue at every absent field
+// whose conditions all hold, in key order.
+func requiredIfIssues(conditions map[string][]FieldCondition, lookup func(string) (any, bool)) []core.ZodRawIssue {
+	var raws []core.ZodRawIssue
+	for _, field := range slices.Sorted(maps.Keys(conditions)) {
+		if _, set := lookup(field); set {
+			continue
+		}
+		holds := true
+		for _, condition := range conditions[field] {
+			value, _ := lookup(condition.Field)
+			if !fieldRelationHolds(value, FieldEqual, condition.Value) {
+				holds = false
+				break
+			}
+		}
+		if holds {
+			raws = append(raws, missingDependentIssue(conditions[field][0].Field, field))
+		}
+	}
+	return raws
+}
+
+// fieldRelationHolds compares two field values. Pointers and Unwrappers
+// compare by the value they hold; numbers compare across Go types, strings
+// lexically and times chronologically. Values that cannot be ordered satisfy
+// only FieldNotEqual when they differ.
+func fieldRelationHolds(value any, relation FieldRelation, other any) bool {
+	a, b := comparableFieldValue(value), comparableFieldValue(other)
+	order, ordered := compareFieldValues(a, b)
+	switch relation {
+	case FieldEqual:
+		return ordered && order == 0 || !ordered && reflect.DeepEqual(a, b)
+	case FieldNotEqual:
+		return ordered && order != 0 || !ordered && !reflect.DeepEqual(a, b)
+	case FieldGreater:
+		return ordered && order > 0
+	case FieldGreaterEqual:
+		return ordered && order >= 0
+	case FieldLess:
+		return ordered && order < 0
+	case FieldLessEqual:
+		return ordered && order <= 0
+	default:
+		return false
+	}
+}
+
+// comparableFieldValue reduces a field value to int64, uint64, float64,
+// string, bool or time.Time where its kind allows, dropping named types.
+func comparableFieldValue(value any) any {
+	if unwrapper, ok := value.(core.Unwrapper); ok {
+		inner, set := unwrapper.Unwrap()
+		if !set {
+			return nil
+		}
+		value = inner
+	}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		return v.Interface()
+	}
+}
+
+// compareFieldValues orders two comparable field values, reporting false when
+// they have no common order.
+func compareFieldValues(a, b any) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return cmp.Compare(a, b), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok && a == b {
+			return 0, true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	case int64, uint64, float64:
+		return compareNumbers(a, b)
+	}
+	return 0, false
+}
+
+func compareNumbers(a, b any) (int, bool) {
+	switch a := a.(type) {
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return cmp.Compare(a, b), true
+		case uint64:
+			if a < 0 {
+				return -1, true
+			}
+			return cmp.Compare(uint64(a), b), true
+		case float64:
+			return cmp.Compare(float64(a), b), true
+		}
+	case uint64:
+		switch b := b.(type) {
+		case uint64:
+			return cmp.Compare(a, b), true
+		case int64:
+			if b < 0 {
+				return 1, true
+			}
+			return cmp.Compare(a, uint64(b)), true
+		case float64:
+			return cmp.Compare(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case float64:
+			return cmp.Compare(a, b), true
+		case int64:
+			return cmp.Compare(a, float64(b)), true
+		case uint64:
+			return cmp.Compare(a, float64(b)), true
+		}
+	}
+	return 0, false
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"mime/multipart"
 	"os"
 	"reflect"
@@ -112,6 +113,18 @@ func (z *ZodFile[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, err
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodFile[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodFile[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // MODIFIER METHODS
 // =============================================================================
@@ -177,6 +190,24 @@ func (z *ZodFile[T, R]) PrefaultFunc(fn func() T) *ZodFile[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodFile[T, R]) Catch(v T) *ZodFile[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodFile[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodFile[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodFile[T, R]) Meta(meta core.GlobalMeta) *ZodFile[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -219,12 +250,29 @@ func (z *ZodFile[T, R]) Mime(mimeTypes []string, params ...any) *ZodFile[T, R] {
 
 // Refine applies a custom validation function for output type R.
 func (z *ZodFile[T, R]) Refine(fn func(R) bool, params ...any) *ZodFile[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodFile[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodFile[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodFile[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodFile[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodFile[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodFile[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if v == nil {
 			return true
 		}
 		if val, ok := convertToFileType[T, R](v); ok {
-			return fn(val)
+			return fn(ctx, val)
 		}
 		return false
 	}
@@ -235,7 +283,7 @@ func (z *ZodFile[T, R]) Refine(fn func(R) bool, params ...any) *ZodFile[T, R] {
 		msg = sp.Error
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
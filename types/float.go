@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"math"
 	"reflect"
 
@@ -132,6 +133,18 @@ func (z *ZodFloatTyped[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (an
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodFloatTyped[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodFloatTyped[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // MODIFIER METHODS
 // =============================================================================
@@ -211,6 +224,24 @@ func (z *ZodFloatTyped[T, R]) PrefaultFunc(fn func() float64) *ZodFloatTyped[T,
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodFloatTyped[T, R]) Catch(v float64) *ZodFloatTyped[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(convertDefaultValue[R](v))
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodFloatTyped[T, R]) CatchFunc(fn func(core.CatchContext) float64) *ZodFloatTyped[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return convertDefaultValue[R](fn(ctx))
+	})
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodFloatTyped[T, R]) Meta(meta core.GlobalMeta) *ZodFloatTyped[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -390,7 +421,24 @@ func (z *ZodFloatTyped[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, an
 //	    return f > 0 && f < 100
 //	}, "value must be between 0 and 100")
 func (z *ZodFloatTyped[T, R]) Refine(fn func(T) bool, params ...any) *ZodFloatTyped[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodFloatTyped[T, R]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodFloatTyped[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodFloatTyped[T, R]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodFloatTyped[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodFloatTyped[T, R]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodFloatTyped[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if v == nil {
 			return z.IsNilable()
 		}
@@ -400,7 +448,7 @@ func (z *ZodFloatTyped[T, R]) Refine(fn func(T) bool, params ...any) *ZodFloatTy
 			return false
 		}
 
-		return fn(val)
+		return fn(ctx, val)
 	}
 
 	sp := utils.NormalizeParams(params...)
@@ -410,7 +458,7 @@ func (z *ZodFloatTyped[T, R]) Refine(fn func(T) bool, params ...any) *ZodFloatTy
 		msg = sp.Error
 	}
 
-	return z.withCheck(checks.NewCustom[any](wrapper, msg))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel))
 }
 
 // convertToFloatType converts matching float values to the target type T.
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/kaptinlin/gozod/core"
@@ -125,6 +126,18 @@ func (z *ZodFunction[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodFunction[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodFunction[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse validates input with compile-time type safety.
 func (z *ZodFunction[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
 	result, err := engine.ParseComplexStrict[any](
@@ -206,6 +219,22 @@ func (z *ZodFunction[T]) PrefaultFunc(fn func() any) *ZodFunction[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodFunction[T]) Catch(v any) *ZodFunction[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodFunction[T]) CatchFunc(fn func(core.CatchContext) any) *ZodFunction[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(fn)
+	return z.withInternals(in)
+}
+
 // Meta stores metadata for this function schema.
 func (z *ZodFunction[T]) Meta(meta core.GlobalMeta) *ZodFunction[T] {
 	clone := z.withInternals(z.internals.Clone())
@@ -256,13 +285,30 @@ func (z *ZodFunction[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine adds a custom validation function.
 func (z *ZodFunction[T]) Refine(fn func(T) bool, params ...any) *ZodFunction[T] {
-	wrap := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodFunction[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodFunction[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodFunction[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodFunction[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodFunction[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodFunction[T] {
+	wrap := func(ctx context.Context, v any) bool {
 		if val, ok := v.(T); ok {
-			return fn(val)
+			return fn(ctx, val)
 		}
 		return false
 	}
-	return z.withCheck(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a custom validation function that accepts any type.
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+// StructFieldIssues collects the field issues of struct parsing code
+// generated by gozodgen, in the form a ZodStruct reports them. The zero
+// value resolves nested struct fields through the "json" tag.
+type StructFieldIssues struct {
+	// FieldNameTag is the struct tag used for field names when a parsed
+	// value must be converted into the field type.
+	FieldNameTag string
+
+	raw      []core.ZodRawIssue
+	canceled bool
+}
+
+// Err returns the collected issues as a *ZodError, or nil when every field
+// passed.
+func (s *StructFieldIssues) Err() error {
+	if len(s.raw) == 0 {
+		return nil
+	}
+	return issues.CreateArrayValidationIssues(s.raw)
+}
+
+// add records the failure of the field under key.
+func (s *StructFieldIssues) add(key string, value any, err error) {
+	if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
+		for _, issue := range zodErr.Issues {
+			s.raw = append(s.raw, issues.ConvertZodIssueToRawWithPrependedPath(issue, []any{key}))
+		}
+		return
+	}
+	raw := issues.CreateIssue(core.Custom, err.Error(), nil, value)
+	raw.Path = []any{key}
+	s.raw = append(s.raw, raw)
+}
+
+// stopped reports whether parsing must stop because the caller context in
+// ctx is done, recording the single canceled issue a ZodStruct reports.
+func (s *StructFieldIssues) stopped(ctx []*core.ParseContext, value any) bool {
+	if s.canceled {
+		return true
+	}
+	if len(ctx) == 0 {
+		return false
+	}
+	issue, canceled := canceledIssue(ctx[0], value)
+	if canceled {
+		s.raw = append(s.raw, issue)
+		s.canceled = true
+	}
+	return canceled
+}
+
+// RequireWith records the missing_required issue a ZodStruct with
+// DependentRequired reports when the field under dependency is set and the
+// field under dependent is not.
+func (s *StructFieldIssues) RequireWith(dependency string, dependencyValue any, dependent string, dependentValue any) {
+	if isStructFieldSet(dependencyValue) && !isStructFieldSet(dependentValue) {
+		s.raw = append(s.raw, missingDependentIssue(dependency, dependent))
+	}
+}
+
+// CompareField records the issue a ZodStruct with CompareFields reports when
+// the field under field is set and does not stand in relation to the field
+// under other.
+func (s *StructFieldIssues) CompareField(field string, value any, relation FieldRelation, other string, otherValue any) {
+	comparison := FieldComparison{Field: field, Relation: relation, Other: other}
+	s.raw = append(s.raw, fieldComparisonIssues([]FieldComparison{comparison}, func(name string) (any, bool) {
+		if name == field {
+			return value, isStructFieldSet(value)
+		}
+		return otherValue, isStructFieldSet(otherValue)
+	})...)
+}
+
+// RequireIf records the missing_required issue a ZodStruct with RequiredIf
+// reports when the field under dependent is not set and every condition
+// holds. values holds the current value of each condition's field, in order.
+func (s *StructFieldIssues) RequireIf(dependent string, dependentValue any, conditions []FieldCondition, values ...any) {
+	if isStructFieldSet(dependentValue) || len(conditions) == 0 || len(values) != len(conditions) {
+		return
+	}
+	for i, condition := range conditions {
+		if !fieldRelationHolds(values[i], FieldEqual, condition.Value) {
+			return
+		}
+	}
+	s.raw = append(s.raw, missingDependentIssue(conditions[0].Field, dependent))
+}
+
+// ParseStructField parses the struct field value under key with schema and
+// returns the result as the field type, exactly as a ZodStruct would set it.
+// A result of type F or *F is assigned without reflection; any other result
+// takes the conversion ZodStruct applies. On failure the issues are recorded
+// in errs and value is returned unchanged. ctx is passed to schema.
+func ParseStructField[F any](schema core.ZodSchema, key string, value F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	parsed, ok := parseStructField(schema, key, value, errs, ctx)
+	if !ok {
+		return value
+	}
+	switch v := parsed.(type) {
+	case F:
+		return v
+	case *F:
+		if v != nil {
+			return *v
+		}
+	}
+	return assignStructField(key, value, parsed, errs)
+}
+
+// ParseStructFieldAs is ParseStructField for a field whose declared type F
+// is defined over the schema output type P, such as type UserID string.
+// convert turns a P result into F without reflection.
+func ParseStructFieldAs[F, P any](schema core.ZodSchema, key string, value F, convert func(P) F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	parsed, ok := parseStructField(schema, key, value, errs, ctx)
+	if !ok {
+		return value
+	}
+	switch v := parsed.(type) {
+	case F:
+		return v
+	case P:
+		return convert(v)
+	case *P:
+		if v != nil {
+			return convert(*v)
+		}
+	}
+	return assignStructField(key, value, parsed, errs)
+}
+
+// ParseNestedStructField parses a struct-typed field under key with the
+// generated parse function of its type, passing ctx on.
+func ParseNestedStructField[F any](parse func(F, ...*core.ParseContext) (F, error), key string, value F, errs *StructFieldIssues, ctx ...*core.ParseContext) F {
+	if errs.stopped(ctx, value) {
+		return value
+	}
+	parsed, err := parse(value, ctx...)
+	if err != nil {
+		errs.add(key, value, err)
+		return value
+	}
+	return parsed
+}
+
+// parseStructField runs schema on value the way ZodStruct parses a field,
+// including the Unwrapper contract. It reports false after recording a
+// failure or once the caller context is done.
+func parseStructField(schema core.ZodSchema, key string, value any, errs *StructFieldIssues, ctx []*core.ParseContext) (any, bool) {
+	if errs.stopped(ctx, value) {
+		return nil, false
+	}
+	input := value
+	unwrapper, wrapped := value.(core.Unwrapper)
+	if wrapped {
+		inner, set := unwrapper.Unwrap()
+		if !set {
+			return value, true
+		}
+		input = inner
+	}
+
+	parsed, err := schema.ParseAny(input, ctx...)
+	if err != nil {
+		errs.add(key, value, err)
+		return nil, false
+	}
+	if wrapped {
+		return value, true
+	}
+	return parsed, true
+}
+
+// assignStructField converts parsed into the field type with the reflective
+// rules ZodStruct uses for results that are not already of that type.
+func assignStructField[F any](key string, value F, parsed any, errs *StructFieldIssues) F {
+	var out F
+	z := &ZodStruct[struct{}, struct{}]{internals: &ZodStructInternals{FieldNameTag: errs.FieldNameTag}}
+	if err := z.setReflectFieldValue(reflect.ValueOf(&out).Elem(), parsed); err != nil {
+		raw := issues.CreateIssue(core.Custom, fmt.Sprintf("Failed to set field %s: %v", key, err), nil, parsed)
+		raw.Path = []any{key}
+		errs.raw = append(errs.raw, raw)
+		return value
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+type generatedLabel string
+
+func TestParseStructField(t *testing.T) {
+	var errs StructFieldIssues
+
+	assert.Equal(t, "Ada", ParseStructField(String().Min(2), "name", "Ada", &errs))
+	assert.Equal(t, generatedLabel("ok"), ParseStructFieldAs(String().Min(2), "label", generatedLabel("ok"),
+		func(v string) generatedLabel { return generatedLabel(v) }, &errs))
+	require.NoError(t, errs.Err())
+
+	assert.Equal(t, "A", ParseStructField(String().Min(2), "name", "A", &errs))
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(errs.Err(), &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"name"}, zodErr.Issues[0].Path)
+}
+
+func TestParseNestedStructField(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name"`
+	}
+	schema := Struct[Inner](core.StructSchema{"name": String().Min(2)})
+	parse := func(v Inner, ctx ...*core.ParseContext) (Inner, error) { return schema.Parse(v, ctx...) }
+
+	var errs StructFieldIssues
+	ParseNestedStructField(parse, "inner", Inner{Name: "A"}, &errs)
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(errs.Err(), &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"inner", "name"}, zodErr.Issues[0].Path)
+}
+
+func TestParseStructFieldPassesParseContext(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name"`
+	}
+	schema := Struct[Inner](core.StructSchema{"name": String()})
+	parse := func(v Inner, ctx ...*core.ParseContext) (Inner, error) { return schema.Parse(v, ctx...) }
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	parseCtx := core.WithContext(canceled)
+
+	var errs StructFieldIssues
+	ParseStructField(String(), "name", "Ada", &errs, parseCtx)
+	ParseStructFieldAs(String(), "label", generatedLabel("ok"),
+		func(v string) generatedLabel { return generatedLabel(v) }, &errs, parseCtx)
+	ParseNestedStructField(parse, "inner", Inner{Name: "Ada"}, &errs, parseCtx)
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(errs.Err(), &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+	assert.Empty(t, zodErr.Issues[0].Path)
+}
+
+func TestStructFieldIssuesRequireWith(t *testing.T) {
+	var errs StructFieldIssues
+
+	errs.RequireWith("billing_address", "", "billing_name", "")
+	errs.RequireWith("billing_address", "Main St", "billing_name", "Ada")
+	require.NoError(t, errs.Err())
+
+	errs.RequireWith("billing_address", "Main St", "billing_name", "")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(errs.Err(), &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"billing_name"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "billing_address", zodErr.Issues[0].Params["dependency"])
+}
+
+func TestStructFieldIssuesCrossFieldRules(t *testing.T) {
+	var errs StructFieldIssues
+
+	errs.CompareField("end", 0, FieldGreater, "start", 5)
+	errs.CompareField("end", 6, FieldGreater, "start", 5)
+	errs.RequireIf("reason", "", []FieldCondition{{Field: "status", Value: "cancelled"}}, "confirmed")
+	errs.RequireIf("reason", "weather", []FieldCondition{{Field: "status", Value: "cancelled"}}, "cancelled")
+	require.NoError(t, errs.Err())
+
+	errs.CompareField("end", 4, FieldGreater, "start", 5)
+	errs.RequireIf("reason", "", []FieldCondition{{Field: "status", Value: "cancelled"}}, "cancelled")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(errs.Err(), &zodErr))
+	require.Len(t, zodErr.Issues, 2)
+	assert.Equal(t, core.InvalidFieldComparison, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"end"}, zodErr.Issues[0].Path)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[1].Code)
+	assert.Equal(t, []any{"reason"}, zodErr.Issues[1].Path)
+	assert.Equal(t, "status", zodErr.Issues[1].Params["dependency"])
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"math"
 	"reflect"
 
@@ -205,6 +206,18 @@ func (z *ZodIntegerTyped[T, R]) ParseAny(
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodIntegerTyped[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodIntegerTyped[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts T or nil, with constraint type *T.
 func (z *ZodIntegerTyped[T, R]) Optional() *ZodIntegerTyped[T, *T] {
 	in := z.internals.Clone()
@@ -281,6 +294,24 @@ func (z *ZodIntegerTyped[T, R]) PrefaultFunc(fn func() R) *ZodIntegerTyped[T, R]
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodIntegerTyped[T, R]) Catch(v int64) *ZodIntegerTyped[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(convertIntDefaultValue[T](v))
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodIntegerTyped[T, R]) CatchFunc(fn func(core.CatchContext) int64) *ZodIntegerTyped[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodIntegerTyped[T, R]) Meta(
 	meta core.GlobalMeta,
@@ -437,7 +468,34 @@ func (z *ZodIntegerTyped[T, R]) Refine(
 	fn func(T) bool,
 	params ...any,
 ) *ZodIntegerTyped[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodIntegerTyped[T, R]) RefineContext(
+	fn func(context.Context, T) bool,
+	params ...any,
+) *ZodIntegerTyped[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodIntegerTyped[T, R]) RefineParallel(
+	fn func(context.Context, T) bool,
+	params ...any,
+) *ZodIntegerTyped[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodIntegerTyped[T, R]) refine(
+	fn func(context.Context, T) bool,
+	parallel bool,
+	params ...any,
+) *ZodIntegerTyped[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if v == nil && z.IsNilable() {
 			return true
 		}
@@ -445,7 +503,7 @@ func (z *ZodIntegerTyped[T, R]) Refine(
 		if !ok {
 			return false
 		}
-		return fn(converted)
+		return fn(ctx, converted)
 	}
 
 	sp := utils.NormalizeParams(params...)
@@ -453,7 +511,7 @@ func (z *ZodIntegerTyped[T, R]) Refine(
 	if sp.Error != nil {
 		msg = sp.Error
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, msg))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel))
 }
 
 // And creates an intersection with another schema.
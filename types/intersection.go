@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -26,6 +27,8 @@ type ZodIntersectionInternals struct {
 	Def   *ZodIntersectionDef
 	Left  core.ZodSchema
 	Right core.ZodSchema
+	// unevaluated validates what neither side evaluated, when set.
+	unevaluated unevaluatedSchemas
 }
 
 // ZodIntersection is an intersection validation schema with dual generic parameters.
@@ -114,12 +117,36 @@ func (i *ZodIntersection[T, R]) validateValue(value any, chks []core.ZodCheck, c
 		return nil, issues.NewZodError([]core.ZodIssue{issues.FinalizeIssue(iss, ctx, core.Config())})
 	}
 
+	merged, err = i.closeUnevaluated(value, merged, ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(chks) > 0 {
 		return engine.ApplyChecks[any](merged, chks, ctx)
 	}
 	return merged, nil
 }
 
+// closeUnevaluated applies the unevaluated schemas to what neither side of
+// the intersection evaluated of value.
+func (i *ZodIntersection[T, R]) closeUnevaluated(value, merged any, ctx *core.ParseContext) (any, error) {
+	if !i.internals.unevaluated.isSet() {
+		return merged, nil
+	}
+	evaluated := evaluatedBy(i.internals.Left, value, ctx)
+	evaluated.merge(evaluatedBy(i.internals.Right, value, ctx))
+	return i.internals.unevaluated.apply(evaluated, value, merged, ctx)
+}
+
+// evaluate reports what both sides and the unevaluated schemas evaluate of
+// value.
+func (i *ZodIntersection[T, R]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	evaluated := evaluatedBy(i.internals.Left, value, ctx)
+	evaluated.merge(evaluatedBy(i.internals.Right, value, ctx))
+	return i.internals.unevaluated.evaluation(evaluated)
+}
+
 // mergeUnrecognizedKeysIssues filters unrecognized_keys issues to only include
 // keys that both sides reported as unrecognized (Zod v4 behavior).
 func mergeUnrecognizedKeysIssues(leftIssues, rightIssues []core.ZodIssue) []core.ZodIssue {
@@ -190,6 +217,18 @@ func (i *ZodIntersection[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (
 	return i.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (i *ZodIntersection[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return i.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (i *ZodIntersection[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return i.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse validates input with compile-time type safety.
 func (i *ZodIntersection[T, R]) StrictParse(input T, ctx ...*core.ParseContext) (R, error) {
 	pc := resolveCtx(ctx)
@@ -214,6 +253,12 @@ func (i *ZodIntersection[T, R]) StrictParse(input T, ctx ...*core.ParseContext)
 		return zero, issues.NewZodError([]core.ZodIssue{issues.FinalizeIssue(iss, pc, core.Config())})
 	}
 
+	merged, err = i.closeUnevaluated(converted, merged, pc)
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+
 	if len(i.internals.Checks) > 0 {
 		checked, err := engine.ApplyChecks[any](merged, i.internals.Checks, pc)
 		if err != nil {
@@ -269,6 +314,7 @@ func (i *ZodIntersection[T, R]) NonOptional() *ZodIntersection[T, T] {
 			Def:              i.internals.Def,
 			Left:             i.internals.Left,
 			Right:            i.internals.Right,
+			unevaluated:      i.internals.unevaluated,
 		},
 	}
 }
@@ -301,6 +347,22 @@ func (i *ZodIntersection[T, R]) PrefaultFunc(fn func() T) *ZodIntersection[T, R]
 	return i.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (i *ZodIntersection[T, R]) Catch(v T) *ZodIntersection[T, R] {
+	in := i.internals.Clone()
+	in.SetCatchValue(v)
+	return i.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (i *ZodIntersection[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodIntersection[T, R] {
+	in := i.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return i.withInternals(in)
+}
+
 // Meta stores metadata for this schema.
 func (i *ZodIntersection[T, R]) Meta(meta core.GlobalMeta) *ZodIntersection[T, R] {
 	clone := i.withInternals(i.internals.Clone())
@@ -323,6 +385,42 @@ func (i *ZodIntersection[T, R]) Right() core.ZodSchema {
 	return i.internals.Right
 }
 
+// Unevaluated validates the object keys and array items that neither side
+// evaluated against schema, like JSON Schema unevaluatedProperties and
+// unevaluatedItems together. Unevaluated(Never()) closes the intersection:
+// keys no side knows are rejected as unrecognized. A nil schema removes it.
+func (i *ZodIntersection[T, R]) Unevaluated(schema core.ZodSchema) *ZodIntersection[T, R] {
+	clone := i.withInternals(i.internals.Clone())
+	clone.internals.unevaluated = unevaluatedSchemas{properties: schema, items: schema}
+	return clone
+}
+
+// UnevaluatedProperties is like Unevaluated for object keys only, like JSON
+// Schema unevaluatedProperties.
+func (i *ZodIntersection[T, R]) UnevaluatedProperties(schema core.ZodSchema) *ZodIntersection[T, R] {
+	clone := i.withInternals(i.internals.Clone())
+	clone.internals.unevaluated.properties = schema
+	return clone
+}
+
+// UnevaluatedItems is like Unevaluated for array items only, like JSON Schema
+// unevaluatedItems.
+func (i *ZodIntersection[T, R]) UnevaluatedItems(schema core.ZodSchema) *ZodIntersection[T, R] {
+	clone := i.withInternals(i.internals.Clone())
+	clone.internals.unevaluated.items = schema
+	return clone
+}
+
+// UnevaluatedPropertiesSchema returns the schema for unevaluated object keys, or nil.
+func (i *ZodIntersection[T, R]) UnevaluatedPropertiesSchema() core.ZodSchema {
+	return i.internals.unevaluated.properties
+}
+
+// UnevaluatedItemsSchema returns the schema for unevaluated array items, or nil.
+func (i *ZodIntersection[T, R]) UnevaluatedItemsSchema() core.ZodSchema {
+	return i.internals.unevaluated.items
+}
+
 // Transform creates a type-safe transformation pipeline.
 func (i *ZodIntersection[T, R]) Transform(fn func(T, *core.RefinementContext) (any, error)) *core.ZodTransform[R, any] {
 	wrapper := func(input R, ctx *core.RefinementContext) (any, error) {
@@ -341,14 +439,31 @@ func (i *ZodIntersection[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R,
 
 // Refine applies type-safe validation with constraint type R.
 func (i *ZodIntersection[T, R]) Refine(fn func(R) bool, params ...any) *ZodIntersection[T, R] {
-	wrapper := func(v any) bool {
+	return i.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (i *ZodIntersection[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodIntersection[T, R] {
+	return i.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (i *ZodIntersection[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodIntersection[T, R] {
+	return i.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (i *ZodIntersection[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodIntersection[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if cv, ok := convertToIntersectionConstraintValue[T, R](v); ok {
-			return fn(cv)
+			return fn(ctx, cv)
 		}
 		return false
 	}
 	in := i.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	return i.withInternals(in)
 }
 
@@ -405,6 +520,7 @@ func (i *ZodIntersection[T, R]) newIntersectionInternals(in *core.ZodTypeInterna
 		Def:              i.internals.Def,
 		Left:             i.internals.Left,
 		Right:            i.internals.Right,
+		unevaluated:      i.internals.unevaluated,
 	}
 }
 
@@ -579,24 +695,22 @@ func mergeSlices(a, b any) (any, error) {
 	return a, nil
 }
 
-// structToMap converts a struct to map[string]any using exported fields and the
-// named field-name tag (default "json") for field names.
+// structToMap converts a struct to map[string]any using exported fields,
+// including those promoted from anonymous embeds, and the named field-name tag
+// (default "json") for field names.
 func structToMap(fieldNameTag string, v reflect.Value) map[string]any {
 	if v.Kind() == reflect.Pointer {
 		v = v.Elem()
 	}
-	fieldNameTag = fieldNameTagOrDefault(fieldNameTag)
-	m := make(map[string]any, v.NumField())
-	for field, value := range v.Fields() {
-		if !field.IsExported() || !value.CanInterface() {
+	fields := tagparser.VisibleFields(fieldNameTagOrDefault(fieldNameTag), v.Type())
+	m := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if field.Key.Skip {
 			continue
 		}
-
-		fieldKey := tagparser.FieldName(fieldNameTag, field)
-		if fieldKey.Skip {
-			continue
+		if value := structFieldValue(v, field.Index); value.CanInterface() {
+			m[field.Key.Name] = value.Interface()
 		}
-		m[fieldKey.Name] = value.Interface()
 	}
 	return m
 }
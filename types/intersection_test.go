@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
 	. "github.com/kaptinlin/gozod/types"
 )
 
@@ -420,3 +421,46 @@ func TestIntersection_NonOptional(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestIntersection_Unevaluated(t *testing.T) {
+	base := Object(core.ObjectSchema{"id": String()})
+	named := Object(core.ObjectSchema{"name": String()})
+	schema := Intersection(base, named).Unevaluated(Never())
+
+	result, err := schema.Parse(map[string]any{"id": "1", "name": "Ada"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"id": "1", "name": "Ada"}, result)
+
+	_, err = schema.Parse(map[string]any{"id": "1", "name": "Ada", "role": "admin"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.UnrecognizedKeys, zodErr.Issues[0].Code)
+	assert.Equal(t, []string{"role"}, zodErr.Issues[0].Keys)
+
+	t.Run("unevaluated keys are validated and kept", func(t *testing.T) {
+		schema := Intersection(base, named).UnevaluatedProperties(Int())
+		result, err := schema.Parse(map[string]any{"id": "1", "name": "Ada", "age": 36})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"id": "1", "name": "Ada", "age": 36}, result)
+
+		_, err = schema.Parse(map[string]any{"id": "1", "name": "Ada", "age": "36"})
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, []any{"age"}, zodErr.Issues[0].Path)
+	})
+
+	t.Run("nested composites contribute their evaluation", func(t *testing.T) {
+		inner := Intersection(base, Object(core.ObjectSchema{}).Passthrough())
+		schema := Intersection(inner, named).Unevaluated(Never())
+		_, err := schema.Parse(map[string]any{"id": "1", "name": "Ada", "role": "admin"})
+		require.NoError(t, err)
+	})
+
+	t.Run("accessors", func(t *testing.T) {
+		assert.NotNil(t, schema.UnevaluatedPropertiesSchema())
+		assert.NotNil(t, schema.UnevaluatedItemsSchema())
+		assert.Nil(t, Intersection(base, named).UnevaluatedPropertiesSchema())
+		assert.Nil(t, schema.UnevaluatedItems(nil).UnevaluatedItemsSchema())
+	})
+}
@@ -84,6 +84,16 @@ func (z *ZodIso[T]) PrefaultFunc(fn func() string) *ZodIso[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newIso[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodIso[T]) Catch(v string) *ZodIso[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newIso[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodIso[T]) CatchFunc(fn func(core.CatchContext) string) *ZodIso[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newIso[T])
+}
+
 // Min validates the ISO string is >= v using lexicographic comparison.
 func (z *ZodIso[T]) Min(v string, params ...any) *ZodIso[T] {
 	return z.cloneWithCheck(checks.StringGte(v, params...))
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -103,7 +104,14 @@ func (z *ZodLazy[T]) Parse(input any, ctx ...*core.ParseContext) (T, error) {
 	}
 
 	in := &z.internals.ZodTypeInternals
+	result, err := z.parse(input, in, pc)
+	if fallback, ok := engine.CatchFallback(input, in, err, pc); ok {
+		return lazyModifierValue[T](fallback), nil
+	}
+	return result, err
+}
 
+func (z *ZodLazy[T]) parse(input any, in *core.ZodTypeInternals, pc *core.ParseContext) (T, error) {
 	if isNilLazyInput(input) {
 		r, handled, err := engine.ProcessNilModifiers[T](input, in, core.ZodTypeLazy, pc)
 		if err != nil {
@@ -165,6 +173,18 @@ func (z *ZodLazy[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, error)
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodLazy[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodLazy[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse requires exact type matching for compile-time safety.
 func (z *ZodLazy[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
 	result, err := engine.ParseComplexStrict[any](
@@ -258,6 +278,22 @@ func (z *ZodLazy[T]) PrefaultFunc(fn func() any) *ZodLazy[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodLazy[T]) Catch(v any) *ZodLazy[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodLazy[T]) CatchFunc(fn func(core.CatchContext) any) *ZodLazy[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(fn)
+	return z.withInternals(in)
+}
+
 // =============================================================================
 // Metadata Methods
 // =============================================================================
@@ -300,13 +336,30 @@ func (z *ZodLazy[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine adds a typed custom validation function.
 func (z *ZodLazy[T]) Refine(fn func(T) bool, params ...any) *ZodLazy[T] {
-	wrap := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodLazy[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodLazy[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodLazy[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodLazy[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodLazy[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodLazy[T] {
+	wrap := func(ctx context.Context, v any) bool {
 		if typed, ok := v.(T); ok {
-			return fn(typed)
+			return fn(ctx, typed)
 		}
 		return false
 	}
-	return z.withCheck(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a custom validation function accepting any input.
@@ -323,6 +376,11 @@ func (z *ZodLazy[T]) Unwrap() core.ZodType[any] {
 // Internal Helper Methods
 // =============================================================================
 
+// evaluate reports what the resolved inner schema evaluates of value.
+func (z *ZodLazy[T]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	return evaluatedBy(z.resolveInner(), value, ctx)
+}
+
 func (z *ZodLazy[T]) withCheck(c core.ZodCheck) *ZodLazy[T] {
 	in := z.internals.Clone()
 	in.AddCheck(c)
@@ -490,6 +548,18 @@ func (w *schemaWrapper) ParseAny(input any, ctx ...*core.ParseContext) (any, err
 	return w.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (w *schemaWrapper) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return w.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (w *schemaWrapper) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return w.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 func (w *schemaWrapper) Internals() *core.ZodTypeInternals {
 	if s, ok := w.inner.(interface{ Internals() *core.ZodTypeInternals }); ok {
 		return s.Internals()
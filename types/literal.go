@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"reflect"
 	"slices"
 
@@ -113,6 +114,18 @@ func (z *ZodLiteral[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any,
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodLiteral[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodLiteral[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil, with constraint type *T.
 func (z *ZodLiteral[T, R]) Optional() *ZodLiteral[T, *T] {
 	in := z.internals.Clone()
@@ -163,6 +176,22 @@ func (z *ZodLiteral[T, R]) PrefaultFunc(fn func() T) *ZodLiteral[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodLiteral[T, R]) Catch(v T) *ZodLiteral[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodLiteral[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodLiteral[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Describe returns a schema with the description.
 func (z *ZodLiteral[T, R]) Describe(description string) *ZodLiteral[T, R] {
 	return z.Meta(core.GlobalMeta{Description: description})
@@ -177,7 +206,24 @@ func (z *ZodLiteral[T, R]) Meta(meta core.GlobalMeta) *ZodLiteral[T, R] {
 
 // Refine adds a typed custom validation function.
 func (z *ZodLiteral[T, R]) Refine(fn func(T) bool, params ...any) *ZodLiteral[T, R] {
-	wrapper := func(data any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodLiteral[T, R]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodLiteral[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodLiteral[T, R]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodLiteral[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodLiteral[T, R]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodLiteral[T, R] {
+	wrapper := func(ctx context.Context, data any) bool {
 		if data == nil {
 			var zero R
 			_, isPtr := any(zero).(*T)
@@ -187,9 +233,9 @@ func (z *ZodLiteral[T, R]) Refine(fn func(T) bool, params ...any) *ZodLiteral[T,
 		if !ok {
 			return false
 		}
-		return fn(typed)
+		return fn(ctx, typed)
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a flexible custom validation function accepting any input.
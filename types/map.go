@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -184,6 +185,18 @@ func (z *ZodMap[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodMap[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodMap[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil, with constraint type *T.
 func (z *ZodMap[T, R]) Optional() *ZodMap[T, *T] {
 	in := z.internals.Clone()
@@ -247,6 +260,30 @@ func (z *ZodMap[T, R]) PrefaultFunc(fn func() T) *ZodMap[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodMap[T, R]) Catch(v T) *ZodMap[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodMap[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodMap[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodMap[T, R]) Readonly() *ZodMap[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta stores metadata for this map schema.
 func (z *ZodMap[T, R]) Meta(meta core.GlobalMeta) *ZodMap[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -310,7 +347,24 @@ func (z *ZodMap[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds a typed custom validation function.
 func (z *ZodMap[T, R]) Refine(fn func(R) bool, params ...any) *ZodMap[T, R] {
-	wrap := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodMap[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodMap[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodMap[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodMap[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodMap[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodMap[T, R] {
+	wrap := func(ctx context.Context, v any) bool {
 		var zero R
 		switch any(zero).(type) {
 		case *map[any]any:
@@ -318,17 +372,17 @@ func (z *ZodMap[T, R]) Refine(fn func(R) bool, params ...any) *ZodMap[T, R] {
 				return true
 			}
 			if cv, ok := toConstraintValue[T, R](v); ok {
-				return fn(cv)
+				return fn(ctx, cv)
 			}
 			return false
 		default:
 			if cv, ok := toConstraintValue[T, R](v); ok {
-				return fn(cv)
+				return fn(ctx, cv)
 			}
 			return false
 		}
 	}
-	return z.withCheck(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a custom validation function accepting any input.
@@ -432,6 +486,10 @@ func (z *ZodMap[T, R]) validateMap(value map[any]any, chks []core.ZodCheck, ctx
 	var collected []core.ZodRawIssue
 
 	for key, val := range value {
+		if issue, canceled := canceledIssue(ctx, value); canceled {
+			collected = append(collected, issue)
+			break
+		}
 		if z.internals.KeyType != nil {
 			collected = z.collectErrors(key, z.internals.KeyType, key, ctx, collected)
 		}
@@ -112,6 +112,36 @@ func TestModifierOrder_NilInputMatrix(t *testing.T) {
 		assert.Nil(t, got)
 	})
 
+	t.Run("primitive outer optional accepts nil before inner catch", func(t *testing.T) {
+		schema := String().Catch("fallback").Optional()
+
+		got, err := schema.Parse(nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("primitive outer catch replaces inner nonoptional failure", func(t *testing.T) {
+		schema := String().NonOptional().Catch("fallback")
+
+		got, err := schema.Parse(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "fallback", got)
+	})
+
+	t.Run("primitive catch keeps modifier record", func(t *testing.T) {
+		schema := String().Default("a").Catch("b")
+
+		kinds := make([]core.ZodModifierKind, 0, 2)
+		for _, modifier := range schema.Internals().Modifiers {
+			kinds = append(kinds, modifier.Kind)
+		}
+
+		assert.Equal(t, []core.ZodModifierKind{core.ZodModifierDefault, core.ZodModifierCatch}, kinds)
+		assert.False(t, schema.IsOptional())
+	})
+
 	t.Run("exact optional only accepts absent object fields", func(t *testing.T) {
 		schema := Object(core.ObjectSchema{
 			"name": String().ExactOptional(),
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
@@ -97,6 +98,18 @@ func (z *ZodNever[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodNever[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodNever[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // MustParseAny validates the input and panics on failure.
 func (z *ZodNever[T, R]) MustParseAny(input any, ctx ...*core.ParseContext) any {
 	result, err := z.ParseAny(input, ctx...)
@@ -185,6 +198,22 @@ func (z *ZodNever[T, R]) PrefaultFunc(fn func() T) *ZodNever[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodNever[T, R]) Catch(v T) *ZodNever[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodNever[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodNever[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodNever[T, R]) Meta(meta core.GlobalMeta) *ZodNever[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -223,13 +252,30 @@ func (z *ZodNever[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds a custom validation function that operates on the constraint type R.
 func (z *ZodNever[T, R]) Refine(fn func(R) bool, params ...any) *ZodNever[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodNever[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodNever[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodNever[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodNever[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodNever[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodNever[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if converted, ok := convertToNeverConstraintValue[T, R](v); ok {
-			return fn(converted)
+			return fn(ctx, converted)
 		}
 		return false
 	}
-	check := checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...))
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel)
 	newInternals := z.internals.Clone()
 	newInternals.AddCheck(check)
 	return z.withInternals(newInternals)
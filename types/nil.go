@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
@@ -116,6 +117,18 @@ func (z *ZodNil[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodNil[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodNil[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // MODIFIER METHODS
 // =============================================================================
@@ -177,6 +190,22 @@ func (z *ZodNil[T, R]) PrefaultFunc(fn func() T) *ZodNil[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodNil[T, R]) Catch(v T) *ZodNil[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodNil[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodNil[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodNil[T, R]) Meta(meta core.GlobalMeta) *ZodNil[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -227,9 +256,26 @@ func (z *ZodNil[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine applies a custom validation function matching the schema's output type R.
 func (z *ZodNil[T, R]) Refine(fn func(R) bool, params ...any) *ZodNil[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodNil[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodNil[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodNil[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodNil[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodNil[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodNil[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if converted, ok := convertToNilConstraintValue[T, R](v); ok {
-			return fn(converted)
+			return fn(ctx, converted)
 		}
 		return false
 	}
@@ -238,7 +284,7 @@ func (z *ZodNil[T, R]) Refine(fn func(R) bool, params ...any) *ZodNil[T, R] {
 	if sp.Error != nil {
 		msg = sp.Error
 	}
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
@@ -0,0 +1,449 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/checks"
+	"github.com/kaptinlin/gozod/internal/engine"
+	"github.com/kaptinlin/gozod/internal/issues"
+	"github.com/kaptinlin/gozod/internal/utils"
+)
+
+// =============================================================================
+// TYPE DEFINITIONS
+// =============================================================================
+
+// ZodNotDef defines the schema definition for negation validation.
+type ZodNotDef struct {
+	core.ZodTypeDef
+	Inner core.ZodSchema
+}
+
+// ZodNotInternals contains the internal state for negation schema.
+type ZodNotInternals struct {
+	core.ZodTypeInternals
+	Def   *ZodNotDef
+	Inner core.ZodSchema
+}
+
+// ZodNot accepts input only when the inner schema rejects it, like JSON
+// Schema not. Input is returned unchanged; the inner result is discarded.
+// T is the base type, R is the constraint type (T or *T for optional/nilable).
+type ZodNot[T any, R any] struct {
+	internals *ZodNotInternals
+}
+
+// =============================================================================
+// CORE METHODS
+// =============================================================================
+
+// Internals returns the internal state for framework usage.
+func (z *ZodNot[T, R]) Internals() *core.ZodTypeInternals {
+	return &z.internals.ZodTypeInternals
+}
+
+// IsOptional reports whether this schema accepts undefined/missing values.
+func (z *ZodNot[T, R]) IsOptional() bool {
+	return z.internals.IsOptional()
+}
+
+// IsNilable reports whether this schema accepts nil values.
+func (z *ZodNot[T, R]) IsNilable() bool {
+	return z.internals.IsNilable()
+}
+
+// Parse validates that input does not match the inner schema.
+func (z *ZodNot[T, R]) Parse(input any, ctx ...*core.ParseContext) (R, error) {
+	parseCtx := resolveCtx(ctx)
+
+	result, err := engine.ParseComplex[any](
+		input,
+		&z.internals.ZodTypeInternals,
+		core.ZodTypeNot,
+		z.extractType,
+		z.extractPtr,
+		z.validate,
+		parseCtx,
+	)
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+	return convertUnionToConstraint[T, R](result), nil
+}
+
+func (z *ZodNot[T, R]) extractType(input any) (any, bool) {
+	return input, true
+}
+
+func (z *ZodNot[T, R]) extractPtr(input any) (*any, bool) {
+	if input == nil {
+		return nil, true
+	}
+	return &input, true
+}
+
+// validate rejects input the inner schema accepts, then runs the checks.
+// Nil input reaches the inner schema like any other value. An inner failure
+// caused by a done caller context is reported as the cancellation.
+func (z *ZodNot[T, R]) validate(input any, chks []core.ZodCheck, parseCtx *core.ParseContext) (any, error) {
+	if _, err := z.internals.Inner.ParseAny(input, parseCtx); err == nil {
+		description := z.internals.Inner.Internals().Metadata().Description
+		return nil, issues.CreateInvalidNotError(description, input, parseCtx)
+	}
+	if ctxErr := parseCtx.ContextErr(); ctxErr != nil {
+		return nil, issues.CreateCanceledError(ctxErr, input, parseCtx)
+	}
+
+	if len(chks) > 0 {
+		return engine.ApplyChecks[any](input, chks, parseCtx)
+	}
+	return input, nil
+}
+
+// MustParse is like Parse but panics on validation failure.
+func (z *ZodNot[T, R]) MustParse(input any, ctx ...*core.ParseContext) R {
+	result, err := z.Parse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// StrictParse validates input with compile-time type safety.
+// The input must exactly match the schema's base type T.
+func (z *ZodNot[T, R]) StrictParse(input T, ctx ...*core.ParseContext) (R, error) {
+	constraintInput, ok := convertToUnionConstraint[T, R](input)
+	if !ok {
+		var zero R
+		parseCtx := resolveCtx(ctx)
+		return zero, issues.CreateTypeConversionError(
+			fmt.Sprintf("%T", input),
+			"not constraint type",
+			any(input),
+			parseCtx,
+		)
+	}
+
+	return engine.ParseComplexStrict[any, R](
+		constraintInput,
+		&z.internals.ZodTypeInternals,
+		core.ZodTypeNot,
+		z.extractType,
+		z.extractPtr,
+		z.validate,
+		ctx...,
+	)
+}
+
+// MustStrictParse is like StrictParse but panics on validation failure.
+func (z *ZodNot[T, R]) MustStrictParse(input T, ctx ...*core.ParseContext) R {
+	result, err := z.StrictParse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseAny validates input and returns an untyped result for runtime scenarios.
+func (z *ZodNot[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, error) {
+	return z.Parse(input, ctx...)
+}
+
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodNot[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodNot[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
+// =============================================================================
+// MODIFIER METHODS
+// =============================================================================
+
+// Optional returns a new schema that accepts undefined/missing values.
+func (z *ZodNot[T, R]) Optional() *ZodNot[T, *T] {
+	in := z.internals.Clone()
+	in.SetOptional(true)
+	return z.withPtrInternals(in)
+}
+
+// Nilable returns a new schema that accepts nil values.
+func (z *ZodNot[T, R]) Nilable() *ZodNot[T, *T] {
+	in := z.internals.Clone()
+	in.SetNilable(true)
+	return z.withPtrInternals(in)
+}
+
+// Nullish returns a new schema that accepts both undefined and nil values.
+func (z *ZodNot[T, R]) Nullish() *ZodNot[T, *T] {
+	in := z.internals.Clone()
+	in.SetOptional(true)
+	in.SetNilable(true)
+	return z.withPtrInternals(in)
+}
+
+// NonOptional returns a new schema that enforces non-nil values.
+func (z *ZodNot[T, R]) NonOptional() *ZodNot[T, T] {
+	in := z.internals.Clone()
+	in.SetOptional(false)
+	in.SetNonOptional(true)
+	return &ZodNot[T, T]{internals: z.cloneState(in)}
+}
+
+// Default sets a value to use when input is nil, bypassing validation.
+func (z *ZodNot[T, R]) Default(v T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetDefaultValue(v)
+	return z.withInternals(in)
+}
+
+// DefaultFunc sets a function to produce the default value when input is nil.
+func (z *ZodNot[T, R]) DefaultFunc(fn func() T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetDefaultFunc(func() any { return fn() })
+	return z.withInternals(in)
+}
+
+// Prefault sets a fallback value that goes through the full validation pipeline.
+func (z *ZodNot[T, R]) Prefault(v T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetPrefaultValue(v)
+	return z.withInternals(in)
+}
+
+// PrefaultFunc sets a function to produce the prefault value.
+func (z *ZodNot[T, R]) PrefaultFunc(fn func() T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetPrefaultFunc(func() any { return fn() })
+	return z.withInternals(in)
+}
+
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodNot[T, R]) Catch(v T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodNot[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Meta attaches metadata to this schema.
+func (z *ZodNot[T, R]) Meta(meta core.GlobalMeta) *ZodNot[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	core.ApplySchemaMeta(z, clone, meta)
+	return clone
+}
+
+// Describe sets a human-readable description for this schema.
+func (z *ZodNot[T, R]) Describe(description string) *ZodNot[T, R] {
+	return z.Meta(core.GlobalMeta{Description: description})
+}
+
+// =============================================================================
+// TYPE-SPECIFIC METHODS
+// =============================================================================
+
+// Inner returns the negated schema.
+func (z *ZodNot[T, R]) Inner() core.ZodSchema {
+	return z.internals.Inner
+}
+
+// =============================================================================
+// TRANSFORMATION AND PIPELINE METHODS
+// =============================================================================
+
+// Transform creates a type-safe transformation pipeline.
+func (z *ZodNot[T, R]) Transform(fn func(T, *core.RefinementContext) (any, error)) *core.ZodTransform[R, any] {
+	wrapperFn := func(input R, ctx *core.RefinementContext) (any, error) {
+		return fn(extractUnionValue[T, R](input), ctx)
+	}
+	return core.NewZodTransform[R, any](z, wrapperFn)
+}
+
+// Pipe chains this schema's output into another schema for further validation.
+func (z *ZodNot[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
+	wrapperFn := func(input R, ctx *core.ParseContext) (any, error) {
+		return target.Parse(extractUnionValue[T, R](input), ctx)
+	}
+	return core.NewZodPipe[R, any](z, target, wrapperFn)
+}
+
+// =============================================================================
+// REFINEMENT METHODS
+// =============================================================================
+
+// Refine adds a custom validation check with type-safe access to the parsed value.
+func (z *ZodNot[T, R]) Refine(fn func(R) bool, params ...any) *ZodNot[T, R] {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodNot[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodNot[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodNot[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodNot[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodNot[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodNot[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
+		cv, ok := convertToUnionConstraint[T, R](v)
+		if !ok {
+			return false
+		}
+		return fn(ctx, cv)
+	}
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
+}
+
+// RefineAny adds a custom validation check operating on the raw value.
+func (z *ZodNot[T, R]) RefineAny(fn func(any) bool, params ...any) *ZodNot[T, R] {
+	return z.withCheck(checks.NewCustom[any](fn, utils.NormalizeCustomParams(params...)))
+}
+
+// =============================================================================
+// COMPOSITION METHODS (Zod v4 Compatibility)
+// =============================================================================
+
+// And creates an intersection of this schema with another.
+func (z *ZodNot[T, R]) And(other any) *ZodIntersection[any, any] {
+	return Intersection(z, other)
+}
+
+// Or creates a union of this schema with another, enabling chaining.
+func (z *ZodNot[T, R]) Or(other any) *ZodUnion[any, any] {
+	return Union([]any{z, other})
+}
+
+// =============================================================================
+// HELPER METHODS
+// =============================================================================
+
+func (z *ZodNot[T, R]) withCheck(c core.ZodCheck) *ZodNot[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(c)
+	return z.withInternals(in)
+}
+
+// cloneState pairs in with the definition and inner schema of z.
+func (z *ZodNot[T, R]) cloneState(in *core.ZodTypeInternals) *ZodNotInternals {
+	return &ZodNotInternals{
+		ZodTypeInternals: *in,
+		Def:              z.internals.Def,
+		Inner:            z.internals.Inner,
+	}
+}
+
+func (z *ZodNot[T, R]) withPtrInternals(in *core.ZodTypeInternals) *ZodNot[T, *T] {
+	clone := &ZodNot[T, *T]{internals: z.cloneState(in)}
+	finalizeClone(clone)
+	return clone
+}
+
+func (z *ZodNot[T, R]) withInternals(in *core.ZodTypeInternals) *ZodNot[T, R] {
+	clone := &ZodNot[T, R]{internals: z.cloneState(in)}
+	finalizeClone(clone)
+	return clone
+}
+
+// CloneFrom copies configuration from another schema.
+func (z *ZodNot[T, R]) CloneFrom(source any) {
+	src, ok := source.(*ZodNot[T, R])
+	if !ok || src == nil {
+		return
+	}
+	cloneWithPreservedChecks(src, z, func() {
+		z.internals = src.cloneState(src.internals.Clone())
+	})
+}
+
+// =============================================================================
+// CONSTRUCTOR FUNCTIONS
+// =============================================================================
+
+func newZodNotFromDef[T any, R any](def *ZodNotDef) *ZodNot[T, R] {
+	internals := &ZodNotInternals{
+		ZodTypeInternals: engine.NewBaseZodTypeInternals(def.Type),
+		Def:              def,
+		Inner:            def.Inner,
+	}
+
+	internals.Constructor = func(newDef *core.ZodTypeDef) core.ZodType[any] {
+		notDef := &ZodNotDef{
+			ZodTypeDef: *newDef,
+			Inner:      def.Inner,
+		}
+		return any(newZodNotFromDef[T, R](notDef)).(core.ZodType[any])
+	}
+
+	schema := &ZodNot[T, R]{internals: internals}
+
+	if def.Error != nil {
+		internals.Error = def.Error
+	}
+	for _, check := range def.Checks {
+		internals.AddCheck(check)
+	}
+
+	return schema
+}
+
+// =============================================================================
+// FACTORY FUNCTIONS
+// =============================================================================
+
+// Not creates a schema that accepts any input the given schema rejects.
+func Not(schema any, args ...any) *ZodNot[any, any] {
+	return NotTyped[any, any](schema, args...)
+}
+
+// NotPtr creates a negation schema with pointer constraint type.
+func NotPtr(schema any, args ...any) *ZodNot[any, *any] {
+	return NotTyped[any, *any](schema, args...)
+}
+
+// NotTyped creates a typed negation schema with generic constraints.
+func NotTyped[T any, R any](schema any, args ...any) *ZodNot[T, R] {
+	param := utils.FirstParam(args...)
+	normalizedParams := utils.NormalizeParams(param)
+
+	if schema == nil {
+		panic("Not: schema is required")
+	}
+	inner, err := core.ConvertToZodSchema(schema)
+	if err != nil {
+		panic(fmt.Sprintf("Not: %v", err))
+	}
+	def := &ZodNotDef{
+		ZodTypeDef: core.ZodTypeDef{
+			Type:   core.ZodTypeNot,
+			Checks: []core.ZodCheck{},
+		},
+		Inner: inner,
+	}
+	if normalizedParams != nil {
+		utils.ApplySchemaParams(&def.ZodTypeDef, normalizedParams)
+	}
+
+	return newZodNotFromDef[T, R](def)
+}
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+func TestNot_RejectsInnerMatches(t *testing.T) {
+	schema := Not(String())
+
+	result, err := schema.Parse(42)
+	require.NoError(t, err)
+	assert.Equal(t, 42, result)
+
+	_, err = schema.Parse("text")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidNot, zodErr.Issues[0].Code)
+	assert.Empty(t, zodErr.Issues[0].Description)
+	assert.Equal(t, "Input matches a forbidden schema", zodErr.Issues[0].Message)
+}
+
+func TestNot_ReportsInnerDescription(t *testing.T) {
+	schema := Not(Literal("admin").Describe("reserved role name"))
+
+	_, err := schema.Parse("admin")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, "reserved role name", zodErr.Issues[0].Description)
+	assert.Equal(t, "Input matches a forbidden schema: reserved role name", zodErr.Issues[0].Message)
+
+	_, err = schema.Parse("editor")
+	require.NoError(t, err)
+}
+
+func TestNot_NestedIssueKeepsDescription(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"role": Not(Literal("admin").Describe("reserved role name")),
+	})
+
+	_, err := schema.Parse(map[string]any{"role": "admin"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidNot, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"role"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "reserved role name", zodErr.Issues[0].Description)
+}
+
+func TestNot_NegatesInnerVerdictOnNil(t *testing.T) {
+	result, err := Not(String()).Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = Not(Nil()).Parse(nil)
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidNot, zodErr.Issues[0].Code)
+
+	_, err = Not(String()).Parse((*string)(nil))
+	require.NoError(t, err)
+}
+
+func TestNot_CanceledInnerFailureIsNotAccepted(t *testing.T) {
+	live := func(ctx context.Context, _ string) bool { return ctx.Err() == nil }
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Not(String().RefineContext(live)).ParseWithContext(ctx, "x")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+}
+
+func TestNot_Accessors(t *testing.T) {
+	inner := String().Min(3)
+	schema := Not(inner)
+
+	assert.Same(t, inner, schema.Inner())
+	assert.Equal(t, core.ZodTypeNot, schema.Internals().Type)
+
+	assert.Panics(t, func() { Not(nil) })
+}
+
+func TestNot_Modifiers(t *testing.T) {
+	base := Not(String())
+
+	optional := base.Optional()
+	result, err := optional.Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.False(t, base.IsOptional())
+
+	refined := base.RefineAny(func(v any) bool { return v != 0 }, "zero")
+	_, err = refined.Parse(0)
+	require.Error(t, err)
+	_, err = base.Parse(0)
+	require.NoError(t, err)
+
+	described := base.Describe("not a string")
+	assert.Equal(t, "not a string", described.Internals().Metadata().Description)
+	assert.Same(t, base.Inner(), described.Inner())
+}
+
+func TestNot_CloneFrom(t *testing.T) {
+	source := Not(String()).Describe("source")
+	target := Not(Int())
+	target.CloneFrom(source)
+
+	_, err := target.Parse("x")
+	require.Error(t, err)
+	_, err = target.Parse(5)
+	require.NoError(t, err)
+}
@@ -1,17 +1,20 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"maps"
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
 	"github.com/kaptinlin/gozod/internal/issues"
 	"github.com/kaptinlin/gozod/internal/utils"
-	"github.com/kaptinlin/gozod/pkg/tagparser"
 )
 
 var (
@@ -61,6 +64,18 @@ type ZodObjectInternals struct {
 	Catchall core.ZodSchema
 	// UnknownKeys controls how unknown keys are handled.
 	UnknownKeys ObjectMode
+	// Patterns validate the values of keys matching a pattern, ordered by pattern.
+	Patterns []ObjectPattern
+	// PropertyNames validates every key of the input, when set.
+	PropertyNames core.ZodSchema
+	// DependentRequired maps a key to the keys required when it is present.
+	DependentRequired map[string][]string
+	// DependentSchemas maps a key to a schema the object must satisfy when it is present.
+	DependentSchemas map[string]core.ZodSchema
+	// FieldComparisons lists the cross-key comparisons checked after the fields.
+	FieldComparisons []FieldComparison
+	// RequiredIf maps a key to the conditions under which it is required.
+	RequiredIf map[string][]FieldCondition
 	// IsPartial reports whether omitted fields are allowed.
 	IsPartial bool
 	// PartialExceptions marks fields that remain required in partial mode.
@@ -69,6 +84,18 @@ type ZodObjectInternals struct {
 	HasUserRefinements bool
 	// FieldNameTag is the struct tag used for field names (default "json").
 	FieldNameTag string
+	// zeroIsAbsent makes dependencies treat zero-valued keys as absent, for
+	// objects that validate Go structs.
+	zeroIsAbsent bool
+	// plan is the field walk precomputed by Compile; derived schemas drop it.
+	plan *objectPlan
+}
+
+// ObjectPattern pairs a key pattern with the schema for values of matching
+// keys, like one entry of JSON Schema patternProperties.
+type ObjectPattern struct {
+	Pattern *regexp.Regexp
+	Schema  core.ZodSchema
 }
 
 // ZodObject represents a type-safe object validation schema.
@@ -92,6 +119,36 @@ func (z *ZodObject[T, R]) Catchall() core.ZodSchema {
 	return z.internals.Catchall
 }
 
+// Patterns returns the pattern property schemas, ordered by pattern.
+func (z *ZodObject[T, R]) Patterns() []ObjectPattern {
+	return slices.Clone(z.internals.Patterns)
+}
+
+// PropertyNamesSchema returns the schema every key must satisfy, or nil.
+func (z *ZodObject[T, R]) PropertyNamesSchema() core.ZodSchema {
+	return z.internals.PropertyNames
+}
+
+// RequiredDependencies returns the keys required by each present key.
+func (z *ZodObject[T, R]) RequiredDependencies() map[string][]string {
+	return cloneDependentRequired(z.internals.DependentRequired)
+}
+
+// SchemaDependencies returns the schemas applied when each key is present.
+func (z *ZodObject[T, R]) SchemaDependencies() map[string]core.ZodSchema {
+	return maps.Clone(z.internals.DependentSchemas)
+}
+
+// FieldComparisons returns the cross-key comparisons of the object.
+func (z *ZodObject[T, R]) FieldComparisons() []FieldComparison {
+	return slices.Clone(z.internals.FieldComparisons)
+}
+
+// RequiredIfConditions returns the conditions under which each key is required.
+func (z *ZodObject[T, R]) RequiredIfConditions() map[string][]FieldCondition {
+	return cloneRequiredIf(z.internals.RequiredIf)
+}
+
 // IsOptional reports whether this schema accepts undefined/missing values.
 func (z *ZodObject[T, R]) IsOptional() bool {
 	return z.internals.IsOptional()
@@ -185,6 +242,18 @@ func (z *ZodObject[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, e
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodObject[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodObject[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil, with constraint type *T.
 func (z *ZodObject[T, R]) Optional() *ZodObject[T, *T] {
 	in := z.internals.Clone()
@@ -252,6 +321,30 @@ func (z *ZodObject[T, R]) PrefaultFunc(fn func() T) *ZodObject[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodObject[T, R]) Catch(v T) *ZodObject[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodObject[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodObject[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodObject[T, R]) Readonly() *ZodObject[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodObject[T, R]) Meta(meta core.GlobalMeta) *ZodObject[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -462,6 +555,81 @@ func (z *ZodObject[T, R]) WithCatchall(catchallSchema core.ZodSchema) *ZodObject
 	return &ZodObject[T, R]{internals: oi}
 }
 
+// PatternProperties validates the value of every key matching a pattern
+// against its schema, like JSON Schema patternProperties. Shape keys must
+// satisfy both their field schema and any matching pattern schema. Other keys
+// matching a pattern are kept in the output and are not treated as unknown.
+// A pattern with the same source as an existing one replaces it.
+func (z *ZodObject[T, R]) PatternProperties(patterns map[*regexp.Regexp]core.ZodSchema) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	for pattern, schema := range patterns {
+		if pattern == nil || schema == nil {
+			panic("PatternProperties: pattern and schema are required")
+		}
+		oi.Patterns = slices.DeleteFunc(oi.Patterns, func(p ObjectPattern) bool {
+			return p.Pattern.String() == pattern.String()
+		})
+		oi.Patterns = append(oi.Patterns, ObjectPattern{Pattern: pattern, Schema: schema})
+	}
+	slices.SortFunc(oi.Patterns, func(a, b ObjectPattern) int {
+		return strings.Compare(a.Pattern.String(), b.Pattern.String())
+	})
+	return &ZodObject[T, R]{internals: oi}
+}
+
+// PropertyNames validates every key of the input against schema, like JSON
+// Schema propertyNames. Failing keys are reported as invalid_key issues.
+func (z *ZodObject[T, R]) PropertyNames(schema core.ZodSchema) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	oi.PropertyNames = schema
+	return &ZodObject[T, R]{internals: oi}
+}
+
+// DependentRequired requires the listed keys whenever the key they are mapped
+// from is present, like JSON Schema dependentRequired. Each absent key is
+// reported as a missing_required issue at its own path. An entry for a key
+// that already has dependents replaces them.
+func (z *ZodObject[T, R]) DependentRequired(deps map[string][]string) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	oi.DependentRequired = withDependentRequired(oi.DependentRequired, deps)
+	return &ZodObject[T, R]{internals: oi}
+}
+
+// DependentSchemas validates the whole object against a schema whenever the
+// key it is mapped from is present, like JSON Schema dependentSchemas. The
+// schema only validates; its output is discarded. An entry for a key that
+// already has a schema replaces it.
+func (z *ZodObject[T, R]) DependentSchemas(deps map[string]core.ZodSchema) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	oi.DependentSchemas = withDependentSchemas(oi.DependentSchemas, deps)
+	return &ZodObject[T, R]{internals: oi}
+}
+
+// CompareFields requires each comparison's key to stand in its relation to
+// the other key, as in "end after start". A comparison is checked only when
+// its key is present; a failure is reported as an invalid_field_comparison
+// issue at that key.
+func (z *ZodObject[T, R]) CompareFields(comparisons ...FieldComparison) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	oi.FieldComparisons = withFieldComparisons(oi.FieldComparisons, comparisons)
+	return &ZodObject[T, R]{internals: oi}
+}
+
+// RequiredIf requires key whenever every condition holds. An absent key is
+// reported as a missing_required issue at its own path. Calling RequiredIf
+// again for the same key replaces its conditions.
+func (z *ZodObject[T, R]) RequiredIf(key string, conditions ...FieldCondition) *ZodObject[T, R] {
+	newInternals := z.internals.Clone()
+	oi := z.newObjectInternals(newInternals)
+	oi.RequiredIf = withRequiredIf(oi.RequiredIf, key, conditions)
+	return &ZodObject[T, R]{internals: oi}
+}
+
 // Keyof returns a string enum schema of all keys.
 func (z *ZodObject[T, R]) Keyof() *ZodEnum[string, string] {
 	keys := make([]string, 0, len(z.internals.Shape))
@@ -502,18 +670,35 @@ func (z *ZodObject[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 // Refine applies type-safe validation using constraint type.
 // Schemas with refinements cannot use Pick/Omit.
 func (z *ZodObject[T, R]) Refine(fn func(R) bool, params ...any) *ZodObject[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodObject[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodObject[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodObject[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodObject[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodObject[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodObject[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if v == nil {
 			return true
 		}
 		if constraintVal, ok := convertToObjectType[T, R](v); ok {
-			return fn(constraintVal)
+			return fn(ctx, constraintVal)
 		}
 		return false
 	}
 
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	result := z.withInternals(in)
 	result.internals.HasUserRefinements = true
 	return result
@@ -547,6 +732,13 @@ func (z *ZodObject[T, R]) newObjectInternals(in *core.ZodTypeInternals) *ZodObje
 		Shape:              maps.Clone(z.internals.Shape),
 		Catchall:           z.internals.Catchall,
 		UnknownKeys:        z.internals.UnknownKeys,
+		Patterns:           slices.Clone(z.internals.Patterns),
+		PropertyNames:      z.internals.PropertyNames,
+		DependentRequired:  cloneDependentRequired(z.internals.DependentRequired),
+		DependentSchemas:   maps.Clone(z.internals.DependentSchemas),
+		FieldComparisons:   slices.Clone(z.internals.FieldComparisons),
+		RequiredIf:         cloneRequiredIf(z.internals.RequiredIf),
+		zeroIsAbsent:       z.internals.zeroIsAbsent,
 		IsPartial:          z.internals.IsPartial,
 		PartialExceptions:  maps.Clone(z.internals.PartialExceptions),
 		HasUserRefinements: z.internals.HasUserRefinements,
@@ -688,22 +880,21 @@ func (z *ZodObject[T, R]) extractObject(v any) (map[string]any, error) {
 		rv = rv.Elem()
 	}
 
-	if rv.Kind() == reflect.Struct {
-		result := make(map[string]any, rv.NumField())
-		for field, value := range rv.Fields() {
-			if !field.IsExported() || !value.CanInterface() {
-				continue
+	if rv.Kind() == reflect.Struct && z.internals.plan != nil {
+		keys := z.internals.plan.structKeys(rv.Type(), z.internals.FieldNameTag)
+		result := make(map[string]any, len(keys))
+		for _, key := range keys {
+			if value := structFieldValue(rv, key.index); value.CanInterface() {
+				result[key.name] = value.Interface()
 			}
-
-			fieldKey := tagparser.FieldName(fieldNameTagOrDefault(z.internals.FieldNameTag), field)
-			if fieldKey.Skip {
-				continue
-			}
-			result[fieldKey.Name] = value.Interface()
 		}
 		return result, nil
 	}
 
+	if rv.Kind() == reflect.Struct {
+		return structToMap(z.internals.FieldNameTag, rv), nil
+	}
+
 	return nil, issues.CreateTypeConversionError(
 		fmt.Sprintf("%T", v), "map[string]any", v, core.NewParseContext(),
 	)
@@ -727,11 +918,25 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 	var errs []core.ZodRawIssue
 	result := make(map[string]any, len(z.internals.Shape))
 
-	for name, schema := range z.internals.Shape {
+	pre := prevalidateFields(ctx, z.internals.Shape,
+		func(name string) (any, bool) {
+			val, exists := value[name]
+			return val, exists && val != nil
+		},
+		func(val any, schema core.ZodSchema) (any, error) {
+			return z.validateField(val, schema, ctx)
+		},
+	)
+
+	for _, field := range z.objectFields(ctx != nil && ctx.Workers >= 2) {
+		name, schema := field.name, field.schema
+		if issue, canceled := canceledIssue(ctx, value); canceled {
+			return nil, issues.CreateArrayValidationIssues(append(errs, issue))
+		}
 		val, exists := value[name]
 
 		if !exists {
-			if z.missingFieldUsesFallback(schema) {
+			if field.fallback {
 				parsed, err := z.validateField(nil, schema, ctx)
 				if err != nil {
 					collectFieldErrors(err, name, &errs, nil)
@@ -740,7 +945,7 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 				result[name] = parsed
 				continue
 			}
-			if !z.isFieldOptional(schema, name) {
+			if !field.optional {
 				raw := issues.CreateIssue(
 					core.InvalidType,
 					fmt.Sprintf("missing required field: %s", name),
@@ -756,7 +961,7 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 			continue
 		}
 
-		if val == nil && z.isFieldExactOptional(schema) {
+		if val == nil && field.exactOptional {
 			raw := issues.CreateIssue(
 				core.InvalidType,
 				fmt.Sprintf("field %s cannot be explicitly nil (use absent key instead)", name),
@@ -771,7 +976,13 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 			continue
 		}
 
-		parsed, err := z.validateField(val, schema, ctx)
+		var parsed any
+		var err error
+		if r, ok := pre[name]; ok {
+			parsed, err = r.value, r.err
+		} else {
+			parsed, err = z.validateField(val, schema, ctx)
+		}
 		if err != nil {
 			collectFieldErrors(err, name, &errs, val)
 			continue
@@ -779,11 +990,37 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 		result[name] = z.normalizeFieldOutput(val, parsed, schema)
 	}
 
+	if z.internals.PropertyNames != nil {
+		errs = append(errs, z.propertyNameIssues(value, ctx)...)
+	}
+	if len(z.internals.DependentRequired) > 0 || len(z.internals.DependentSchemas) > 0 ||
+		len(z.internals.FieldComparisons) > 0 || len(z.internals.RequiredIf) > 0 {
+		lookup := func(key string) (any, bool) {
+			val, ok := value[key]
+			return val, ok && (!z.internals.zeroIsAbsent || isStructFieldSet(val))
+		}
+		present := func(key string) bool {
+			_, set := lookup(key)
+			return set
+		}
+		errs = append(errs, dependentRequiredIssues(z.internals.DependentRequired, present)...)
+		errs = append(errs, dependentSchemaIssues(z.internals.DependentSchemas, present, value, ctx)...)
+		errs = append(errs, fieldComparisonIssues(z.internals.FieldComparisons, lookup)...)
+		errs = append(errs, requiredIfIssues(z.internals.RequiredIf, lookup)...)
+	}
+
 	var unknown []string
 	for key, val := range value {
+		parsed, matched, valid := z.validatePatterns(key, val, ctx, &errs)
 		if _, known := z.internals.Shape[key]; known {
 			continue
 		}
+		if matched {
+			if valid {
+				result[key] = parsed
+			}
+			continue
+		}
 		switch z.internals.UnknownKeys {
 		case ObjectModeStrict:
 			unknown = append(unknown, key)
@@ -832,6 +1069,79 @@ func (z *ZodObject[T, R]) validateObject(value map[string]any, chks []core.ZodCh
 	return result, nil
 }
 
+// evaluate reports the keys of value the object evaluates: every key outside
+// strip mode, and otherwise the shape keys, the keys matching a pattern, and
+// the keys evaluated by the dependent schemas that apply.
+func (z *ZodObject[T, R]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	if z.internals.UnknownKeys != ObjectModeStrip {
+		return evaluation{allKeys: true}
+	}
+	var e evaluation
+	keys, _ := objectKeys(value)
+	for _, key := range keys {
+		if _, known := z.internals.Shape[key]; known {
+			e.addKey(key)
+			continue
+		}
+		for _, pattern := range z.internals.Patterns {
+			if pattern.Pattern.MatchString(key) {
+				e.addKey(key)
+				break
+			}
+		}
+	}
+	for _, key := range keys {
+		if schema, ok := z.internals.DependentSchemas[key]; ok {
+			e.merge(evaluatedBy(schema, value, ctx))
+		}
+	}
+	return e
+}
+
+// validatePatterns validates val against the schema of every pattern matching
+// key. It reports whether any pattern matched and whether all matching
+// schemas accepted val, returning the value parsed by the first of them.
+func (z *ZodObject[T, R]) validatePatterns(key string, val any, ctx *core.ParseContext, errs *[]core.ZodRawIssue) (any, bool, bool) {
+	var parsed any
+	matched, valid := false, true
+	for _, pattern := range z.internals.Patterns {
+		if !pattern.Pattern.MatchString(key) {
+			continue
+		}
+		out, err := z.validateField(val, pattern.Schema, ctx)
+		if err != nil {
+			collectFieldErrors(err, key, errs, val)
+			valid = false
+		} else if !matched {
+			parsed = z.normalizeFieldOutput(val, out, pattern.Schema)
+		}
+		matched = true
+	}
+	return parsed, matched, valid
+}
+
+// propertyNameIssues validates every key against the PropertyNames schema.
+func (z *ZodObject[T, R]) propertyNameIssues(value map[string]any, ctx *core.ParseContext) []core.ZodRawIssue {
+	var keyIssues []core.ZodRawIssue
+	for _, key := range slices.Sorted(maps.Keys(value)) {
+		_, err := z.internals.PropertyNames.ParseAny(key, ctx)
+		if err == nil {
+			continue
+		}
+		raw := issues.CreateInvalidKeyIssue(key, "object", value)
+		if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
+			nested := make([]core.ZodRawIssue, len(zodErr.Issues))
+			for i, issue := range zodErr.Issues {
+				nested[i] = issues.ConvertZodIssueToRaw(issue)
+			}
+			raw.Properties["issues"] = nested
+		}
+		raw.Path = []any{key}
+		keyIssues = append(keyIssues, raw)
+	}
+	return keyIssues
+}
+
 // validateField validates a single field value against its schema.
 func (z *ZodObject[T, R]) validateField(value any, schema core.ZodSchema, ctx *core.ParseContext) (any, error) {
 	if schema == nil {
@@ -840,17 +1150,6 @@ func (z *ZodObject[T, R]) validateField(value any, schema core.ZodSchema, ctx *c
 	return schema.ParseAny(value, ctx)
 }
 
-func (z *ZodObject[T, R]) missingFieldUsesFallback(schema core.ZodSchema) bool {
-	if schema == nil {
-		return false
-	}
-	internals := schema.Internals()
-	if internals == nil {
-		return false
-	}
-	return internals.NilInputUsesFallback()
-}
-
 func (z *ZodObject[T, R]) normalizeFieldOutput(input, parsed any, schema core.ZodSchema) any {
 	if input == nil || parsed == nil || schema == nil {
 		return parsed
@@ -889,14 +1188,6 @@ func (z *ZodObject[T, R]) isFieldOptional(schema core.ZodSchema, field string) b
 	return schema.Internals().IsOptional()
 }
 
-// isFieldExactOptional reports whether a field has ExactOptional set.
-func (z *ZodObject[T, R]) isFieldExactOptional(schema core.ZodSchema) bool {
-	if schema == nil {
-		return false
-	}
-	return schema.Internals().IsExactOptional()
-}
-
 // newZodObjectFromDef constructs a new ZodObject from a definition.
 func newZodObjectFromDef[T any, R any](def *ZodObjectDef) *ZodObject[T, R] {
 	internals := &ZodObjectInternals{
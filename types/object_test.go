@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"maps"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -2101,7 +2102,7 @@ func TestObject_MultipleErrorCollection(t *testing.T) {
 			case core.UnrecognizedKeys:
 				unrecognizedErrors++
 				assert.Equal(t, []any{}, issue.Path, "Unrecognized keys error should have empty path")
-			case core.InvalidType, core.InvalidValue, core.InvalidFormat, core.InvalidUnion, core.InvalidKey, core.InvalidElement, core.TooBig, core.NotMultipleOf, core.Custom, core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired, core.TypeConversion, core.NilPointer:
+			case core.InvalidType, core.InvalidValue, core.InvalidFormat, core.InvalidUnion, core.InvalidKey, core.InvalidElement, core.TooBig, core.NotMultipleOf, core.Custom, core.InvalidSchema, core.InvalidDiscriminator, core.IncompatibleTypes, core.MissingRequired, core.TypeConversion, core.NilPointer, core.Canceled, core.InvalidNot, core.InvalidFieldComparison:
 				// These issue codes are not expected in this specific test
 			default:
 				// Handle unexpected issue codes gracefully
@@ -2302,3 +2303,168 @@ func TestObject_SafeExtend(t *testing.T) {
 		assert.Equal(t, 30, result["age"])
 	})
 }
+
+func TestObject_PatternProperties(t *testing.T) {
+	extension := regexp.MustCompile(`^x-`)
+	schema := Object(core.ObjectSchema{
+		"name": String(),
+	}).PatternProperties(map[*regexp.Regexp]core.ZodSchema{
+		extension: String().Min(2),
+	}).Strict()
+
+	input := map[string]any{"name": "api", "x-owner": "ops"}
+	result, err := schema.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	_, err = schema.Parse(map[string]any{"name": "api", "x-owner": "o"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"x-owner"}, zodErr.Issues[0].Path)
+
+	_, err = schema.Parse(map[string]any{"name": "api", "owner": "ops"})
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.UnrecognizedKeys, zodErr.Issues[0].Code)
+
+	t.Run("shape keys also satisfy matching patterns", func(t *testing.T) {
+		schema := Object(core.ObjectSchema{"x-id": String()}).
+			PatternProperties(map[*regexp.Regexp]core.ZodSchema{extension: String().Min(3)})
+		_, err := schema.Parse(map[string]any{"x-id": "ab"})
+		require.Error(t, err)
+		_, err = schema.Parse(map[string]any{"x-id": "abc"})
+		require.NoError(t, err)
+	})
+
+	t.Run("catchall applies only to keys matching no pattern", func(t *testing.T) {
+		schema := Object(core.ObjectSchema{"name": String()}).
+			PatternProperties(map[*regexp.Regexp]core.ZodSchema{extension: String()}).
+			Passthrough().
+			WithCatchall(Int())
+		_, err := schema.Parse(map[string]any{"name": "api", "x-owner": "ops", "port": 80})
+		require.NoError(t, err)
+		_, err = schema.Parse(map[string]any{"name": "api", "port": "80"})
+		require.Error(t, err)
+	})
+
+	t.Run("accessors and replacement", func(t *testing.T) {
+		replaced := schema.PatternProperties(map[*regexp.Regexp]core.ZodSchema{
+			regexp.MustCompile(`^x-`): Int(),
+			regexp.MustCompile(`^a-`): Bool(),
+		})
+		patterns := replaced.Patterns()
+		require.Len(t, patterns, 2)
+		assert.Equal(t, "^a-", patterns[0].Pattern.String())
+		assert.Equal(t, "^x-", patterns[1].Pattern.String())
+		assert.Len(t, schema.Patterns(), 1)
+	})
+}
+
+func TestObject_PropertyNames(t *testing.T) {
+	schema := Object(core.ObjectSchema{}).
+		PropertyNames(String().Regex(regexp.MustCompile(`^[a-z]+$`))).
+		Passthrough()
+
+	_, err := schema.Parse(map[string]any{"name": 1, "role": 2})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(map[string]any{"name": 1, "Role": 2})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidKey, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"Role"}, zodErr.Issues[0].Path)
+	require.Len(t, zodErr.Issues[0].Issues, 1)
+	assert.Equal(t, core.InvalidFormat, zodErr.Issues[0].Issues[0].Code)
+
+	assert.NotNil(t, schema.PropertyNamesSchema())
+	assert.Nil(t, Object(core.ObjectSchema{}).PropertyNamesSchema())
+}
+
+func TestObject_DependentRequired(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"credit_card":     String().Optional(),
+		"billing_address": String().Optional(),
+		"billing_name":    String().Optional(),
+	}).DependentRequired(map[string][]string{
+		"credit_card": {"billing_address", "billing_name"},
+	})
+
+	_, err := schema.Parse(map[string]any{})
+	require.NoError(t, err)
+	_, err = schema.Parse(map[string]any{"billing_address": "Main St"})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(map[string]any{"credit_card": "4242", "billing_name": "Ada"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"billing_address"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "credit_card", zodErr.Issues[0].Params["dependency"])
+
+	t.Run("accessors and replacement", func(t *testing.T) {
+		replaced := schema.DependentRequired(map[string][]string{"credit_card": {"billing_name"}})
+		assert.Equal(t, map[string][]string{"credit_card": {"billing_name"}}, replaced.RequiredDependencies())
+		assert.Len(t, schema.RequiredDependencies()["credit_card"], 2)
+		assert.Nil(t, Object(core.ObjectSchema{}).RequiredDependencies())
+	})
+}
+
+func TestObject_DependentSchemas(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"credit_card":     String().Optional(),
+		"billing_address": String().Optional(),
+	}).DependentSchemas(map[string]core.ZodSchema{
+		"credit_card": Object(core.ObjectSchema{"billing_address": String().Min(3)}).Passthrough(),
+	})
+
+	result, err := schema.Parse(map[string]any{"credit_card": "4242", "billing_address": "Main St"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"credit_card": "4242", "billing_address": "Main St"}, result)
+	_, err = schema.Parse(map[string]any{"billing_address": "x"})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(map[string]any{"credit_card": "4242", "billing_address": "x"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"billing_address"}, zodErr.Issues[0].Path)
+
+	assert.Len(t, schema.SchemaDependencies(), 1)
+	assert.Panics(t, func() {
+		schema.DependentSchemas(map[string]core.ZodSchema{"credit_card": nil})
+	})
+}
+
+func TestObject_CrossFieldRules(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"password": String(),
+		"confirm":  String().Optional(),
+		"plan":     String().Optional(),
+		"seats":    Float64().Optional(),
+	}).CompareFields(FieldComparison{Field: "confirm", Relation: FieldEqual, Other: "password"}).
+		RequiredIf("seats", FieldCondition{Field: "plan", Value: "team"})
+
+	_, err := schema.Parse(map[string]any{"password": "secret"})
+	require.NoError(t, err)
+	_, err = schema.Parse(map[string]any{"password": "secret", "confirm": "secret", "plan": "team", "seats": 5.0})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(map[string]any{"password": "secret", "confirm": "Secret", "plan": "team"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 2)
+	assert.Equal(t, []any{"confirm"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "eq", zodErr.Issues[0].Params["relation"])
+	assert.Equal(t, "password", zodErr.Issues[0].Params["field"])
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[1].Code)
+	assert.Equal(t, []any{"seats"}, zodErr.Issues[1].Path)
+
+	assert.Len(t, schema.FieldComparisons(), 1)
+	assert.Equal(t, map[string][]FieldCondition{"seats": {{Field: "plan", Value: "team"}}}, schema.RequiredIfConditions())
+	assert.Nil(t, Object(core.ObjectSchema{}).RequiredIfConditions())
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/checks"
+)
+
+// parallelWorkers returns the worker pool size for validating values against
+// schemas, or 0 when the walk must stay serial. Fan-out is opt-in: every
+// schema must carry a RefineParallel check and the parse call must request
+// at least two workers through core.ParseContext.Workers.
+func parallelWorkers(ctx *core.ParseContext, schemas ...core.ZodSchema) int {
+	if ctx == nil || ctx.Workers < 2 || len(schemas) == 0 {
+		return 0
+	}
+	for _, schema := range schemas {
+		if schema == nil {
+			return 0
+		}
+		internals := schema.Internals()
+		if internals == nil || !checks.HasParallelRefine(internals.Checks) {
+			return 0
+		}
+	}
+	return ctx.Workers
+}
+
+// forEachParallel calls fn for every index in [0, n) on at most workers
+// goroutines. fn must store its result by index so callers can merge results
+// in input order.
+func forEachParallel(n, workers int, fn func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, n) {
+		wg.Go(func() {
+			for i := range jobs {
+				fn(i)
+			}
+		})
+	}
+	for i := range n {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// walkIndexed validates n indexed values with visit, serially or on the
+// parse worker pool, and returns their issues in index order. The walk stops
+// with a single canceled issue once the caller context is done.
+func walkIndexed(
+	ctx *core.ParseContext,
+	input any,
+	n, workers int,
+	visit func(i int) []core.ZodRawIssue,
+) []core.ZodRawIssue {
+	var out []core.ZodRawIssue
+	if workers < 2 {
+		for i := range n {
+			if issue, canceled := canceledIssue(ctx, input); canceled {
+				return append(out, issue)
+			}
+			out = append(out, visit(i)...)
+		}
+		return out
+	}
+
+	results := make([][]core.ZodRawIssue, n)
+	visited := make([]bool, n)
+	forEachParallel(n, workers, func(i int) {
+		if ctx.ContextErr() != nil {
+			return
+		}
+		results[i] = visit(i)
+		visited[i] = true
+	})
+	for i := range n {
+		if !visited[i] {
+			issue, _ := canceledIssue(ctx, input)
+			return append(out, issue)
+		}
+		out = append(out, results[i]...)
+	}
+	return out
+}
+
+// fieldResult is a field parse computed ahead of the serial field walk.
+type fieldResult struct {
+	value any
+	err   error
+}
+
+// prevalidateFields parses shape fields on the parse worker pool ahead of the
+// serial field walk. Only fields whose schema opted in with RefineParallel are
+// fanned out; it returns nil when no field qualifies.
+func prevalidateFields(
+	ctx *core.ParseContext,
+	shape map[string]core.ZodSchema,
+	value func(name string) (any, bool),
+	parse func(v any, schema core.ZodSchema) (any, error),
+) map[string]fieldResult {
+	if ctx == nil || ctx.Workers < 2 {
+		return nil
+	}
+
+	type job struct {
+		name   string
+		value  any
+		schema core.ZodSchema
+	}
+	var jobs []job
+	for _, name := range slices.Sorted(maps.Keys(shape)) {
+		schema := shape[name]
+		if parallelWorkers(ctx, schema) == 0 {
+			continue
+		}
+		if v, ok := value(name); ok {
+			jobs = append(jobs, job{name: name, value: v, schema: schema})
+		}
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	results := make([]fieldResult, len(jobs))
+	forEachParallel(len(jobs), ctx.Workers, func(i int) {
+		if ctx.ContextErr() != nil {
+			return
+		}
+		v, err := parse(jobs[i].value, jobs[i].schema)
+		results[i] = fieldResult{value: v, err: err}
+	})
+
+	byName := make(map[string]fieldResult, len(jobs))
+	for i, j := range jobs {
+		byName[j.name] = results[i]
+	}
+	return byName
+}
+
+// shapeFields iterates shape, in sorted field order when ordered is set so
+// issues from a parallel walk are reported deterministically.
+func shapeFields(shape map[string]core.ZodSchema, ordered bool) iter.Seq2[string, core.ZodSchema] {
+	if !ordered {
+		return maps.All(shape)
+	}
+	return func(yield func(string, core.ZodSchema) bool) {
+		for _, name := range slices.Sorted(maps.Keys(shape)) {
+			if !yield(name, shape[name]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package types_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+// rendezvous reports whether two callbacks were in flight at the same time.
+type rendezvous struct {
+	calls   atomic.Int32
+	arrived chan struct{}
+	met     atomic.Bool
+}
+
+func newRendezvous() *rendezvous {
+	return &rendezvous{arrived: make(chan struct{})}
+}
+
+// wait blocks the first caller until a second one arrives or a timeout passes.
+func (r *rendezvous) wait() {
+	switch r.calls.Add(1) {
+	case 1:
+		select {
+		case <-r.arrived:
+		case <-time.After(time.Second):
+		}
+	case 2:
+		r.met.Store(true)
+		close(r.arrived)
+	}
+}
+
+func TestRefineContext_ReceivesCallerContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "allowed")
+
+	schema := String().RefineContext(func(ctx context.Context, s string) bool {
+		return ctx.Value(ctxKey{}) == s
+	}, "not allowed")
+
+	_, err := schema.ParseWithContext(ctx, "allowed")
+	require.NoError(t, err)
+
+	_, err = schema.ParseWithContext(ctx, "other")
+	require.Error(t, err)
+
+	_, err = schema.Parse("allowed")
+	require.Error(t, err, "plain Parse passes context.Background")
+}
+
+func TestRefineParallel_Slice(t *testing.T) {
+	t.Run("fans out elements and keeps index order", func(t *testing.T) {
+		meet := newRendezvous()
+		schema := Slice[string](String().RefineParallel(func(_ context.Context, s string) bool {
+			meet.wait()
+			return !strings.HasPrefix(s, "bad")
+		}))
+
+		input := []string{"ok", "bad1", "ok", "bad3", "ok", "bad5"}
+		_, err := schema.Parse(input, &core.ParseContext{Workers: 4})
+		require.Error(t, err)
+		assert.True(t, meet.met.Load())
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 3)
+		for i, want := range []int{1, 3, 5} {
+			assert.Equal(t, []any{want}, zodErr.Issues[i].Path)
+		}
+	})
+
+	t.Run("runs serially without workers", func(t *testing.T) {
+		var inFlight, peak atomic.Int32
+		schema := Slice[string](String().RefineParallel(func(context.Context, string) bool {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			if n > peak.Load() {
+				peak.Store(n)
+			}
+			time.Sleep(time.Millisecond)
+			return true
+		}))
+
+		_, err := schema.Parse([]string{"a", "b", "c", "d"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), peak.Load())
+	})
+
+	t.Run("plain refinements never fan out", func(t *testing.T) {
+		calls := 0
+		schema := Slice[string](String().Refine(func(string) bool {
+			calls++
+			return true
+		}))
+
+		_, err := schema.Parse([]string{"a", "b", "c"}, &core.ParseContext{Workers: 4})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("stops on cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		schema := Slice[string](String().RefineParallel(func(context.Context, string) bool { return true }))
+		_, err := schema.ParseWithContext(ctx, []string{"a", "b"}, &core.ParseContext{Workers: 2})
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+	})
+}
+
+func TestRefineParallel_StructFields(t *testing.T) {
+	type Signup struct {
+		Username string `json:"username"`
+		Email    string `json:"email"`
+		Country  string `json:"country"`
+	}
+
+	meet := newRendezvous()
+	taken := func(_ context.Context, s string) bool {
+		meet.wait()
+		return s != "taken"
+	}
+	schema := Struct[Signup](core.StructSchema{
+		"username": String().RefineParallel(taken, "username taken"),
+		"email":    String().RefineParallel(taken, "email taken"),
+		"country":  String().Min(2),
+	})
+
+	for range 5 {
+		meet = newRendezvous()
+		_, err := schema.Parse(Signup{Username: "taken", Email: "taken", Country: "x"}, &core.ParseContext{Workers: 2})
+		require.Error(t, err)
+		assert.True(t, meet.met.Load())
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 3)
+		assert.Equal(t, []any{"country"}, zodErr.Issues[0].Path)
+		assert.Equal(t, []any{"email"}, zodErr.Issues[1].Path)
+		assert.Equal(t, "email taken", zodErr.Issues[1].Message)
+		assert.Equal(t, []any{"username"}, zodErr.Issues[2].Path)
+	}
+}
+
+func TestRefineParallel_ObjectFields(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"b": Int().RefineParallel(func(_ context.Context, v int) bool { return v > 0 }),
+		"a": Int().RefineParallel(func(_ context.Context, v int) bool { return v > 0 }),
+	})
+
+	_, err := schema.Parse(map[string]any{"a": -1, "b": -1}, &core.ParseContext{Workers: 2})
+	require.Error(t, err)
+
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 2)
+	assert.Equal(t, []any{"a"}, zodErr.Issues[0].Path)
+	assert.Equal(t, []any{"b"}, zodErr.Issues[1].Path)
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+func TestReadonly_DetachesResultFromInput(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		input := []string{"a", "b"}
+		got, err := Slice[string](String()).Readonly().Parse(input)
+		require.NoError(t, err)
+
+		got[0] = "changed"
+		assert.Equal(t, []string{"a", "b"}, input)
+	})
+
+	t.Run("object nested values", func(t *testing.T) {
+		input := map[string]any{"tags": []any{"x"}}
+		schema := Object(core.ObjectSchema{"tags": Slice[any](Any())}).Readonly()
+
+		got, err := schema.Parse(input)
+		require.NoError(t, err)
+
+		got["extra"] = true
+		got["tags"].([]any)[0] = "changed"
+		assert.Equal(t, map[string]any{"tags": []any{"x"}}, input)
+	})
+
+	t.Run("strict parse", func(t *testing.T) {
+		input := map[string]any{"a": 1}
+		got, err := Record(String(), Int()).Readonly().StrictParse(input)
+		require.NoError(t, err)
+
+		got["a"] = 2
+		assert.Equal(t, 1, input["a"])
+	})
+
+	t.Run("struct slice field", func(t *testing.T) {
+		type Post struct {
+			Tags []string `json:"tags"`
+		}
+		input := Post{Tags: []string{"go"}}
+		got, err := Struct[Post]().Readonly().Parse(input)
+		require.NoError(t, err)
+
+		got.Tags[0] = "changed"
+		assert.Equal(t, []string{"go"}, input.Tags)
+	})
+}
+
+func TestReadonly_RecordsModifier(t *testing.T) {
+	schema := Slice[string](String()).Optional().Readonly()
+
+	kinds := make([]core.ZodModifierKind, 0, 2)
+	for _, modifier := range schema.Internals().Modifiers {
+		kinds = append(kinds, modifier.Kind)
+	}
+
+	assert.Equal(t, []core.ZodModifierKind{core.ZodModifierOptional, core.ZodModifierReadonly}, kinds)
+	assert.True(t, schema.IsOptional())
+	assert.True(t, schema.Internals().IsReadonly())
+
+	got, err := schema.Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"reflect"
 	"slices"
@@ -81,6 +83,22 @@ func (z *ZodRecord[T, R]) IsLoose() bool {
 	return z.internals.Loose
 }
 
+// evaluate reports the keys of value the record evaluates: the keys matching
+// the key schema of a loose record, and every key otherwise.
+func (z *ZodRecord[T, R]) evaluate(value any, _ *core.ParseContext) evaluation {
+	if !z.internals.Loose || z.internals.KeyType == nil {
+		return evaluation{allKeys: true}
+	}
+	var e evaluation
+	keys, _ := objectKeys(value)
+	for _, key := range keys {
+		if _, err := z.parseKeyWithSchema(key); err == nil {
+			e.addKey(key)
+		}
+	}
+	return e
+}
+
 // Parse validates input using unified ParseComplex API.
 func (z *ZodRecord[T, R]) Parse(input any, ctx ...*core.ParseContext) (R, error) {
 	var zero R
@@ -161,6 +179,18 @@ func (z *ZodRecord[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, e
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodRecord[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodRecord[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional creates an optional record schema that returns a pointer constraint.
 func (z *ZodRecord[T, R]) Optional() *ZodRecord[T, *T] {
 	in := z.internals.Clone()
@@ -253,6 +283,32 @@ func (z *ZodRecord[T, R]) PrefaultFunc(fn func() T) *ZodRecord[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodRecord[T, R]) Catch(v T) *ZodRecord[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodRecord[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodRecord[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodRecord[T, R]) Readonly() *ZodRecord[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta stores metadata for this record schema.
 func (z *ZodRecord[T, R]) Meta(meta core.GlobalMeta) *ZodRecord[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -310,13 +366,30 @@ func (z *ZodRecord[T, R]) Overwrite(transform func(R) R, params ...any) *ZodReco
 
 // Refine applies type-safe validation with constraint type R.
 func (z *ZodRecord[T, R]) Refine(fn func(R) bool, params ...any) *ZodRecord[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodRecord[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodRecord[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodRecord[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodRecord[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodRecord[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodRecord[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if val, ok := convertToRecordConstraintValue[T, R](v); ok {
-			return fn(val)
+			return fn(ctx, val)
 		}
 		return false
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny provides flexible validation without type conversion.
@@ -609,11 +682,12 @@ func (z *ZodRecord[T, R]) extractRecord(value any) (map[string]any, error) {
 		if converted, err := mapx.ToGeneric(value); err == nil && converted != nil {
 			result := make(map[string]any, len(converted))
 			for k, v := range converted {
-				strKey, ok := k.(string)
-				if !ok {
+				key := reflect.ValueOf(k)
+				// Keys of a defined string type, such as an enum, are strings.
+				if key.Kind() != reflect.String {
 					return nil, ErrNonStringKeyInMap
 				}
-				result[strKey] = v
+				result[key.String()] = v
 			}
 			return result, nil
 		}
@@ -716,7 +790,13 @@ func (z *ZodRecord[T, R]) validateRecord(value map[string]any, checks []core.Zod
 
 	// --- Value Validation ---
 	if z.internals.ValueType != nil {
-		for key, val := range value {
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			val := value[key]
+			if issue, canceled := canceledIssue(ctx, value); canceled {
+				rawIssues = append(rawIssues, issue)
+				break
+			}
+
 			// In loose mode, only validate values for keys that match the key schema.
 			if z.internals.Loose && z.internals.KeyType != nil {
 				if _, keyErr := z.parseKeyWithSchema(key); keyErr != nil {
@@ -736,7 +816,13 @@ func (z *ZodRecord[T, R]) validateRecord(value map[string]any, checks []core.Zod
 
 			// Use generic validateValue helper to leverage existing reflection logic.
 			if err := z.validateValue(val, z.internals.ValueType, ctx, key); err != nil {
-				return nil, err
+				zodErr, ok := errors.AsType[*issues.ZodError](err)
+				if !ok {
+					return nil, err
+				}
+				for _, issue := range zodErr.Issues {
+					rawIssues = append(rawIssues, issues.ConvertZodIssueToRawWithPrependedPath(issue, []any{key}))
+				}
 			}
 		}
 	}
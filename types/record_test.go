@@ -777,8 +777,22 @@ func TestRecord_ErrorHandling(t *testing.T) {
 
 		invalidRecord := map[string]any{"key": struct{}{}}
 		_, err := recordSchema.Parse(invalidRecord)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "value validation failed")
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.InvalidType, zodErr.Issues[0].Code)
+		assert.Equal(t, []any{"key"}, zodErr.Issues[0].Path)
+	})
+
+	t.Run("value issues are reported at each key", func(t *testing.T) {
+		recordSchema := Record(String(), String().Min(2))
+
+		_, err := recordSchema.Parse(map[string]any{"b": "x", "a": "y", "c": "ok"})
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 2)
+		assert.Equal(t, []any{"a"}, zodErr.Issues[0].Path)
+		assert.Equal(t, []any{"b"}, zodErr.Issues[1].Path)
 	})
 
 	t.Run("custom error message", func(t *testing.T) {
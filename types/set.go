@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -134,6 +135,18 @@ func (z *ZodSet[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodSet[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodSet[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // ValueType returns the value schema for this set.
 func (z *ZodSet[T, R]) ValueType() any {
 	return z.internals.ValueType
@@ -205,6 +218,30 @@ func (z *ZodSet[T, R]) PrefaultFunc(fn func() map[T]struct{}) *ZodSet[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodSet[T, R]) Catch(v map[T]struct{}) *ZodSet[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodSet[T, R]) CatchFunc(fn func(core.CatchContext) map[T]struct{}) *ZodSet[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodSet[T, R]) Readonly() *ZodSet[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta stores metadata for this set schema.
 func (z *ZodSet[T, R]) Meta(meta core.GlobalMeta) *ZodSet[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -267,10 +304,27 @@ func (z *ZodSet[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds a typed custom validation function.
 func (z *ZodSet[T, R]) Refine(fn func(R) bool, params ...any) *ZodSet[T, R] {
-	wrap := func(v any) bool {
-		return fn(convertToSetConstraintType[T, R](v))
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodSet[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodSet[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodSet[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodSet[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodSet[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodSet[T, R] {
+	wrap := func(ctx context.Context, v any) bool {
+		return fn(ctx, convertToSetConstraintType[T, R](v))
 	}
-	return z.withCheck(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrap, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a custom validation function accepting any input.
@@ -404,6 +458,10 @@ func (z *ZodSet[T, R]) validateForEngine(value map[T]struct{}, chks []core.ZodCh
 	var collected []core.ZodRawIssue
 	if z.internals.ValueType != nil {
 		for elem := range value {
+			if issue, canceled := canceledIssue(ctx, value); canceled {
+				collected = append(collected, issue)
+				break
+			}
 			collected = z.collectErrors(elem, z.internals.ValueType, elem, ctx, collected)
 		}
 	}
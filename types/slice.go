@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -121,6 +122,18 @@ func (z *ZodSlice[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodSlice[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodSlice[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil values with pointer constraint.
 func (z *ZodSlice[T, R]) Optional() *ZodSlice[T, *[]T] {
 	in := z.internals.Clone()
@@ -194,6 +207,30 @@ func (z *ZodSlice[T, R]) PrefaultFunc(fn func() []T) *ZodSlice[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodSlice[T, R]) Catch(v []T) *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodSlice[T, R]) CatchFunc(fn func(core.CatchContext) []T) *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodSlice[T, R]) Readonly() *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodSlice[T, R]) Meta(meta core.GlobalMeta) *ZodSlice[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -232,6 +269,35 @@ func (z *ZodSlice[T, R]) NonEmpty(params ...any) *ZodSlice[T, R] {
 	return z.Min(1, params...)
 }
 
+// Contains requires between minimum and maximum elements to match schema,
+// like JSON Schema contains/minContains/maxContains. A negative maximum
+// leaves the count unbounded. Failures report the matching element indices
+// in the issue's Params["matched"].
+func (z *ZodSlice[T, R]) Contains(schema any, minimum, maximum int, params ...any) *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Contains(containsSchema(schema), minimum, maximum, params...))
+	return z.withInternals(in)
+}
+
+// Unique requires all elements to be distinct, like JSON Schema
+// uniqueItems. Each duplicate is reported at its index, with the index of the
+// first occurrence in the issue's Params["firstIndex"].
+func (z *ZodSlice[T, R]) Unique(params ...any) *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Unique(nil, params...))
+	return z.withInternals(in)
+}
+
+// UniqueBy is like Unique but compares elements by the value key returns.
+func (z *ZodSlice[T, R]) UniqueBy(key func(T) any, params ...any) *ZodSlice[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.Unique(func(v any) any {
+		element, _ := v.(T)
+		return key(element)
+	}, params...))
+	return z.withInternals(in)
+}
+
 // Element returns the element schema.
 func (z *ZodSlice[T, R]) Element() core.ZodSchema {
 	if schema, ok := z.internals.Element.(core.ZodSchema); ok {
@@ -240,6 +306,11 @@ func (z *ZodSlice[T, R]) Element() core.ZodSchema {
 	return nil
 }
 
+// evaluate reports that a slice evaluates every item.
+func (z *ZodSlice[T, R]) evaluate(any, *core.ParseContext) evaluation {
+	return evaluation{allItems: true}
+}
+
 // Transform applies a transformation function to the parsed slice value.
 func (z *ZodSlice[T, R]) Transform(
 	fn func(R, *core.RefinementContext) (any, error),
@@ -274,11 +345,28 @@ func (z *ZodSlice[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds type-safe custom validation.
 func (z *ZodSlice[T, R]) Refine(fn func(R) bool, params ...any) *ZodSlice[T, R] {
-	wrapper := func(v any) bool {
-		return fn(toSliceConstraint[T, R](v))
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodSlice[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodSlice[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodSlice[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodSlice[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodSlice[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodSlice[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
+		return fn(ctx, toSliceConstraint[T, R](v))
 	}
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	return z.withInternals(in)
 }
 
@@ -456,19 +544,24 @@ func (z *ZodSlice[T, R]) validateForEngine(
 	}
 
 	if schema, ok := z.internals.Element.(core.ZodSchema); ok && schema != nil {
-		for i, elem := range validated {
-			if err := validateElement(elem, schema); err != nil {
-				if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
-					for _, issue := range zodErr.Issues {
-						errs = append(errs, issues.ConvertZodIssueToRawWithProperties(issue, []any{i}))
-					}
-				} else {
-					raw := issues.CreateIssue(core.Custom, err.Error(), nil, elem)
-					raw.Path = []any{i}
-					errs = append(errs, raw)
+		workers := parallelWorkers(ctx, schema)
+		errs = append(errs, walkIndexed(ctx, validated, len(validated), workers, func(i int) []core.ZodRawIssue {
+			elem := validated[i]
+			err := validateElement(elem, schema, ctx)
+			if err == nil {
+				return nil
+			}
+			if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
+				elemIssues := make([]core.ZodRawIssue, 0, len(zodErr.Issues))
+				for _, issue := range zodErr.Issues {
+					elemIssues = append(elemIssues, issues.ConvertZodIssueToRawWithProperties(issue, []any{i}))
 				}
+				return elemIssues
 			}
-		}
+			raw := issues.CreateIssue(core.Custom, err.Error(), nil, elem)
+			raw.Path = []any{i}
+			return []core.ZodRawIssue{raw}
+		})...)
 	}
 
 	if len(errs) > 0 {
@@ -478,6 +571,15 @@ func (z *ZodSlice[T, R]) validateForEngine(
 	return validated, nil
 }
 
+// containsSchema converts the schema passed to Contains on slices and arrays.
+func containsSchema(schema any) core.ZodSchema {
+	zodSchema, err := core.ConvertToZodSchema(schema)
+	if err != nil {
+		panic(fmt.Sprintf("Contains: %v", err))
+	}
+	return zodSchema
+}
+
 // toSliceType converts any value to the slice constraint type R.
 func toSliceType[T any, R any](v any) (R, bool) {
 	var zero R
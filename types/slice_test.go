@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -956,3 +957,145 @@ func TestSlice_PreservesNestedIssueDetails(t *testing.T) {
 	assert.Equal(t, []any{0}, issue.Path)
 	assert.Equal(t, "email", issue.Format)
 }
+
+func TestSlice_ParseWithContext(t *testing.T) {
+	t.Run("stops walking once the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		seen := 0
+		schema := Slice[string](String().Refine(func(string) bool {
+			seen++
+			if seen == 3 {
+				cancel()
+			}
+			return true
+		}))
+
+		input := make([]string, 1000)
+		for i := range input {
+			input[i] = "x"
+		}
+
+		_, err := schema.ParseWithContext(ctx, input)
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+		assert.Equal(t, 3, seen)
+	})
+
+	t.Run("live context parses normally", func(t *testing.T) {
+		schema := Slice[string](String().Min(1))
+
+		result, err := schema.ParseWithContext(context.Background(), []string{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, result)
+
+		anyResult, err := schema.ParseAnyContext(context.Background(), []string{"a"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, anyResult)
+	})
+
+	t.Run("element transforms observe the caller context", func(t *testing.T) {
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+
+		var seen []any
+		schema := Slice[any](String().Transform(func(s string, rc *core.RefinementContext) (any, error) {
+			seen = append(seen, rc.ContextOrBackground().Value(ctxKey{}))
+			return s, nil
+		}))
+
+		_, err := schema.ParseWithContext(ctx, []any{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{"request", "request"}, seen)
+	})
+}
+
+func TestSlice_Contains(t *testing.T) {
+	admin := Object(core.ObjectSchema{"role": Literal("admin")}).Passthrough()
+	schema := Slice[any](Any()).Contains(admin, 1, -1)
+
+	users := []any{
+		map[string]any{"role": "viewer"},
+		map[string]any{"role": "admin"},
+	}
+	result, err := schema.Parse(users)
+	require.NoError(t, err)
+	assert.Equal(t, users, result)
+
+	_, err = schema.Parse([]any{map[string]any{"role": "viewer"}})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+	assert.Equal(t, "contains", zodErr.Issues[0].Origin)
+	assert.Equal(t, []int{}, zodErr.Issues[0].Params["matched"])
+	assert.Equal(t, "Too small: expected array to contain at least 1 matching items", zodErr.Issues[0].Message)
+
+	bounded := Slice[int](Int()).Contains(Int().Positive(), 1, 2)
+	_, err = bounded.Parse([]int{-1, 2, 0, 3})
+	require.NoError(t, err)
+
+	_, err = bounded.Parse([]int{1, -2, 3, 4})
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooBig, zodErr.Issues[0].Code)
+	assert.Equal(t, []int{0, 2, 3}, zodErr.Issues[0].Params["matched"])
+	assert.Equal(t, "Too big: expected array to contain at most 2 matching items", zodErr.Issues[0].Message)
+
+	assert.Panics(t, func() { Slice[int](Int()).Contains(nil, 1, -1) })
+}
+
+func TestSlice_Unique(t *testing.T) {
+	schema := Slice[string](String()).Unique()
+
+	result, err := schema.Parse([]string{"a", "b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, result)
+
+	_, err = schema.Parse([]string{"a", "b", "a", "b"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 2)
+	assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+	assert.Equal(t, "Duplicate element at index 2 (first seen at index 0)", zodErr.Issues[0].Message)
+	assert.Equal(t, []any{3}, zodErr.Issues[1].Path)
+	assert.Equal(t, 1, zodErr.Issues[1].Params["firstIndex"])
+
+	nested := Slice[any](Any()).Unique()
+	_, err = nested.Parse([]any{[]any{1}, map[string]any{"a": 1}, []any{1}})
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+}
+
+func TestSlice_UniqueBy(t *testing.T) {
+	type user struct {
+		Email string
+		Name  string
+	}
+	schema := Slice[user](Any()).UniqueBy(func(u user) any { return u.Email })
+
+	_, err := schema.Parse([]user{{Email: "a@x.io", Name: "A"}, {Email: "b@x.io", Name: "A"}})
+	require.NoError(t, err)
+
+	_, err = schema.Parse([]user{{Email: "a@x.io", Name: "A"}, {Email: "a@x.io", Name: "B"}})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{1}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+
+	nested := Object(core.ObjectSchema{"users": schema})
+	_, err = nested.Parse(map[string]any{"users": []user{{Email: "a@x.io"}, {Email: "a@x.io"}}})
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"users", 1}, zodErr.Issues[0].Path)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -154,6 +155,18 @@ func (z *ZodString[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodString[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodString[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a new schema that accepts nil, with *string output.
 func (z *ZodString[T]) Optional() *ZodString[*string] {
 	in := z.internals.Clone()
@@ -216,6 +229,24 @@ func (z *ZodString[T]) PrefaultFunc(fn func() string) *ZodString[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodString[T]) Catch(v string) *ZodString[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodString[T]) CatchFunc(fn func(core.CatchContext) string) *ZodString[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodString[T]) Meta(meta core.GlobalMeta) *ZodString[T] {
 	return z.withMeta(meta)
@@ -413,7 +444,24 @@ func (z *ZodString[T]) With(fn func(value T, payload *core.ParsePayload), params
 
 // Refine applies a custom validation function matching the schema's output type T.
 func (z *ZodString[T]) Refine(fn func(T) bool, params ...any) *ZodString[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodString[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodString[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodString[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodString[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodString[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodString[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 		switch any(zero).(type) {
 		case string:
@@ -424,7 +472,7 @@ func (z *ZodString[T]) Refine(fn func(T) bool, params ...any) *ZodString[T] {
 			if !ok {
 				return false
 			}
-			return fn(any(strVal).(T))
+			return fn(ctx, any(strVal).(T))
 
 		case *string:
 			if v == nil {
@@ -434,13 +482,13 @@ func (z *ZodString[T]) Refine(fn func(T) bool, params ...any) *ZodString[T] {
 			if !ok {
 				return false
 			}
-			return fn(any(&strVal).(T))
+			return fn(ctx, any(&strVal).(T))
 
 		default:
 			return false
 		}
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds custom validation that receives the raw value as any.
@@ -34,6 +34,16 @@ func (z *ZodEmail[T]) PrefaultFunc(fn func() string) *ZodEmail[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newEmail[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodEmail[T]) Catch(v string) *ZodEmail[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newEmail[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodEmail[T]) CatchFunc(fn func(core.CatchContext) string) *ZodEmail[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newEmail[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodEmail[T]) Meta(meta core.GlobalMeta) *ZodEmail[T] {
 	return withStringWrapperMeta(z, z.ZodString, newEmail[T], meta)
@@ -69,6 +79,16 @@ func (z *ZodGUID[T]) PrefaultFunc(fn func() string) *ZodGUID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newGUID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodGUID[T]) Catch(v string) *ZodGUID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newGUID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodGUID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodGUID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newGUID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodGUID[T]) Meta(meta core.GlobalMeta) *ZodGUID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newGUID[T], meta)
@@ -104,6 +124,16 @@ func (z *ZodCUID[T]) PrefaultFunc(fn func() string) *ZodCUID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newCUID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodCUID[T]) Catch(v string) *ZodCUID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newCUID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodCUID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodCUID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newCUID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodCUID[T]) Meta(meta core.GlobalMeta) *ZodCUID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newCUID[T], meta)
@@ -139,6 +169,16 @@ func (z *ZodCUID2[T]) PrefaultFunc(fn func() string) *ZodCUID2[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newCUID2[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodCUID2[T]) Catch(v string) *ZodCUID2[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newCUID2[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodCUID2[T]) CatchFunc(fn func(core.CatchContext) string) *ZodCUID2[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newCUID2[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodCUID2[T]) Meta(meta core.GlobalMeta) *ZodCUID2[T] {
 	return withStringWrapperMeta(z, z.ZodString, newCUID2[T], meta)
@@ -174,6 +214,16 @@ func (z *ZodULID[T]) PrefaultFunc(fn func() string) *ZodULID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newULID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodULID[T]) Catch(v string) *ZodULID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newULID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodULID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodULID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newULID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodULID[T]) Meta(meta core.GlobalMeta) *ZodULID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newULID[T], meta)
@@ -209,6 +259,16 @@ func (z *ZodXID[T]) PrefaultFunc(fn func() string) *ZodXID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newXID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodXID[T]) Catch(v string) *ZodXID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newXID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodXID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodXID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newXID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodXID[T]) Meta(meta core.GlobalMeta) *ZodXID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newXID[T], meta)
@@ -244,6 +304,16 @@ func (z *ZodKSUID[T]) PrefaultFunc(fn func() string) *ZodKSUID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newKSUID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodKSUID[T]) Catch(v string) *ZodKSUID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newKSUID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodKSUID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodKSUID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newKSUID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodKSUID[T]) Meta(meta core.GlobalMeta) *ZodKSUID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newKSUID[T], meta)
@@ -279,6 +349,16 @@ func (z *ZodNanoID[T]) PrefaultFunc(fn func() string) *ZodNanoID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newNanoID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodNanoID[T]) Catch(v string) *ZodNanoID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newNanoID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodNanoID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodNanoID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newNanoID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodNanoID[T]) Meta(meta core.GlobalMeta) *ZodNanoID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newNanoID[T], meta)
@@ -314,6 +394,16 @@ func (z *ZodUUID[T]) PrefaultFunc(fn func() string) *ZodUUID[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newUUID[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodUUID[T]) Catch(v string) *ZodUUID[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newUUID[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodUUID[T]) CatchFunc(fn func(core.CatchContext) string) *ZodUUID[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newUUID[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodUUID[T]) Meta(meta core.GlobalMeta) *ZodUUID[T] {
 	return withStringWrapperMeta(z, z.ZodString, newUUID[T], meta)
@@ -349,6 +439,16 @@ func (z *ZodIPv4[T]) PrefaultFunc(fn func() string) *ZodIPv4[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newIPv4[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodIPv4[T]) Catch(v string) *ZodIPv4[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newIPv4[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodIPv4[T]) CatchFunc(fn func(core.CatchContext) string) *ZodIPv4[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newIPv4[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodIPv4[T]) Meta(meta core.GlobalMeta) *ZodIPv4[T] {
 	return withStringWrapperMeta(z, z.ZodString, newIPv4[T], meta)
@@ -384,6 +484,16 @@ func (z *ZodIPv6[T]) PrefaultFunc(fn func() string) *ZodIPv6[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newIPv6[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodIPv6[T]) Catch(v string) *ZodIPv6[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newIPv6[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodIPv6[T]) CatchFunc(fn func(core.CatchContext) string) *ZodIPv6[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newIPv6[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodIPv6[T]) Meta(meta core.GlobalMeta) *ZodIPv6[T] {
 	return withStringWrapperMeta(z, z.ZodString, newIPv6[T], meta)
@@ -419,6 +529,16 @@ func (z *ZodCIDRv4[T]) PrefaultFunc(fn func() string) *ZodCIDRv4[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newCIDRv4[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodCIDRv4[T]) Catch(v string) *ZodCIDRv4[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newCIDRv4[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodCIDRv4[T]) CatchFunc(fn func(core.CatchContext) string) *ZodCIDRv4[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newCIDRv4[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodCIDRv4[T]) Meta(meta core.GlobalMeta) *ZodCIDRv4[T] {
 	return withStringWrapperMeta(z, z.ZodString, newCIDRv4[T], meta)
@@ -454,6 +574,16 @@ func (z *ZodCIDRv6[T]) PrefaultFunc(fn func() string) *ZodCIDRv6[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newCIDRv6[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodCIDRv6[T]) Catch(v string) *ZodCIDRv6[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newCIDRv6[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodCIDRv6[T]) CatchFunc(fn func(core.CatchContext) string) *ZodCIDRv6[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newCIDRv6[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodCIDRv6[T]) Meta(meta core.GlobalMeta) *ZodCIDRv6[T] {
 	return withStringWrapperMeta(z, z.ZodString, newCIDRv6[T], meta)
@@ -489,6 +619,16 @@ func (z *ZodURL[T]) PrefaultFunc(fn func() string) *ZodURL[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newURL[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodURL[T]) Catch(v string) *ZodURL[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newURL[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodURL[T]) CatchFunc(fn func(core.CatchContext) string) *ZodURL[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newURL[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodURL[T]) Meta(meta core.GlobalMeta) *ZodURL[T] {
 	return withStringWrapperMeta(z, z.ZodString, newURL[T], meta)
@@ -524,6 +664,16 @@ func (z *ZodHostname[T]) PrefaultFunc(fn func() string) *ZodHostname[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newHostname[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodHostname[T]) Catch(v string) *ZodHostname[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newHostname[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodHostname[T]) CatchFunc(fn func(core.CatchContext) string) *ZodHostname[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newHostname[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodHostname[T]) Meta(meta core.GlobalMeta) *ZodHostname[T] {
 	return withStringWrapperMeta(z, z.ZodString, newHostname[T], meta)
@@ -559,6 +709,16 @@ func (z *ZodMAC[T]) PrefaultFunc(fn func() string) *ZodMAC[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newMAC[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodMAC[T]) Catch(v string) *ZodMAC[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newMAC[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodMAC[T]) CatchFunc(fn func(core.CatchContext) string) *ZodMAC[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newMAC[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodMAC[T]) Meta(meta core.GlobalMeta) *ZodMAC[T] {
 	return withStringWrapperMeta(z, z.ZodString, newMAC[T], meta)
@@ -594,6 +754,16 @@ func (z *ZodE164[T]) PrefaultFunc(fn func() string) *ZodE164[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newE164[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodE164[T]) Catch(v string) *ZodE164[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newE164[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodE164[T]) CatchFunc(fn func(core.CatchContext) string) *ZodE164[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newE164[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodE164[T]) Meta(meta core.GlobalMeta) *ZodE164[T] {
 	return withStringWrapperMeta(z, z.ZodString, newE164[T], meta)
@@ -644,6 +814,16 @@ func (z *ZodEmoji[T]) PrefaultFunc(fn func() string) *ZodEmoji[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newEmoji[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodEmoji[T]) Catch(v string) *ZodEmoji[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newEmoji[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodEmoji[T]) CatchFunc(fn func(core.CatchContext) string) *ZodEmoji[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newEmoji[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodEmoji[T]) Meta(meta core.GlobalMeta) *ZodEmoji[T] {
 	return withStringWrapperMeta(z, z.ZodString, newEmoji[T], meta)
@@ -679,6 +859,16 @@ func (z *ZodJWT[T]) PrefaultFunc(fn func() string) *ZodJWT[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newJWT[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodJWT[T]) Catch(v string) *ZodJWT[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newJWT[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodJWT[T]) CatchFunc(fn func(core.CatchContext) string) *ZodJWT[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newJWT[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodJWT[T]) Meta(meta core.GlobalMeta) *ZodJWT[T] {
 	return withStringWrapperMeta(z, z.ZodString, newJWT[T], meta)
@@ -714,6 +904,16 @@ func (z *ZodBase64[T]) PrefaultFunc(fn func() string) *ZodBase64[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newBase64[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodBase64[T]) Catch(v string) *ZodBase64[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newBase64[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodBase64[T]) CatchFunc(fn func(core.CatchContext) string) *ZodBase64[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newBase64[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodBase64[T]) Meta(meta core.GlobalMeta) *ZodBase64[T] {
 	return withStringWrapperMeta(z, z.ZodString, newBase64[T], meta)
@@ -749,6 +949,16 @@ func (z *ZodBase64URL[T]) PrefaultFunc(fn func() string) *ZodBase64URL[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newBase64URL[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodBase64URL[T]) Catch(v string) *ZodBase64URL[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newBase64URL[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodBase64URL[T]) CatchFunc(fn func(core.CatchContext) string) *ZodBase64URL[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newBase64URL[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodBase64URL[T]) Meta(meta core.GlobalMeta) *ZodBase64URL[T] {
 	return withStringWrapperMeta(z, z.ZodString, newBase64URL[T], meta)
@@ -784,6 +994,16 @@ func (z *ZodHex[T]) PrefaultFunc(fn func() string) *ZodHex[T] {
 	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newHex[T])
 }
 
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodHex[T]) Catch(v string) *ZodHex[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newHex[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodHex[T]) CatchFunc(fn func(core.CatchContext) string) *ZodHex[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newHex[T])
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodHex[T]) Meta(meta core.GlobalMeta) *ZodHex[T] {
 	return withStringWrapperMeta(z, z.ZodString, newHex[T], meta)
@@ -798,3 +1018,48 @@ func (z *ZodHex[T]) Describe(description string) *ZodHex[T] {
 func (z *ZodHex[T]) RefineAny(fn func(any) bool, params ...any) *ZodHex[T] {
 	return wrapStringFluent(z, z.ZodString.RefineAny(fn, params...), newHex[T])
 }
+
+// Default sets a fallback value returned when input is nil.
+func (z *ZodTemplateLiteral[T]) Default(v string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.Default(v), newTemplateLiteral[T])
+}
+
+// DefaultFunc sets a fallback function called when input is nil.
+func (z *ZodTemplateLiteral[T]) DefaultFunc(fn func() string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.DefaultFunc(fn), newTemplateLiteral[T])
+}
+
+// Prefault sets a fallback value that goes through the full validation pipeline.
+func (z *ZodTemplateLiteral[T]) Prefault(v string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.Prefault(v), newTemplateLiteral[T])
+}
+
+// PrefaultFunc sets a fallback function that goes through the full validation pipeline.
+func (z *ZodTemplateLiteral[T]) PrefaultFunc(fn func() string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.PrefaultFunc(fn), newTemplateLiteral[T])
+}
+
+// Catch returns v in place of the parse result when validation fails.
+func (z *ZodTemplateLiteral[T]) Catch(v string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.Catch(v), newTemplateLiteral[T])
+}
+
+// CatchFunc calls fn when validation fails and returns its result instead.
+func (z *ZodTemplateLiteral[T]) CatchFunc(fn func(core.CatchContext) string) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.CatchFunc(fn), newTemplateLiteral[T])
+}
+
+// Meta returns a schema with merged metadata.
+func (z *ZodTemplateLiteral[T]) Meta(meta core.GlobalMeta) *ZodTemplateLiteral[T] {
+	return withStringWrapperMeta(z, z.ZodString, newTemplateLiteral[T], meta)
+}
+
+// Describe returns a schema with the description.
+func (z *ZodTemplateLiteral[T]) Describe(description string) *ZodTemplateLiteral[T] {
+	return z.Meta(core.GlobalMeta{Description: description})
+}
+
+// RefineAny adds custom validation that receives the raw value as any.
+func (z *ZodTemplateLiteral[T]) RefineAny(fn func(any) bool, params ...any) *ZodTemplateLiteral[T] {
+	return wrapStringFluent(z, z.ZodString.RefineAny(fn, params...), newTemplateLiteral[T])
+}
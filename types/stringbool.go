@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"strings"
 
 	"github.com/kaptinlin/gozod/core"
@@ -103,6 +104,18 @@ func (z *ZodStringBool[T]) ParseAny(input any, ctx ...*core.ParseContext) (any,
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodStringBool[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodStringBool[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // StrictParse validates input with compile-time type safety.
 func (z *ZodStringBool[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
 	return engine.ParsePrimitiveStrict[bool, T](
@@ -182,6 +195,22 @@ func (z *ZodStringBool[T]) PrefaultFunc(fn func() string) *ZodStringBool[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodStringBool[T]) Catch(v bool) *ZodStringBool[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodStringBool[T]) CatchFunc(fn func(core.CatchContext) bool) *ZodStringBool[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodStringBool[T]) Meta(meta core.GlobalMeta) *ZodStringBool[T] {
 	clone := z.withInternals(z.internals.Clone())
@@ -196,7 +225,24 @@ func (z *ZodStringBool[T]) Describe(desc string) *ZodStringBool[T] {
 
 // Refine applies a custom validation function matching the schema's output type T.
 func (z *ZodStringBool[T]) Refine(fn func(T) bool, params ...any) *ZodStringBool[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodStringBool[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodStringBool[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodStringBool[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodStringBool[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodStringBool[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodStringBool[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 		switch any(zero).(type) {
 		case bool:
@@ -204,15 +250,15 @@ func (z *ZodStringBool[T]) Refine(fn func(T) bool, params ...any) *ZodStringBool
 				return false
 			}
 			if b, ok := v.(bool); ok {
-				return fn(any(b).(T))
+				return fn(ctx, any(b).(T))
 			}
 			return false
 		case *bool:
 			if v == nil {
-				return fn(any((*bool)(nil)).(T))
+				return fn(ctx, any((*bool)(nil)).(T))
 			}
 			if b, ok := v.(bool); ok {
-				return fn(any(&b).(T))
+				return fn(ctx, any(&b).(T))
 			}
 			return false
 		default:
@@ -226,7 +272,7 @@ func (z *ZodStringBool[T]) Refine(fn func(T) bool, params ...any) *ZodStringBool
 		msg = sp.Error
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
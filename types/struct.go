@@ -1,11 +1,14 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"maps"
 	"math"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/kaptinlin/gozod/core"
@@ -45,7 +48,7 @@ var anyType = reflect.TypeFor[any]()
 
 type structFieldBinding struct {
 	field            reflect.StructField
-	index            int
+	index            []int
 	fieldKey         string
 	skipFieldNameTag bool
 }
@@ -70,26 +73,49 @@ func structFieldBindings(fieldNameTag string, t reflect.Type) []structFieldBindi
 		return nil
 	}
 
-	fieldNameTag = fieldNameTagOrDefault(fieldNameTag)
-	bindings := make([]structFieldBinding, 0, t.NumField())
-	for field := range t.Fields() {
-		if !field.IsExported() {
-			continue
-		}
-
-		fieldKey := tagparser.FieldName(fieldNameTag, field)
+	visible := tagparser.VisibleFields(fieldNameTagOrDefault(fieldNameTag), t)
+	bindings := make([]structFieldBinding, 0, len(visible))
+	for _, field := range visible {
 		bindings = append(bindings, structFieldBinding{
-			field:            field,
-			index:            field.Index[0],
-			fieldKey:         fieldKey.Name,
-			skipFieldNameTag: fieldKey.Skip,
+			field:            field.StructField,
+			index:            field.Index,
+			fieldKey:         field.Key.Name,
+			skipFieldNameTag: field.Key.Skip,
 		})
 	}
 	return bindings
 }
 
-func findStructFieldBinding(fieldNameTag string, t reflect.Type, name string) (structFieldBinding, bool) {
-	for _, binding := range structFieldBindings(fieldNameTag, t) {
+// structFieldValue returns the field of the struct value v at index, or the
+// zero value of the field when a nil embedded pointer hides it.
+func structFieldValue(v reflect.Value, index []int) reflect.Value {
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Zero(v.Type().FieldByIndex(index).Type)
+	}
+	return field
+}
+
+// settableStructField returns the field of the struct value v at index for
+// writing. Embedded pointers on the way are replaced by copies, allocated
+// when nil, so that writes never reach a struct shared with the input.
+func settableStructField(v reflect.Value, index []int) reflect.Value {
+	for i, step := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			detached := reflect.New(v.Type().Elem())
+			if !v.IsNil() {
+				detached.Elem().Set(v.Elem())
+			}
+			v.Set(detached)
+			v = detached.Elem()
+		}
+		v = v.Field(step)
+	}
+	return v
+}
+
+func findStructFieldBinding(bindings []structFieldBinding, name string) (structFieldBinding, bool) {
+	for _, binding := range bindings {
 		if binding.field.Name == name || (!binding.skipFieldNameTag && binding.fieldKey == name) {
 			return binding, true
 		}
@@ -117,6 +143,16 @@ type ZodStructInternals struct {
 	PartialExceptions map[string]bool
 	// FieldNameTag is the struct tag used for field names (default "json").
 	FieldNameTag string
+	// DependentRequired maps a field to the fields required when it is set.
+	DependentRequired map[string][]string
+	// DependentSchemas maps a field to a schema the struct must satisfy when it is set.
+	DependentSchemas map[string]core.ZodSchema
+	// FieldComparisons lists the cross-field comparisons checked after the fields.
+	FieldComparisons []FieldComparison
+	// RequiredIf maps a field to the conditions under which it is required.
+	RequiredIf map[string][]FieldCondition
+	// plan is the field walk precomputed by Compile; derived schemas drop it.
+	plan *structPlan
 }
 
 // ZodStruct represents a type-safe struct validation schema.
@@ -148,6 +184,34 @@ func (z *ZodStruct[T, R]) fieldNameTag() string {
 	return fieldNameTagOrDefault(z.internals.FieldNameTag)
 }
 
+// RequiredDependencies returns the fields required by each set field, named
+// by field key.
+func (z *ZodStruct[T, R]) RequiredDependencies() map[string][]string {
+	return dependentRequiredFieldKeys(z.internals.DependentRequired, z.fieldBindings())
+}
+
+// SchemaDependencies returns the schemas applied when each field is set,
+// keyed by field key.
+func (z *ZodStruct[T, R]) SchemaDependencies() map[string]core.ZodSchema {
+	return dependentSchemaFieldKeys(z.internals.DependentSchemas, z.fieldBindings())
+}
+
+// fieldBindings returns the field bindings of T under the schema's
+// field-name tag.
+func (z *ZodStruct[T, R]) fieldBindings() []structFieldBinding {
+	return structFieldBindings(z.internals.FieldNameTag, reflect.TypeFor[T]())
+}
+
+// FieldComparisons returns the cross-field comparisons of the struct.
+func (z *ZodStruct[T, R]) FieldComparisons() []FieldComparison {
+	return slices.Clone(z.internals.FieldComparisons)
+}
+
+// RequiredIfConditions returns the conditions under which each field is required.
+func (z *ZodStruct[T, R]) RequiredIfConditions() map[string][]FieldCondition {
+	return cloneRequiredIf(z.internals.RequiredIf)
+}
+
 // UnknownKeys returns the unknown keys handling mode.
 func (z *ZodStruct[T, R]) UnknownKeys() ObjectMode {
 	return ObjectModeStrict
@@ -158,6 +222,11 @@ func (z *ZodStruct[T, R]) Catchall() core.ZodSchema {
 	return nil
 }
 
+// evaluate reports every key of value as evaluated, like a strict object.
+func (z *ZodStruct[T, R]) evaluate(any, *core.ParseContext) evaluation {
+	return evaluation{allKeys: true}
+}
+
 // Parse validates input and returns a value of type R.
 func (z *ZodStruct[T, R]) Parse(input any, ctx ...*core.ParseContext) (R, error) {
 	var zero R
@@ -262,6 +331,18 @@ func (z *ZodStruct[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, e
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodStruct[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodStruct[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a schema that accepts nil values with pointer constraint.
 func (z *ZodStruct[T, R]) Optional() *ZodStruct[T, *T] {
 	in := z.internals.Clone()
@@ -292,10 +373,14 @@ func (z *ZodStruct[T, R]) NonOptional() *ZodStruct[T, T] {
 
 	return &ZodStruct[T, T]{
 		internals: &ZodStructInternals{
-			ZodTypeInternals: *in,
-			Def:              z.internals.Def,
-			Shape:            z.internals.Shape,
-			FieldNameTag:     z.internals.FieldNameTag,
+			ZodTypeInternals:  *in,
+			Def:               z.internals.Def,
+			Shape:             z.internals.Shape,
+			FieldNameTag:      z.internals.FieldNameTag,
+			DependentRequired: cloneDependentRequired(z.internals.DependentRequired),
+			DependentSchemas:  maps.Clone(z.internals.DependentSchemas),
+			FieldComparisons:  slices.Clone(z.internals.FieldComparisons),
+			RequiredIf:        cloneRequiredIf(z.internals.RequiredIf),
 		},
 	}
 }
@@ -340,6 +425,32 @@ func (z *ZodStruct[T, R]) PrefaultFunc(fn func() T) *ZodStruct[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodStruct[T, R]) Catch(v T) *ZodStruct[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodStruct[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodStruct[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodStruct[T, R]) Readonly() *ZodStruct[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // Meta stores metadata.
 func (z *ZodStruct[T, R]) Meta(meta core.GlobalMeta) *ZodStruct[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -389,14 +500,31 @@ func (z *ZodStruct[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds a custom validation function.
 func (z *ZodStruct[T, R]) Refine(fn func(R) bool, params ...any) *ZodStruct[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodStruct[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodStruct[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodStruct[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodStruct[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodStruct[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodStruct[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if constraintValue, ok := convertToConstraintValue[T, R](v); ok {
-			return fn(constraintValue)
+			return fn(ctx, constraintValue)
 		}
 		return false
 	}
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, checks.NormalizeCheckParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, checks.NormalizeCheckParams(params...)), parallel))
 	return z.withInternals(in)
 }
 
@@ -428,9 +556,56 @@ func (z *ZodStruct[T, R]) Extend(augmentation core.StructSchema, params ...any)
 
 	extended := newZodStructFromDef[T, R](def)
 	extended.internals.FieldNameTag = z.internals.FieldNameTag
+	extended.internals.DependentRequired = cloneDependentRequired(z.internals.DependentRequired)
+	extended.internals.DependentSchemas = maps.Clone(z.internals.DependentSchemas)
+	extended.internals.FieldComparisons = slices.Clone(z.internals.FieldComparisons)
+	extended.internals.RequiredIf = cloneRequiredIf(z.internals.RequiredIf)
 	return extended
 }
 
+// DependentRequired requires the listed fields whenever the field they are
+// mapped from is set, like JSON Schema dependentRequired. Fields are named by
+// Go field name or field-name tag, and issues and exports use the field key;
+// a field is set when it is not the zero
+// value, or when an Unwrapper reports a value. Each unset field is reported as
+// a missing_required issue at its own path. An entry for a field that already
+// has dependents replaces them.
+func (z *ZodStruct[T, R]) DependentRequired(deps map[string][]string) *ZodStruct[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.DependentRequired = withDependentRequired(clone.internals.DependentRequired, deps)
+	return clone
+}
+
+// DependentSchemas validates the whole struct against a schema whenever the
+// field it is mapped from is set, like JSON Schema dependentSchemas. The
+// schema only validates; its output is discarded. An entry for a field that
+// already has a schema replaces it.
+func (z *ZodStruct[T, R]) DependentSchemas(deps map[string]core.ZodSchema) *ZodStruct[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.DependentSchemas = withDependentSchemas(clone.internals.DependentSchemas, deps)
+	return clone
+}
+
+// CompareFields requires each comparison's field to stand in its relation to
+// the other field, as in "end after start". Fields are named by Go field name
+// or field-name tag. A comparison is checked only when its field is set; a
+// failure is reported as an invalid_field_comparison issue at that field's
+// path.
+func (z *ZodStruct[T, R]) CompareFields(comparisons ...FieldComparison) *ZodStruct[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.FieldComparisons = withFieldComparisons(clone.internals.FieldComparisons, comparisons)
+	return clone
+}
+
+// RequiredIf requires field whenever every condition holds. An unset field is
+// reported as a missing_required issue at its own path. Calling RequiredIf
+// again for the same field replaces its conditions.
+func (z *ZodStruct[T, R]) RequiredIf(field string, conditions ...FieldCondition) *ZodStruct[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.RequiredIf = withRequiredIf(clone.internals.RequiredIf, field, conditions)
+	return clone
+}
+
 // Partial makes all fields optional.
 func (z *ZodStruct[T, R]) Partial(keys ...[]string) *ZodStruct[T, R] {
 	newInternals := z.internals.Clone()
@@ -456,6 +631,10 @@ func (z *ZodStruct[T, R]) Partial(keys ...[]string) *ZodStruct[T, R] {
 		IsPartial:         true,
 		PartialExceptions: partialExceptions,
 		FieldNameTag:      z.internals.FieldNameTag,
+		DependentRequired: cloneDependentRequired(z.internals.DependentRequired),
+		DependentSchemas:  maps.Clone(z.internals.DependentSchemas),
+		FieldComparisons:  slices.Clone(z.internals.FieldComparisons),
+		RequiredIf:        cloneRequiredIf(z.internals.RequiredIf),
 	}}
 }
 
@@ -479,6 +658,10 @@ func (z *ZodStruct[T, R]) Required(fields ...[]string) *ZodStruct[T, R] {
 		IsPartial:         true,              // Keep as partial, but with specific required fields
 		PartialExceptions: partialExceptions, // Fields in this map are required
 		FieldNameTag:      z.internals.FieldNameTag,
+		DependentRequired: cloneDependentRequired(z.internals.DependentRequired),
+		DependentSchemas:  maps.Clone(z.internals.DependentSchemas),
+		FieldComparisons:  slices.Clone(z.internals.FieldComparisons),
+		RequiredIf:        cloneRequiredIf(z.internals.RequiredIf),
 	}}
 }
 
@@ -515,6 +698,10 @@ func (z *ZodStruct[T, R]) newStructInternals(in *core.ZodTypeInternals) *ZodStru
 		IsPartial:         z.internals.IsPartial,
 		PartialExceptions: maps.Clone(z.internals.PartialExceptions),
 		FieldNameTag:      z.internals.FieldNameTag,
+		DependentRequired: cloneDependentRequired(z.internals.DependentRequired),
+		DependentSchemas:  maps.Clone(z.internals.DependentSchemas),
+		FieldComparisons:  slices.Clone(z.internals.FieldComparisons),
+		RequiredIf:        cloneRequiredIf(z.internals.RequiredIf),
 	}
 }
 
@@ -752,7 +939,7 @@ func (z *ZodStruct[T, R]) extractStructPtrForEngine(input any) (*T, bool) {
 func (z *ZodStruct[T, R]) validateStructForEngine(value T, checks []core.ZodCheck, ctx *core.ParseContext) (T, error) {
 	// Apply field defaults and validate struct fields if schema is defined
 	transformedValue := value
-	if len(z.internals.Shape) > 0 {
+	if len(z.internals.Shape) > 0 || z.hasDependencies() {
 		if transformed, err := z.parseStructWithDefaults(any(value), ctx); err != nil {
 			return value, err
 		} else if convertedValue, ok := transformed.(T); ok {
@@ -785,7 +972,7 @@ func (z *ZodStruct[T, R]) validateStructForEngine(value T, checks []core.ZodChec
 
 // parseStructWithDefaults parses struct fields with defaults.
 func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseContext) (any, error) {
-	if len(z.internals.Shape) == 0 {
+	if len(z.internals.Shape) == 0 && !z.hasDependencies() {
 		return input, nil // No field schemas defined, return input as-is
 	}
 
@@ -813,16 +1000,38 @@ func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseCont
 	var collectedIssues []core.ZodRawIssue
 
 	// Process each field defined in the schema
-	for fieldName, fieldSchema := range z.internals.Shape {
+	fields := z.structFields(structType, ctx != nil && ctx.Workers >= 2)
+	pre := prevalidateFields(ctx, z.internals.Shape,
+		func(fieldName string) (any, bool) {
+			i := slices.IndexFunc(fields, func(f shapeField) bool { return f.name == fieldName })
+			if i < 0 || fields[i].get == nil {
+				return nil, false
+			}
+			fieldValue := structFieldValue(val, fields[i].get).Interface()
+			if z.shouldSkipFieldInPartialMode(fieldValue, fieldName) {
+				return nil, false
+			}
+			return fieldValue, true
+		},
+		func(fieldValue any, fieldSchema core.ZodSchema) (any, error) {
+			return z.parseFieldWithSchema(fieldValue, fieldSchema, ctx)
+		},
+	)
+
+	for _, field := range fields {
+		fieldName, fieldSchema := field.name, field.schema
 		if fieldSchema == nil {
 			continue // Skip nil schemas
 		}
+		if issue, canceled := canceledIssue(ctx, input); canceled {
+			collectedIssues = append(collectedIssues, issue)
+			break
+		}
 
 		// Find the struct field by Go field name or field-name tag.
-		fieldValue, found := z.getStructFieldValue(val, structType, fieldName)
-		if !found {
+		if field.get == nil {
 			// Field not found in struct - handle missing required fields
-			if !z.isFieldOptional(fieldSchema, fieldName) {
+			if !field.optional {
 				rawIssue := issues.CreateIssue(core.InvalidType, fmt.Sprintf("Missing required struct field: %s", fieldName), map[string]any{
 					"expected": "nonoptional",
 					"received": "undefined",
@@ -832,14 +1041,21 @@ func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseCont
 			}
 			continue
 		}
+		fieldValue := structFieldValue(val, field.get).Interface()
 
 		// Check if this field should be skipped in partial mode
-		if z.shouldSkipFieldInPartialMode(fieldValue.Interface(), fieldName) {
+		if z.shouldSkipFieldInPartialMode(fieldValue, fieldName) {
 			continue
 		}
 
 		// Parse the field value with its schema (this applies defaults and transformations)
-		parsedFieldValue, err := z.parseFieldWithSchema(fieldValue.Interface(), fieldSchema, ctx)
+		var parsedFieldValue any
+		var err error
+		if r, ok := pre[fieldName]; ok {
+			parsedFieldValue, err = r.value, r.err
+		} else {
+			parsedFieldValue, err = z.parseFieldWithSchema(fieldValue, fieldSchema, ctx)
+		}
 		if err != nil {
 			// Collect field validation errors with path prefix
 			if zodErr, ok := errors.AsType[*issues.ZodError](err); ok {
@@ -848,13 +1064,13 @@ func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseCont
 					collectedIssues = append(collectedIssues, rawIssue)
 				}
 			} else {
-				rawIssue := issues.CreateIssue(core.Custom, err.Error(), nil, fieldValue.Interface())
+				rawIssue := issues.CreateIssue(core.Custom, err.Error(), nil, fieldValue)
 				rawIssue.Path = []any{fieldName}
 				collectedIssues = append(collectedIssues, rawIssue)
 			}
 		} else {
 			// Set the parsed (potentially transformed) value back to the new struct
-			if err := z.setStructFieldValue(newStruct, structType, fieldName, parsedFieldValue); err != nil {
+			if err := z.setStructFieldValue(newStruct, field.set, parsedFieldValue); err != nil {
 				// Failed to set field value
 				rawIssue := issues.CreateIssue(core.Custom, fmt.Sprintf("Failed to set field %s: %v", fieldName, err), nil, parsedFieldValue)
 				rawIssue.Path = []any{fieldName}
@@ -863,6 +1079,10 @@ func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseCont
 		}
 	}
 
+	if z.hasDependencies() {
+		collectedIssues = append(collectedIssues, z.dependencyIssues(val, ctx)...)
+	}
+
 	// If we collected any issues, return them as a combined error
 	if len(collectedIssues) > 0 {
 		return nil, issues.CreateArrayValidationIssues(collectedIssues)
@@ -871,6 +1091,44 @@ func (z *ZodStruct[T, R]) parseStructWithDefaults(input any, ctx *core.ParseCont
 	return newStruct.Interface(), nil
 }
 
+func (z *ZodStruct[T, R]) hasDependencies() bool {
+	return len(z.internals.DependentRequired) > 0 || len(z.internals.DependentSchemas) > 0 ||
+		len(z.internals.FieldComparisons) > 0 || len(z.internals.RequiredIf) > 0
+}
+
+// dependencyIssues checks the DependentRequired, DependentSchemas,
+// FieldComparisons and RequiredIf of the struct value val against its fields.
+func (z *ZodStruct[T, R]) dependencyIssues(val reflect.Value, ctx *core.ParseContext) []core.ZodRawIssue {
+	bindings := structFieldBindings(z.internals.FieldNameTag, val.Type())
+	lookup := func(name string) (any, bool) {
+		binding, ok := findStructFieldBinding(bindings, name)
+		if !ok {
+			return nil, false
+		}
+		value := structFieldValue(val, binding.index).Interface()
+		return value, isStructFieldSet(value)
+	}
+	present := func(name string) bool {
+		_, set := lookup(name)
+		return set
+	}
+	raws := dependentRequiredIssues(dependentRequiredFieldKeys(z.internals.DependentRequired, bindings), present)
+	raws = append(raws, dependentSchemaIssues(dependentSchemaFieldKeys(z.internals.DependentSchemas, bindings), present, val.Interface(), ctx)...)
+	raws = append(raws, fieldComparisonIssues(z.internals.FieldComparisons, lookup)...)
+	return append(raws, requiredIfIssues(z.internals.RequiredIf, lookup)...)
+}
+
+// isStructFieldSet reports whether a struct field value counts as present:
+// an Unwrapper reports whether it holds a value, and any other value is set
+// when it is not the zero value.
+func isStructFieldSet(value any) bool {
+	if unwrapper, ok := value.(core.Unwrapper); ok {
+		_, set := unwrapper.Unwrap()
+		return set
+	}
+	return !isZeroValue(value)
+}
+
 // parseFieldWithSchema parses a field value.
 func (z *ZodStruct[T, R]) parseFieldWithSchema(fieldValue any, fieldSchema any, ctx *core.ParseContext) (any, error) {
 	if fieldSchema == nil {
@@ -891,30 +1149,37 @@ func (z *ZodStruct[T, R]) parseFieldWithSchema(fieldValue any, fieldSchema any,
 		wasUnwrapped = true
 	}
 
-	// Use reflection to call Parse method - this handles all schema types
-	schemaVal := reflect.ValueOf(fieldSchema)
-	parseMethod := schemaVal.MethodByName("Parse")
-
-	if !parseMethod.IsValid() {
-		return fieldValue, nil // Schema doesn't have Parse method, return original value
-	}
-
-	// Call Parse(fieldValue, ctx)
-	args := []reflect.Value{
-		reflect.ValueOf(fieldValue),
-		reflect.ValueOf(ctx),
-	}
+	// Schemas parse through ParseAny; other values fall back to a reflective
+	// Parse call.
+	var result any
+	if schema, ok := fieldSchema.(core.ZodSchema); ok {
+		parsed, err := schema.ParseAny(fieldValue, ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = parsed
+	} else {
+		parseMethod := reflect.ValueOf(fieldSchema).MethodByName("Parse")
+		if !parseMethod.IsValid() {
+			return fieldValue, nil // Schema doesn't have Parse method, return original value
+		}
 
-	results := parseMethod.Call(args)
-	if len(results) != 2 {
-		return fieldValue, nil // Unexpected return signature
-	}
+		// Call Parse(fieldValue, ctx)
+		results := parseMethod.Call([]reflect.Value{
+			reflect.ValueOf(fieldValue),
+			reflect.ValueOf(ctx),
+		})
+		if len(results) != 2 {
+			return fieldValue, nil // Unexpected return signature
+		}
 
-	// Check for error (second return value)
-	if !results[1].IsNil() {
-		if err, ok := results[1].Interface().(error); ok {
-			return nil, err
+		// Check for error (second return value)
+		if !results[1].IsNil() {
+			if err, ok := results[1].Interface().(error); ok {
+				return nil, err
+			}
 		}
+		result = results[0].Interface()
 	}
 
 	// If we unwrapped the value, return the original wrapper type
@@ -922,29 +1187,36 @@ func (z *ZodStruct[T, R]) parseFieldWithSchema(fieldValue any, fieldSchema any,
 		return originalFieldValue, nil
 	}
 
-	// Return the parsed value (first return value)
-	return results[0].Interface(), nil
+	return result, nil
 }
 
-// setStructFieldValue sets a field value.
-func (z *ZodStruct[T, R]) setStructFieldValue(structVal reflect.Value, structType reflect.Type, fieldName string, value any) error {
-	fieldVal := structVal.FieldByName(fieldName)
-	if fieldVal.IsValid() && fieldVal.CanSet() {
-		return z.setReflectFieldValue(fieldVal, value)
-	}
-
-	binding, found := findStructFieldBinding(z.internals.FieldNameTag, structType, fieldName)
-	if !found {
+// setStructFieldValue sets the field at index, which is nil when the
+// struct has no settable field for the shape entry.
+func (z *ZodStruct[T, R]) setStructFieldValue(structVal reflect.Value, index []int, value any) error {
+	if index == nil {
 		return ErrFieldNotFoundOrNotSettable
 	}
-
-	fieldVal = structVal.Field(binding.index)
+	// A zero value needs no nil embedded pointer allocated to hold it.
+	if _, err := structVal.FieldByIndexErr(index); err != nil && isZeroFieldValue(value) {
+		return nil
+	}
+	fieldVal := settableStructField(structVal, index)
 	if !fieldVal.CanSet() {
 		return ErrFieldNotFoundOrNotSettable
 	}
 	return z.setReflectFieldValue(fieldVal, value)
 }
 
+// isZeroFieldValue reports whether a parsed field value is the zero value,
+// looking through the pointers optional schemas return.
+func isZeroFieldValue(value any) bool {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	return !v.IsValid() || v.IsZero()
+}
+
 // setReflectFieldValue sets a reflect.Value field.
 func (z *ZodStruct[T, R]) setReflectFieldValue(fieldVal reflect.Value, value any) error {
 	if value == nil {
@@ -971,6 +1243,14 @@ func (z *ZodStruct[T, R]) setReflectFieldValue(fieldVal reflect.Value, value any
 		fieldVal.Set(reflect.Zero(fieldVal.Type()))
 		return nil
 	}
+	// A union variant parsed to a struct whose pointer implements the
+	// interface type of the field.
+	if fieldVal.Kind() == reflect.Interface && reflect.PointerTo(valueVal.Type()).Implements(fieldVal.Type()) {
+		ptrVal := reflect.New(valueVal.Type())
+		ptrVal.Elem().Set(valueVal)
+		fieldVal.Set(ptrVal)
+		return nil
+	}
 
 	// Handle map type conversions (e.g., map[any]any to map[string]string)
 	if fieldVal.Type().Kind() == reflect.Map && valueVal.Type().Kind() == reflect.Map {
@@ -1237,7 +1517,7 @@ func (z *ZodStruct[T, R]) convertMapToStruct(sourceValue any, targetType reflect
 		if found && mapValue != nil {
 			// Try direct assignment first
 			mapValueVal := reflect.ValueOf(mapValue)
-			fieldVal := newStruct.Field(binding.index)
+			fieldVal := settableStructField(newStruct, binding.index)
 			if mapValueVal.Type().AssignableTo(binding.field.Type) {
 				fieldVal.Set(mapValueVal)
 			} else {
@@ -1253,19 +1533,6 @@ func (z *ZodStruct[T, R]) convertMapToStruct(sourceValue any, targetType reflect
 	return newStruct.Interface()
 }
 
-// getStructFieldValue gets the value of a struct field by Go field name or field-name tag.
-func (z *ZodStruct[T, R]) getStructFieldValue(val reflect.Value, structType reflect.Type, fieldName string) (reflect.Value, bool) {
-	if field := val.FieldByName(fieldName); field.IsValid() {
-		return field, true
-	}
-
-	binding, found := findStructFieldBinding(z.internals.FieldNameTag, structType, fieldName)
-	if !found {
-		return reflect.Value{}, false
-	}
-	return val.Field(binding.index), true
-}
-
 // shouldSkipFieldInPartialMode checks if a field should be skipped in partial mode
 func (z *ZodStruct[T, R]) shouldSkipFieldInPartialMode(fieldValue any, fieldName string) bool {
 	// Only apply partial logic if we're in partial mode
@@ -1321,8 +1588,8 @@ func isZeroValue(v any) bool {
 	}
 }
 
-// isFieldOptional checks if a field schema is optional using reflection or partial state
-func (z *ZodStruct[T, R]) isFieldOptional(schema any, fieldName string) bool {
+// isFieldOptional checks if a field schema is optional using its modifiers or partial state
+func (z *ZodStruct[T, R]) isFieldOptional(schema core.ZodSchema, fieldName string) bool {
 	if schema == nil {
 		return true
 	}
@@ -1339,21 +1606,12 @@ func (z *ZodStruct[T, R]) isFieldOptional(schema any, fieldName string) bool {
 		}
 	}
 
-	schemaValue := reflect.ValueOf(schema)
-	if !schemaValue.IsValid() || schemaValue.IsNil() {
+	if schemaValue := reflect.ValueOf(schema); schemaValue.Kind() == reflect.Pointer && schemaValue.IsNil() {
 		return true
 	}
-
-	// Try to get internals to check if optional
-	if internalsMethod := schemaValue.MethodByName("Internals"); internalsMethod.IsValid() {
-		results := internalsMethod.Call(nil)
-		if len(results) > 0 {
-			if internals, ok := results[0].Interface().(*core.ZodTypeInternals); ok {
-				return internals.IsOptional()
-			}
-		}
+	if internals := schema.Internals(); internals != nil {
+		return internals.IsOptional()
 	}
-
 	return false
 }
 
@@ -1525,7 +1783,7 @@ func convertMapToStructStrict[T any](fieldNameTag string, data map[string]any) (
 			return zero, false
 		}
 
-		fieldVal := v.Field(binding.index)
+		fieldVal := settableStructField(v, binding.index)
 		switch {
 		case rv.Type().AssignableTo(binding.field.Type):
 			fieldVal.Set(rv)
@@ -1567,7 +1825,34 @@ func validateStructTagGraph(structType reflect.Type, tagName, fieldNameTag strin
 	for structType.Kind() == reflect.Pointer {
 		structType = structType.Elem()
 	}
-	return validateStructTagGraphAt(structType, structType.Name(), tagName, fieldNameTag, make(map[reflect.Type]bool))
+	return validateStructTagGraphAt(structType, structTypeName(structType), tagName, fieldNameTag, make(map[reflect.Type]bool))
+}
+
+// structTypeName returns the name of structType as Go source outside its
+// package spells it, so that a generic instantiation reads Page[model.User]
+// rather than naming the import path of each type argument.
+func structTypeName(structType reflect.Type) string {
+	name := structType.Name()
+	if !strings.Contains(name, "[") {
+		return name
+	}
+	var b strings.Builder
+	start := 0
+	for i := 0; i <= len(name); i++ {
+		if i < len(name) && !strings.ContainsRune("[], *", rune(name[i])) {
+			continue
+		}
+		part := name[start:i]
+		if slash := strings.LastIndexByte(part, '/'); slash >= 0 {
+			part = part[slash+1:]
+		}
+		b.WriteString(part)
+		if i < len(name) {
+			b.WriteByte(name[i])
+		}
+		start = i + 1
+	}
+	return b.String()
 }
 
 func validateStructTagGraphAt(
@@ -1587,6 +1872,18 @@ func validateStructTagGraphAt(
 	if err != nil {
 		return err
 	}
+	if _, err := tagparser.DependentRequired(fields); err != nil {
+		if compileErr, ok := errors.AsType[*tagparser.CompileError](err); ok {
+			compileErr.Field = path + "." + compileErr.Field
+		}
+		return err
+	}
+	if _, err := compileStructCrossFieldPlan(fields); err != nil {
+		if compileErr, ok := errors.AsType[*tagparser.CompileError](err); ok {
+			compileErr.Field = path + "." + compileErr.Field
+		}
+		return err
+	}
 	for i := range fields {
 		field := fields[i]
 		field.Name = path + "." + field.Name
@@ -1594,7 +1891,7 @@ func validateStructTagGraphAt(
 		if err := validateSupportedFieldType(field.Type, field.Name); err != nil {
 			return err
 		}
-		if _, err := tagparser.CompileFieldPlan(&field); err != nil {
+		if err := validateFieldTagPlan(field); err != nil {
 			return err
 		}
 		if nested, ok := nestedStructType(field.Type); ok {
@@ -1606,6 +1903,48 @@ func validateStructTagGraphAt(
 	return nil
 }
 
+// validateFieldTagPlan compiles the rules of field and of the elements and
+// keys its tag scopes rules to.
+func validateFieldTagPlan(field tagparser.FieldInfo) error {
+	plan, err := tagparser.CompileFieldPlan(&field)
+	if err != nil {
+		return err
+	}
+	for _, operation := range plan.Operations {
+		switch operation.Op {
+		case tagparser.RuleCustom:
+			// A rule registered only with tagparser has nothing to apply.
+			if _, ok := tagRuleFuncs.Load(operation.Name); !ok {
+				return &tagparser.CompileError{Field: field.Name, Rule: operation.Name, Err: tagparser.ErrUnknownRule}
+			}
+		case tagparser.RuleEnumType:
+			if err := checkEnumType(field); err != nil {
+				return err
+			}
+		default:
+			// Built-in rules need nothing beyond the compiled plan.
+		}
+	}
+	if len(field.ElemRules) > 0 {
+		elem, err := field.ElementField()
+		if err != nil {
+			return err
+		}
+		elem.Type = effectiveStructFieldType(elem.Type)
+		if err := validateFieldTagPlan(elem); err != nil {
+			return err
+		}
+	}
+	if len(field.KeyRules) > 0 {
+		key, err := field.KeyField()
+		if err != nil {
+			return err
+		}
+		return validateFieldTagPlan(key)
+	}
+	return nil
+}
+
 func validateSupportedFieldType(fieldType reflect.Type, path string) error {
 	original := fieldType
 	for fieldType.Kind() == reflect.Pointer {
@@ -1621,6 +1960,10 @@ func validateSupportedFieldType(fieldType reflect.Type, path string) error {
 		if fieldType.NumMethod() == 0 {
 			return nil
 		}
+		if _, ok := registeredInterface(fieldType); ok {
+			return nil
+		}
+		return &StructConstructionError{Path: path, Type: original, Err: ErrUnregisteredInterfaceType}
 	case reflect.Slice, reflect.Array:
 		return validateSupportedFieldType(fieldType.Elem(), path+"[]")
 	case reflect.Map:
@@ -1670,6 +2013,8 @@ func FromStruct[T any](opts ...FromStructOption) (*ZodStruct[T, T], error) {
 		s = Struct[T](fieldSchemas)
 	}
 	s.internals.FieldNameTag = cfg.fieldNameTag
+	s.internals.DependentRequired = structTagDependentRequired(structType, cfg.tagName, cfg.fieldNameTag)
+	s.internals.FieldComparisons, s.internals.RequiredIf = structTagCrossFieldRules(structType, cfg.tagName, cfg.fieldNameTag)
 	return s, nil
 }
 
@@ -1692,6 +2037,8 @@ func FromStructPtr[T any](opts ...FromStructOption) (*ZodStruct[T, *T], error) {
 		s = StructPtr[T](fieldSchemas)
 	}
 	s.internals.FieldNameTag = cfg.fieldNameTag
+	s.internals.DependentRequired = structTagDependentRequired(structType, cfg.tagName, cfg.fieldNameTag)
+	s.internals.FieldComparisons, s.internals.RequiredIf = structTagCrossFieldRules(structType, cfg.tagName, cfg.fieldNameTag)
 	return s, nil
 }
 
@@ -1735,32 +2082,118 @@ func newFromStructConfig(opts []FromStructOption) *fromStructConfig {
 
 // fromStructFieldSchemas derives field schemas from a struct type, or returns
 // nil when the type carries no rule tags.
-func fromStructFieldSchemas(structType reflect.Type, cfg *fromStructConfig) (core.StructSchema, error) {
+func fromStructFieldSchemas(structType reflect.Type, cfg *fromStructConfig) (schemas core.StructSchema, err error) {
 	if !hasTagsWithName(structType, cfg.tagName) {
 		return nil, nil
 	}
 	if err := validateStructTagGraph(structType, cfg.tagName, cfg.fieldNameTag); err != nil {
 		return nil, err
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			failure, ok := r.(tagRuleFailure)
+			if !ok {
+				panic(r)
+			}
+			schemas, err = nil, failure.err
+		}
+	}()
 	return parseStructTagsToSchemasWithTag(structType, cfg.tagName, cfg.fieldNameTag), nil
 }
 
-// hasTagsWithName checks if a struct type has any tags with the given name
-func hasTagsWithName(structType reflect.Type, tagName string) bool {
-	if structType.Kind() == reflect.Pointer {
-		structType = structType.Elem()
+// structTagDependentRequired returns the required_with dependencies declared
+// by the tags of structType, or nil when it declares none.
+func structTagDependentRequired(structType reflect.Type, tagName, fieldNameTag string) map[string][]string {
+	fields, err := tagparser.NewWithTags(tagName, fieldNameTag).ParseStructTags(structType)
+	if err != nil {
+		return nil
+	}
+	deps, err := tagparser.DependentRequired(fields)
+	if err != nil {
+		return nil
 	}
+	return deps
+}
 
-	if structType.Kind() != reflect.Struct {
-		return false
+// structTagCrossFieldRules returns the cross-field comparisons and
+// required_if conditions declared by the tags of structType.
+func structTagCrossFieldRules(structType reflect.Type, tagName, fieldNameTag string) ([]FieldComparison, map[string][]FieldCondition) {
+	fields, err := tagparser.NewWithTags(tagName, fieldNameTag).ParseStructTags(structType)
+	if err != nil {
+		return nil, nil
+	}
+	plan, err := compileStructCrossFieldPlan(fields)
+	if err != nil {
+		return nil, nil
 	}
+	var comparisons []FieldComparison
+	for _, comparison := range plan.Comparisons {
+		comparisons = append(comparisons, FieldComparison{
+			Field:    comparison.Field,
+			Relation: fieldRelationForRule[comparison.Op],
+			Other:    comparison.Other,
+		})
+	}
+	var requiredIf map[string][]FieldCondition
+	for field, conditions := range plan.RequiredIf {
+		if requiredIf == nil {
+			requiredIf = make(map[string][]FieldCondition, len(plan.RequiredIf))
+		}
+		for _, condition := range conditions {
+			requiredIf[field] = append(requiredIf[field], FieldCondition{Field: condition.Field, Value: condition.Value})
+		}
+	}
+	return comparisons, requiredIf
+}
 
-	for field := range structType.Fields() {
+// compileStructCrossFieldPlan compiles the cross-field rules of fields,
+// comparing wrapped field types by the values they hold.
+func compileStructCrossFieldPlan(fields []tagparser.FieldInfo) (tagparser.CrossFieldPlan, error) {
+	fields = slices.Clone(fields)
+	for i := range fields {
+		fields[i].Type = effectiveStructFieldType(fields[i].Type)
+	}
+	return tagparser.CompileCrossFieldPlan(fields)
+}
+
+var fieldRelationForRule = map[tagparser.RuleOp]FieldRelation{
+	tagparser.RuleEqField:  FieldEqual,
+	tagparser.RuleNeField:  FieldNotEqual,
+	tagparser.RuleGTField:  FieldGreater,
+	tagparser.RuleGTEField: FieldGreaterEqual,
+	tagparser.RuleLTField:  FieldLess,
+	tagparser.RuleLTEField: FieldLessEqual,
+}
+
+// taggedObjectSchema builds the object schema that validates a nested tagged
+// struct. Its required_with and cross-field rules treat zero-valued fields as
+// absent, as the struct schema does.
+func taggedObjectSchema(structType reflect.Type, fieldSchemas core.StructSchema, tagName, fieldNameTag string) core.ZodSchema {
+	schema := Object(fieldSchemas).WithFieldNameTag(fieldNameTag)
+	if deps := structTagDependentRequired(structType, tagName, fieldNameTag); len(deps) > 0 {
+		schema = schema.DependentRequired(deps)
+		schema.internals.zeroIsAbsent = true
+	}
+	comparisons, requiredIf := structTagCrossFieldRules(structType, tagName, fieldNameTag)
+	if len(comparisons) > 0 {
+		schema = schema.CompareFields(comparisons...)
+		schema.internals.zeroIsAbsent = true
+	}
+	for _, field := range slices.Sorted(maps.Keys(requiredIf)) {
+		schema = schema.RequiredIf(field, requiredIf[field]...)
+		schema.internals.zeroIsAbsent = true
+	}
+	return schema
+}
+
+// hasTagsWithName checks if a struct type has any tags with the given name,
+// on its own fields or on those promoted from anonymous embeds.
+func hasTagsWithName(structType reflect.Type, tagName string) bool {
+	for _, field := range tagparser.VisibleFields(defaultFieldNameTag, structType) {
 		if _, exists := field.Tag.Lookup(tagName); exists {
 			return true
 		}
 	}
-
 	return false
 }
 
@@ -1869,7 +2302,7 @@ func createSchemaFromTypeWithCycleDetection(fieldType reflect.Type, fieldInfo ta
 			// Parse nested struct tags recursively with cycle detection
 			fieldSchemas := parseStructTagsToSchemasWithCycleDetection(actualType, tagName, fieldNameTag, visited)
 			if len(fieldSchemas) > 0 {
-				schema = Object(fieldSchemas).WithFieldNameTag(fieldNameTag)
+				schema = taggedObjectSchema(actualType, fieldSchemas, tagName, fieldNameTag)
 			} else {
 				schema = Any()
 			}
@@ -1931,7 +2364,7 @@ func createLazySchemaForType(fieldType reflect.Type, fieldInfo tagparser.FieldIn
 				// This will use cached schemas on subsequent calls
 				fieldSchemas := parseStructTagsToSchemasWithTag(elementType, tagName, fieldNameTag)
 				if len(fieldSchemas) > 0 {
-					schema = Object(fieldSchemas).WithFieldNameTag(fieldNameTag)
+					schema = taggedObjectSchema(elementType, fieldSchemas, tagName, fieldNameTag)
 				} else {
 					schema = Any()
 				}
@@ -1964,7 +2397,7 @@ func createLazySchemaForType(fieldType reflect.Type, fieldInfo tagparser.FieldIn
 			// This will use cached schemas on subsequent calls
 			fieldSchemas := parseStructTagsToSchemasWithTag(actualType, tagName, fieldNameTag)
 			if len(fieldSchemas) > 0 {
-				schema = Object(fieldSchemas).WithFieldNameTag(fieldNameTag)
+				schema = taggedObjectSchema(actualType, fieldSchemas, tagName, fieldNameTag)
 			} else {
 				schema = Any()
 			}
@@ -2200,10 +2633,15 @@ func createSchemaFromTypeWithInfo(fieldType reflect.Type, fieldInfo tagparser.Fi
 			}
 		}
 	case reflect.Interface:
-		schema = Any()
+		// An interface with methods is validated by its registered union.
+		if union, ok := registeredInterface(fieldType); ok {
+			schema = union
+		} else {
+			schema = Any()
+		}
 	case reflect.Slice, reflect.Array:
 		elemType := fieldType.Elem()
-		elemSchema := createSchemaFromType(elemType)
+		elemSchema := elementSchemaFromTags(elemType, fieldInfo)
 		if elemSchema != nil {
 			if isPointer {
 				// For pointer to slice (*[]T), use SlicePtr
@@ -2221,14 +2659,19 @@ func createSchemaFromTypeWithInfo(fieldType reflect.Type, fieldInfo tagparser.Fi
 	case reflect.Map:
 		// Handle maps
 		valueType := fieldType.Elem()
-		valueSchema := createSchemaFromType(valueType)
+		valueSchema := elementSchemaFromTags(valueType, fieldInfo)
+		keySchema := keySchemaFromTags(fieldInfo)
 		if valueSchema != nil {
 			if isPointer {
 				// For pointer to map (*map[K]V), use MapPtr
-				schema = createMapPtrSchema(valueSchema, valueType)
+				schema = createMapPtrSchema(keySchema, valueSchema, valueType)
 			} else {
 				// For regular map (map[K]V), use Map
-				schema = createMapSchema(valueSchema, valueType)
+				schema = createMapSchema(keySchema, valueSchema, valueType)
+			}
+			// A map holds any subset of the keys its key rules allow.
+			if fieldInfo.HasEnumKeys() {
+				schema = applySchemaMethod(schema, "Partial")
 			}
 		} else {
 			schema = MapPtr(String(), Any())
@@ -2278,6 +2721,32 @@ func createSchemaFromTypeWithInfo(fieldType reflect.Type, fieldInfo tagparser.Fi
 	return schema
 }
 
+// elementSchemaFromTags creates the schema of a collection field's elements,
+// or map values, applying the rules its tag scopes to them.
+func elementSchemaFromTags(elemType reflect.Type, fieldInfo tagparser.FieldInfo) core.ZodSchema {
+	if len(fieldInfo.ElemRules) == 0 {
+		return createSchemaFromType(elemType)
+	}
+	elem, err := fieldInfo.ElementField()
+	if err != nil {
+		panic(fmt.Sprintf("materialize unvalidated element rules for field %s: %v", fieldInfo.Name, err))
+	}
+	return applyParsedTagRules(createSchemaFromTypeWithInfo(elem.Type, elem), elem)
+}
+
+// keySchemaFromTags creates the schema of a map field's keys, applying the
+// rules its tag scopes to them.
+func keySchemaFromTags(fieldInfo tagparser.FieldInfo) core.ZodSchema {
+	if len(fieldInfo.KeyRules) == 0 {
+		return String()
+	}
+	key, err := fieldInfo.KeyField()
+	if err != nil {
+		panic(fmt.Sprintf("materialize unvalidated key rules for field %s: %v", fieldInfo.Name, err))
+	}
+	return applyParsedTagRules(createSchemaFromTypeWithInfo(key.Type, key), key)
+}
+
 // createSchemaFromType creates a basic schema based on Go type
 func createSchemaFromType(fieldType reflect.Type) core.ZodSchema {
 	// This is the original function used by other places
@@ -2302,7 +2771,10 @@ func applyParsedTagRules(schema core.ZodSchema, fieldInfo tagparser.FieldInfo) c
 	// Apply tag-driven validation rules; structural flags are handled elsewhere.
 	for _, operation := range fieldPlan.Operations {
 		switch operation.Op {
-		case tagparser.RuleRequired, tagparser.RuleOptional, tagparser.RuleCoerce:
+		case tagparser.RuleRequired, tagparser.RuleOptional, tagparser.RuleCoerce,
+			tagparser.RuleRequiredWith, tagparser.RuleEqField, tagparser.RuleNeField,
+			tagparser.RuleGTField, tagparser.RuleGTEField, tagparser.RuleLTField,
+			tagparser.RuleLTEField, tagparser.RuleRequiredIf:
 			continue
 		case tagparser.RuleEmail, tagparser.RuleURL, tagparser.RuleUUID,
 			tagparser.RuleIPv4, tagparser.RuleIPv6, tagparser.RuleCIDRv4,
@@ -2322,12 +2794,16 @@ func applyParsedTagRules(schema core.ZodSchema, fieldInfo tagparser.FieldInfo) c
 			schema = applySchemaMethod(schema, "Min", 1)
 		case tagparser.RuleEnum:
 			schema = applyCompiledEnumConstraint(schema, operation.Operand)
+		case tagparser.RuleEnumType:
+			schema = enumTypeSchema(schema, fieldInfo.Type)
 		case tagparser.RuleLiteral:
 			schema = applyCompiledLiteralConstraint(schema, operation.Operand)
 		case tagparser.RuleDefault:
 			schema = applyCompiledFallback(schema, operation.Operand, false)
 		case tagparser.RulePrefault:
 			schema = applyCompiledFallback(schema, operation.Operand, true)
+		case tagparser.RuleCustom:
+			schema = applyCustomTagRule(schema, fieldInfo.Name, operation)
 		default:
 			schema = applyCompiledRule(schema, operation)
 		}
@@ -2388,6 +2864,16 @@ func applyCompiledRule(schema core.ZodSchema, plan tagparser.RulePlan) core.ZodS
 		return applySchemaMethod(schema, "StartsWith", plan.Operand)
 	case tagparser.RuleEndsWith:
 		return applySchemaMethod(schema, "EndsWith", plan.Operand)
+	case tagparser.RuleBefore:
+		return applySchemaMethod(schema, "Before", plan.Operand)
+	case tagparser.RuleAfter:
+		return applySchemaMethod(schema, "After", plan.Operand)
+	case tagparser.RulePast:
+		return applySchemaMethod(schema, "Past")
+	case tagparser.RuleFuture:
+		return applySchemaMethod(schema, "Future")
+	case tagparser.RuleUnique:
+		return applySchemaMethod(schema, "Unique")
 	default:
 		return schema
 	}
@@ -2567,87 +3053,87 @@ func createSlicePtrSchema(elemSchema core.ZodSchema, elemType reflect.Type) core
 }
 
 // Helper function to create map schema based on value type
-func createMapSchema(valueSchema core.ZodSchema, valueType reflect.Type) core.ZodSchema {
-	// Maps in Go always have string keys for JSON unmarshaling
+func createMapSchema(keySchema, valueSchema core.ZodSchema, valueType reflect.Type) core.ZodSchema {
+	// Maps in Go always have string keys for JSON unmarshaling, validated by keySchema
 	switch valueType.Kind() {
 	case reflect.String:
-		return RecordTyped[map[string]string, map[string]string](String(), valueSchema)
+		return RecordTyped[map[string]string, map[string]string](keySchema, valueSchema)
 	case reflect.Bool:
-		return RecordTyped[map[string]bool, map[string]bool](String(), valueSchema)
+		return RecordTyped[map[string]bool, map[string]bool](keySchema, valueSchema)
 	case reflect.Int:
-		return RecordTyped[map[string]int, map[string]int](String(), valueSchema)
+		return RecordTyped[map[string]int, map[string]int](keySchema, valueSchema)
 	case reflect.Int8:
-		return RecordTyped[map[string]int8, map[string]int8](String(), valueSchema)
+		return RecordTyped[map[string]int8, map[string]int8](keySchema, valueSchema)
 	case reflect.Int16:
-		return RecordTyped[map[string]int16, map[string]int16](String(), valueSchema)
+		return RecordTyped[map[string]int16, map[string]int16](keySchema, valueSchema)
 	case reflect.Int32:
-		return RecordTyped[map[string]int32, map[string]int32](String(), valueSchema)
+		return RecordTyped[map[string]int32, map[string]int32](keySchema, valueSchema)
 	case reflect.Int64:
-		return RecordTyped[map[string]int64, map[string]int64](String(), valueSchema)
+		return RecordTyped[map[string]int64, map[string]int64](keySchema, valueSchema)
 	case reflect.Uint:
-		return RecordTyped[map[string]uint, map[string]uint](String(), valueSchema)
+		return RecordTyped[map[string]uint, map[string]uint](keySchema, valueSchema)
 	case reflect.Uint8:
-		return RecordTyped[map[string]uint8, map[string]uint8](String(), valueSchema)
+		return RecordTyped[map[string]uint8, map[string]uint8](keySchema, valueSchema)
 	case reflect.Uint16:
-		return RecordTyped[map[string]uint16, map[string]uint16](String(), valueSchema)
+		return RecordTyped[map[string]uint16, map[string]uint16](keySchema, valueSchema)
 	case reflect.Uint32:
-		return RecordTyped[map[string]uint32, map[string]uint32](String(), valueSchema)
+		return RecordTyped[map[string]uint32, map[string]uint32](keySchema, valueSchema)
 	case reflect.Uint64:
-		return RecordTyped[map[string]uint64, map[string]uint64](String(), valueSchema)
+		return RecordTyped[map[string]uint64, map[string]uint64](keySchema, valueSchema)
 	case reflect.Float32:
-		return RecordTyped[map[string]float32, map[string]float32](String(), valueSchema)
+		return RecordTyped[map[string]float32, map[string]float32](keySchema, valueSchema)
 	case reflect.Float64:
-		return RecordTyped[map[string]float64, map[string]float64](String(), valueSchema)
+		return RecordTyped[map[string]float64, map[string]float64](keySchema, valueSchema)
 	case reflect.Interface:
-		return RecordTyped[map[string]any, map[string]any](String(), Any())
+		return RecordTyped[map[string]any, map[string]any](keySchema, Any())
 	case reflect.Invalid, reflect.Uintptr, reflect.Complex64, reflect.Complex128, reflect.Array,
 		reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice,
 		reflect.Struct, reflect.UnsafePointer:
-		return Map(String(), valueSchema)
+		return Map(keySchema, valueSchema)
 	}
-	return Map(String(), valueSchema) // Default fallback
+	return Map(keySchema, valueSchema) // Default fallback
 }
 
 // Helper function to create pointer map schema based on value type
-func createMapPtrSchema(valueSchema core.ZodSchema, valueType reflect.Type) core.ZodSchema {
-	// Maps in Go always have string keys for JSON unmarshaling
+func createMapPtrSchema(keySchema, valueSchema core.ZodSchema, valueType reflect.Type) core.ZodSchema {
+	// Maps in Go always have string keys for JSON unmarshaling, validated by keySchema
 	switch valueType.Kind() {
 	case reflect.String:
-		return RecordTyped[map[string]string, *map[string]string](String(), valueSchema)
+		return RecordTyped[map[string]string, *map[string]string](keySchema, valueSchema)
 	case reflect.Bool:
-		return RecordTyped[map[string]bool, *map[string]bool](String(), valueSchema)
+		return RecordTyped[map[string]bool, *map[string]bool](keySchema, valueSchema)
 	case reflect.Int:
-		return RecordTyped[map[string]int, *map[string]int](String(), valueSchema)
+		return RecordTyped[map[string]int, *map[string]int](keySchema, valueSchema)
 	case reflect.Int8:
-		return RecordTyped[map[string]int8, *map[string]int8](String(), valueSchema)
+		return RecordTyped[map[string]int8, *map[string]int8](keySchema, valueSchema)
 	case reflect.Int16:
-		return RecordTyped[map[string]int16, *map[string]int16](String(), valueSchema)
+		return RecordTyped[map[string]int16, *map[string]int16](keySchema, valueSchema)
 	case reflect.Int32:
-		return RecordTyped[map[string]int32, *map[string]int32](String(), valueSchema)
+		return RecordTyped[map[string]int32, *map[string]int32](keySchema, valueSchema)
 	case reflect.Int64:
-		return RecordTyped[map[string]int64, *map[string]int64](String(), valueSchema)
+		return RecordTyped[map[string]int64, *map[string]int64](keySchema, valueSchema)
 	case reflect.Uint:
-		return RecordTyped[map[string]uint, *map[string]uint](String(), valueSchema)
+		return RecordTyped[map[string]uint, *map[string]uint](keySchema, valueSchema)
 	case reflect.Uint8:
-		return RecordTyped[map[string]uint8, *map[string]uint8](String(), valueSchema)
+		return RecordTyped[map[string]uint8, *map[string]uint8](keySchema, valueSchema)
 	case reflect.Uint16:
-		return RecordTyped[map[string]uint16, *map[string]uint16](String(), valueSchema)
+		return RecordTyped[map[string]uint16, *map[string]uint16](keySchema, valueSchema)
 	case reflect.Uint32:
-		return RecordTyped[map[string]uint32, *map[string]uint32](String(), valueSchema)
+		return RecordTyped[map[string]uint32, *map[string]uint32](keySchema, valueSchema)
 	case reflect.Uint64:
-		return RecordTyped[map[string]uint64, *map[string]uint64](String(), valueSchema)
+		return RecordTyped[map[string]uint64, *map[string]uint64](keySchema, valueSchema)
 	case reflect.Float32:
-		return RecordTyped[map[string]float32, *map[string]float32](String(), valueSchema)
+		return RecordTyped[map[string]float32, *map[string]float32](keySchema, valueSchema)
 	case reflect.Float64:
-		return RecordTyped[map[string]float64, *map[string]float64](String(), valueSchema)
+		return RecordTyped[map[string]float64, *map[string]float64](keySchema, valueSchema)
 	case reflect.Interface:
-		return RecordTyped[map[string]any, *map[string]any](String(), Any())
+		return RecordTyped[map[string]any, *map[string]any](keySchema, Any())
 	case reflect.Invalid, reflect.Uintptr, reflect.Complex64, reflect.Complex128, reflect.Array,
 		reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice,
 		reflect.Struct, reflect.UnsafePointer:
-		return MapPtr(String(), valueSchema)
+		return MapPtr(keySchema, valueSchema)
 	}
-	return MapPtr(String(), valueSchema) // Default fallback
+	return MapPtr(keySchema, valueSchema) // Default fallback
 }
 
 // Helper function to create nested struct schema
@@ -2657,7 +3143,7 @@ func createNestedStructSchema(structType reflect.Type) core.ZodSchema {
 		// Parse nested struct tags recursively
 		fieldSchemas := parseStructTagsToSchemas(structType)
 		if len(fieldSchemas) > 0 {
-			return Object(fieldSchemas)
+			return taggedObjectSchema(structType, fieldSchemas, "gozod", defaultFieldNameTag)
 		}
 	}
 	// For structs without tags, use Any() for now
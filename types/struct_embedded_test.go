@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+type embeddedBase struct {
+	ID      string `json:"id" gozod:"required,min=3"`
+	Version int    `json:"version" gozod:"gte=1"`
+}
+
+type EmbeddedMeta struct {
+	Note string `json:"note" gozod:"max=3"`
+}
+
+type embeddedUser struct {
+	embeddedBase
+	*EmbeddedMeta
+	Version int    `json:"version" gozod:"gte=10"`
+	Name    string `json:"name" gozod:"required"`
+}
+
+type embeddedPage[T any] struct {
+	Items []T `json:"items" gozod:"required,min=1"`
+	Total int `json:"total" gozod:"gte=0"`
+}
+
+func TestFromStructValidatesPromotedFields(t *testing.T) {
+	schema, err := FromStruct[embeddedUser]()
+	require.NoError(t, err)
+
+	valid := embeddedUser{
+		embeddedBase: embeddedBase{ID: "abc", Version: 0},
+		EmbeddedMeta: &EmbeddedMeta{Note: "ok"},
+		Version:      10,
+		Name:         "ada",
+	}
+	result, err := schema.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, valid, result)
+	assert.NotSame(t, valid.EmbeddedMeta, result.EmbeddedMeta, "writes never reach the input's embeds")
+
+	_, err = schema.Parse(embeddedUser{
+		embeddedBase: embeddedBase{ID: "a"},
+		EmbeddedMeta: &EmbeddedMeta{Note: "long"},
+		Version:      1,
+		Name:         "ada",
+	})
+	var zodErr *issues.ZodError
+	require.ErrorAs(t, err, &zodErr)
+	paths := make([]string, len(zodErr.Issues))
+	for i, issue := range zodErr.Issues {
+		paths[i] = issue.Path[0].(string)
+	}
+	// The outer version shadows the promoted one.
+	assert.ElementsMatch(t, []string{"id", "note", "version"}, paths)
+}
+
+func TestFromStructKeepsNilEmbeddedPointers(t *testing.T) {
+	schema := MustFromStruct[embeddedUser]()
+
+	result, err := schema.Parse(embeddedUser{embeddedBase: embeddedBase{ID: "abc"}, Version: 10, Name: "ada"})
+	require.NoError(t, err)
+	assert.Nil(t, result.EmbeddedMeta)
+}
+
+func TestFromStructDoesNotFlattenNamedEmbeds(t *testing.T) {
+	type Named struct {
+		embeddedBase `json:"base"`
+		Name         string `json:"name" gozod:"required"`
+	}
+
+	schema := MustFromStruct[Named]()
+	assert.Contains(t, schema.Shape(), "name")
+	assert.NotContains(t, schema.Shape(), "id")
+}
+
+func TestFromStructValidatesGenericInstantiations(t *testing.T) {
+	schema, err := FromStruct[embeddedPage[embeddedBase]]()
+	require.NoError(t, err)
+
+	_, err = schema.Parse(embeddedPage[embeddedBase]{Items: []embeddedBase{{ID: "a", Version: 1}}, Total: -1})
+	var zodErr *issues.ZodError
+	require.ErrorAs(t, err, &zodErr)
+	paths := make([][]any, 0, len(zodErr.Issues))
+	for _, issue := range zodErr.Issues {
+		paths = append(paths, issue.Path)
+	}
+	assert.ElementsMatch(t, [][]any{{"items", 0, "id"}, {"total"}}, paths)
+}
+
+func TestObjectReadsPromotedFieldsOfStructInput(t *testing.T) {
+	schema := Object(core.ObjectSchema{
+		"id":   String().Min(3),
+		"name": String(),
+	})
+
+	_, err := schema.Parse(embeddedUser{embeddedBase: embeddedBase{ID: "abc"}, Name: "ada"})
+	require.NoError(t, err)
+	_, err = schema.Parse(embeddedUser{embeddedBase: embeddedBase{ID: "a"}, Name: "ada"})
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+var (
+	// ErrInvalidEnumRegistration indicates that RegisterEnum rejected a type
+	// or its values.
+	ErrInvalidEnumRegistration = errors.New("invalid enum registration")
+	// ErrUnregisteredEnumType indicates that a field tagged enum_type has a
+	// type with no values registered by RegisterEnum.
+	ErrUnregisteredEnumType = errors.New("enum type is not registered")
+)
+
+// EnumValue is a defined string or integer type whose values RegisterEnum
+// can record.
+type EnumValue interface {
+	~string | ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+var enumTypeValues sync.Map // reflect.Type -> []reflect.Value
+
+// RegisterEnum records the values of the defined type T, typically its
+// declared constants, so that fields of type T tagged gozod:"enum_type" accept
+// exactly those values. Each type is registered once, before FromStruct
+// builds a schema that uses it.
+//
+// The schema validates and exports the underlying values, as encoding/json
+// encodes them; a String method does not change them. A type with a
+// MarshalText or MarshalJSON method encodes to other values and is rejected.
+func RegisterEnum[T EnumValue](values ...T) error {
+	typ := reflect.TypeFor[T]()
+	if typ.PkgPath() == "" {
+		return fmt.Errorf("%w: %v is not a defined type", ErrInvalidEnumRegistration, typ)
+	}
+	if marshalsToText(typ) {
+		return fmt.Errorf("%w: %v marshals to text, so its values are not the ones it encodes", ErrInvalidEnumRegistration, typ)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("%w: %v has no values", ErrInvalidEnumRegistration, typ)
+	}
+	registered := make([]reflect.Value, len(values))
+	for i, value := range values {
+		registered[i] = reflect.ValueOf(value)
+	}
+	if _, loaded := enumTypeValues.LoadOrStore(typ, registered); loaded {
+		return fmt.Errorf("%w: %v is already registered", ErrInvalidEnumRegistration, typ)
+	}
+	return nil
+}
+
+// marshalsToText reports whether typ, or a pointer to it, implements
+// encoding.TextMarshaler or json.Marshaler.
+func marshalsToText(typ reflect.Type) bool {
+	for _, marshaler := range []reflect.Type{reflect.TypeFor[encoding.TextMarshaler](), reflect.TypeFor[json.Marshaler]()} {
+		if typ.Implements(marshaler) || reflect.PointerTo(typ).Implements(marshaler) {
+			return true
+		}
+	}
+	return false
+}
+
+// registeredEnumType returns the registered type of an enum_type field,
+// looking through pointers.
+func registeredEnumType(fieldType reflect.Type) ([]reflect.Value, bool) {
+	for fieldType != nil && fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType == nil {
+		return nil, false
+	}
+	values, ok := enumTypeValues.Load(fieldType)
+	if !ok {
+		return nil, false
+	}
+	return values.([]reflect.Value), true
+}
+
+// checkEnumType reports an enum_type field whose type is not registered.
+func checkEnumType(field tagparser.FieldInfo) error {
+	if _, ok := registeredEnumType(field.Type); ok {
+		return nil
+	}
+	return &tagparser.CompileError{
+		Field: field.Name,
+		Rule:  string(tagparser.RuleEnumType),
+		Err:   fmt.Errorf("%w: %v", ErrUnregisteredEnumType, field.Type),
+	}
+}
+
+// enumTypeSchema builds the enum schema of an enum_type field over the
+// underlying kind of its type, as other schemas of defined types are built.
+func enumTypeSchema(schema core.ZodSchema, fieldType reflect.Type) core.ZodSchema {
+	values, ok := registeredEnumType(fieldType)
+	if !ok {
+		return schema
+	}
+	pointer := fieldType.Kind() == reflect.Pointer
+	switch values[0].Kind() {
+	case reflect.String:
+		return enumOfKind[string](values, pointer)
+	case reflect.Int:
+		return enumOfKind[int](values, pointer)
+	case reflect.Int8:
+		return enumOfKind[int8](values, pointer)
+	case reflect.Int16:
+		return enumOfKind[int16](values, pointer)
+	case reflect.Int32:
+		return enumOfKind[int32](values, pointer)
+	case reflect.Int64:
+		return enumOfKind[int64](values, pointer)
+	case reflect.Uint:
+		return enumOfKind[uint](values, pointer)
+	case reflect.Uint8:
+		return enumOfKind[uint8](values, pointer)
+	case reflect.Uint16:
+		return enumOfKind[uint16](values, pointer)
+	case reflect.Uint32:
+		return enumOfKind[uint32](values, pointer)
+	case reflect.Uint64:
+		return enumOfKind[uint64](values, pointer)
+	default:
+		return schema
+	}
+}
+
+func enumOfKind[T comparable](values []reflect.Value, pointer bool) core.ZodSchema {
+	target := reflect.TypeFor[T]()
+	typed := make([]T, len(values))
+	for i, value := range values {
+		typed[i] = value.Convert(target).Interface().(T)
+	}
+	if pointer {
+		return EnumSlicePtr(typed)
+	}
+	return EnumSlice(typed)
+}
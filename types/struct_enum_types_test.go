@@ -0,0 +1,155 @@
+package types
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/internal/issues"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+type ticketStatus string
+
+const (
+	ticketOpen   ticketStatus = "open"
+	ticketClosed ticketStatus = "closed"
+)
+
+type ticketPriority uint8
+
+const (
+	priorityLow ticketPriority = iota + 1
+	priorityHigh
+)
+
+type unregisteredStatus string
+
+type textLevel int
+
+func (l textLevel) MarshalText() ([]byte, error) { return []byte(strconv.Itoa(int(l))), nil }
+
+type namedLevel int
+
+const (
+	levelDebug namedLevel = iota
+	levelInfo
+)
+
+func (l namedLevel) String() string { return [...]string{"debug", "info"}[l] }
+
+func init() {
+	if err := RegisterEnum(ticketOpen, ticketClosed); err != nil {
+		panic(err)
+	}
+	if err := RegisterEnum(levelDebug, levelInfo); err != nil {
+		panic(err)
+	}
+	if err := RegisterEnum(priorityLow, priorityHigh); err != nil {
+		panic(err)
+	}
+}
+
+func TestFromStructAppliesRegisteredEnumTypes(t *testing.T) {
+	type Ticket struct {
+		Status   ticketStatus                    `json:"status" gozod:"required,enum_type"`
+		Priority ticketPriority                  `json:"priority" gozod:"required,enum_type"`
+		Previous *ticketStatus                   `json:"previous" gozod:"enum_type"`
+		Watched  []ticketStatus                  `json:"watched" gozod:"dive,enum_type"`
+		Routing  map[ticketStatus]ticketPriority `json:"routing" gozod:"keys,enum_type,values,enum_type"`
+	}
+
+	schema := MustFromStruct[Ticket]()
+	closed := ticketClosed
+	valid := Ticket{
+		Status:   ticketOpen,
+		Priority: priorityHigh,
+		Previous: &closed,
+		Watched:  []ticketStatus{ticketClosed},
+		Routing:  map[ticketStatus]ticketPriority{ticketOpen: priorityLow},
+	}
+	result, err := schema.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, valid, result)
+
+	reopened := ticketStatus("reopened")
+	_, err = schema.Parse(Ticket{
+		Status:   "archived",
+		Priority: 7,
+		Previous: &reopened,
+		Watched:  []ticketStatus{ticketOpen, "spam"},
+		Routing:  map[ticketStatus]ticketPriority{ticketOpen: 0},
+	})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	paths := make([][]any, 0, len(zodErr.Issues))
+	for _, issue := range zodErr.Issues {
+		paths = append(paths, issue.Path)
+	}
+	assert.ElementsMatch(t, [][]any{
+		{"status"},
+		{"priority"},
+		{"previous"},
+		{"watched", 1},
+		{"routing", "open"},
+	}, paths)
+}
+
+func TestFromStructRejectsUnregisteredEnumTypes(t *testing.T) {
+	type Unregistered struct {
+		Status unregisteredStatus `gozod:"enum_type"`
+	}
+	type Undefined struct {
+		Status string `gozod:"enum_type"`
+	}
+	type WrongFamily struct {
+		Ratio float64 `gozod:"enum_type"`
+	}
+	type UnregisteredElement struct {
+		Statuses []unregisteredStatus `gozod:"dive,enum_type"`
+	}
+
+	_, err := FromStruct[Unregistered]()
+	require.ErrorIs(t, err, ErrUnregisteredEnumType)
+	assert.ErrorContains(t, err, "Unregistered.Status")
+	_, err = FromStruct[Undefined]()
+	require.ErrorIs(t, err, ErrUnregisteredEnumType)
+	_, err = FromStruct[WrongFamily]()
+	require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+	_, err = FromStruct[UnregisteredElement]()
+	require.ErrorIs(t, err, ErrUnregisteredEnumType)
+	assert.ErrorContains(t, err, "Statuses[]")
+}
+
+func TestRegisterEnumRejectsInvalidRegistrations(t *testing.T) {
+	err := RegisterEnum("open", "closed")
+	require.ErrorIs(t, err, ErrInvalidEnumRegistration)
+
+	err = RegisterEnum[unregisteredStatus]()
+	require.ErrorIs(t, err, ErrInvalidEnumRegistration)
+
+	err = RegisterEnum(ticketOpen)
+	require.ErrorIs(t, err, ErrInvalidEnumRegistration)
+	assert.ErrorContains(t, err, "already registered")
+
+	err = RegisterEnum(textLevel(1))
+	require.ErrorIs(t, err, ErrInvalidEnumRegistration)
+	assert.ErrorContains(t, err, "marshals to text")
+}
+
+func TestFromStructEnumTypeUsesUnderlyingValuesOfStringers(t *testing.T) {
+	type Entry struct {
+		Level namedLevel `json:"level" gozod:"enum_type"`
+	}
+
+	schema, err := FromStruct[Entry]()
+	require.NoError(t, err)
+	_, err = schema.Parse(Entry{Level: levelInfo})
+	require.NoError(t, err)
+	_, err = schema.Parse(Entry{Level: 5})
+	require.Error(t, err)
+	_, err = schema.Parse(map[string]any{"level": "info"})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	// ErrInvalidInterfaceRegistration indicates that RegisterInterface
+	// rejected a type or its union.
+	ErrInvalidInterfaceRegistration = errors.New("invalid interface registration")
+	// ErrUnregisteredInterfaceType indicates that a struct field has an
+	// interface type with methods and no union registered by
+	// RegisterInterface.
+	ErrUnregisteredInterfaceType = fmt.Errorf("%w: interface type is not registered", ErrUnsupportedFieldType)
+)
+
+var interfaceUnions sync.Map // reflect.Type -> *ZodDiscriminatedUnion[any, any]
+
+// RegisterInterface records the discriminated union that validates struct
+// fields of the interface type I. FromStruct parses such a field with the
+// union, which picks the variant named by the discriminator of the value the
+// field holds; each variant must produce a type implementing I. Each type is
+// registered once, before FromStruct builds a schema that uses it.
+func RegisterInterface[I any](union *ZodDiscriminatedUnion[any, any]) error {
+	typ := reflect.TypeFor[I]()
+	if typ.Kind() != reflect.Interface || typ.NumMethod() == 0 {
+		return fmt.Errorf("%w: %v is not an interface type with methods", ErrInvalidInterfaceRegistration, typ)
+	}
+	if union == nil {
+		return fmt.Errorf("%w: %v has no union", ErrInvalidInterfaceRegistration, typ)
+	}
+	if _, loaded := interfaceUnions.LoadOrStore(typ, union); loaded {
+		return fmt.Errorf("%w: %v is already registered", ErrInvalidInterfaceRegistration, typ)
+	}
+	return nil
+}
+
+// InterfaceSchema returns the union registered for the interface type I.
+func InterfaceSchema[I any]() (*ZodDiscriminatedUnion[any, any], error) {
+	typ := reflect.TypeFor[I]()
+	union, ok := registeredInterface(typ)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnregisteredInterfaceType, typ)
+	}
+	return union, nil
+}
+
+// MustInterfaceSchema returns the union registered for the interface type I
+// and panics when there is none.
+func MustInterfaceSchema[I any]() *ZodDiscriminatedUnion[any, any] {
+	union, err := InterfaceSchema[I]()
+	if err != nil {
+		panic(err)
+	}
+	return union
+}
+
+// registeredInterface returns the union registered for the interface type
+// of a field, looking through pointers.
+func registeredInterface(fieldType reflect.Type) (*ZodDiscriminatedUnion[any, any], bool) {
+	for fieldType != nil && fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType == nil {
+		return nil, false
+	}
+	union, ok := interfaceUnions.Load(fieldType)
+	if !ok {
+		return nil, false
+	}
+	return union.(*ZodDiscriminatedUnion[any, any]), true
+}
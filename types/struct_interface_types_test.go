@@ -0,0 +1,126 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+)
+
+type testShape interface {
+	Area() float64
+}
+
+type testShapeKind string
+
+type testCircle struct {
+	Kind   testShapeKind `json:"kind"`
+	Radius float64       `json:"radius"`
+}
+
+func (c testCircle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+type testSquare struct {
+	Kind testShapeKind `json:"kind"`
+	Side float64       `json:"side"`
+}
+
+func (s *testSquare) Area() float64 { return s.Side * s.Side }
+
+type unregisteredShape interface {
+	Perimeter() float64
+}
+
+func init() {
+	shapes := MustDiscriminatedUnion("kind", []core.ZodSchema{
+		Struct[testCircle](core.StructSchema{"kind": Literal("circle"), "radius": Float64().Positive()}),
+		Struct[testSquare](core.StructSchema{"kind": Literal("square"), "side": Float64().Positive()}),
+	})
+	if err := RegisterInterface[testShape](shapes); err != nil {
+		panic(err)
+	}
+}
+
+func TestFromStructValidatesRegisteredInterfaces(t *testing.T) {
+	type Drawing struct {
+		Shape  testShape   `json:"shape" gozod:"required"`
+		Layers []testShape `json:"layers"`
+	}
+
+	schema, err := FromStruct[Drawing]()
+	require.NoError(t, err)
+
+	valid := Drawing{
+		Shape:  testCircle{Kind: "circle", Radius: 2},
+		Layers: []testShape{&testSquare{Kind: "square", Side: 1}},
+	}
+	result, err := schema.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, valid.Shape, result.Shape)
+	require.Len(t, result.Layers, 1)
+	assert.Equal(t, valid.Layers[0], result.Layers[0])
+
+	_, err = schema.Parse(Drawing{Shape: testCircle{Kind: "circle", Radius: -1}})
+	var zodErr *issues.ZodError
+	require.ErrorAs(t, err, &zodErr)
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"shape", "radius"}, zodErr.Issues[0].Path)
+
+	_, err = schema.Parse(Drawing{Shape: &testSquare{Kind: "hexagon"}})
+	require.ErrorAs(t, err, &zodErr)
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidUnion, zodErr.Issues[0].Code)
+}
+
+func TestFromStructRejectsUnregisteredInterfaces(t *testing.T) {
+	type Drawing struct {
+		Shape unregisteredShape `json:"shape" gozod:"required"`
+	}
+
+	_, err := FromStruct[Drawing]()
+	require.ErrorIs(t, err, ErrUnregisteredInterfaceType)
+	require.ErrorIs(t, err, ErrUnsupportedFieldType)
+	assert.ErrorContains(t, err, "Drawing.Shape")
+}
+
+func TestRegisterInterfaceRejectsInvalidRegistrations(t *testing.T) {
+	union := MustDiscriminatedUnion("kind", []core.ZodSchema{
+		Object(core.ObjectSchema{"kind": Literal("a")}),
+	})
+
+	tests := []struct {
+		name     string
+		register func() error
+	}{
+		{name: "not an interface", register: func() error { return RegisterInterface[testCircle](union) }},
+		{name: "empty interface", register: func() error { return RegisterInterface[any](union) }},
+		{name: "nil union", register: func() error { return RegisterInterface[unregisteredShape](nil) }},
+		{name: "registered twice", register: func() error { return RegisterInterface[testShape](union) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.register(), ErrInvalidInterfaceRegistration)
+		})
+	}
+
+	_, err := InterfaceSchema[unregisteredShape]()
+	assert.True(t, errors.Is(err, ErrUnregisteredInterfaceType))
+	assert.Panics(t, func() { MustInterfaceSchema[unregisteredShape]() })
+	assert.NotNil(t, MustInterfaceSchema[testShape]())
+}
+
+func TestDiscriminatedUnionDispatchesStructInput(t *testing.T) {
+	union := MustInterfaceSchema[testShape]()
+
+	result, err := union.Parse(&testSquare{Kind: "square", Side: 2})
+	require.NoError(t, err)
+	assert.Equal(t, testSquare{Kind: "square", Side: 2}, result)
+
+	result, err = union.Parse(map[string]any{"kind": "circle", "radius": 1.0})
+	require.NoError(t, err)
+	assert.Equal(t, testCircle{Kind: "circle", Radius: 1}, result)
+}
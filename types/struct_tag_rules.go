@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+// ErrNilTagRuleFunc indicates that RegisterTagRule received no rule function.
+var ErrNilTagRuleFunc = errors.New("tag rule function is nil")
+
+// TagRuleFunc applies a registered struct tag rule to a field schema. It
+// receives the rule's operand, which is empty for a bare rule, and returns the
+// schema to use in place of the one it was given.
+type TagRuleFunc func(schema core.ZodSchema, operand string) (core.ZodSchema, error)
+
+// TagRuleOption configures a rule registered with RegisterTagRule.
+type TagRuleOption func(*tagRuleConfig)
+
+type tagRuleConfig struct {
+	arity tagparser.OperandArity
+}
+
+// WithTagRuleOperand sets whether the rule takes an operand. Rules accept an
+// optional operand by default.
+func WithTagRuleOperand(arity tagparser.OperandArity) TagRuleOption {
+	return func(c *tagRuleConfig) {
+		c.arity = arity
+	}
+}
+
+var tagRuleFuncs sync.Map // rule name -> TagRuleFunc
+
+// RegisterTagRule adds a struct tag rule that FromStruct applies to fields of
+// family, as it applies built-in rules. Tags naming the rule with the wrong
+// field family or operand arity fail tag validation; errors returned by fn
+// are returned by FromStruct.
+func RegisterTagRule(name string, family tagparser.FieldFamily, fn TagRuleFunc, opts ...TagRuleOption) error {
+	if fn == nil {
+		return fmt.Errorf("%w: %w: rule %q", tagparser.ErrInvalidRuleRegistration, ErrNilTagRuleFunc, name)
+	}
+	cfg := tagRuleConfig{arity: tagparser.OperandOptional}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if err := tagparser.RegisterRule(name, family, cfg.arity); err != nil {
+		return err
+	}
+	if _, loaded := tagRuleFuncs.LoadOrStore(name, fn); loaded {
+		return fmt.Errorf("%w: rule %q is already registered", tagparser.ErrInvalidRuleRegistration, name)
+	}
+	return nil
+}
+
+// tagRuleFailure carries a registered rule's error out of schema
+// construction, which has no error results, to fromStructFieldSchemas.
+type tagRuleFailure struct {
+	err error
+}
+
+func applyCustomTagRule(schema core.ZodSchema, fieldName string, plan tagparser.RulePlan) core.ZodSchema {
+	operand, _ := plan.Operand.(string)
+	rule := plan.Name
+	if operand != "" {
+		rule += "=" + operand
+	}
+	fail := func(err error) {
+		panic(tagRuleFailure{err: &tagparser.CompileError{Field: fieldName, Rule: rule, Err: err}})
+	}
+
+	fn, ok := tagRuleFuncs.Load(plan.Name)
+	if !ok {
+		fail(tagparser.ErrUnknownRule)
+	}
+	result, err := fn.(TagRuleFunc)(schema, operand)
+	if err != nil {
+		fail(fmt.Errorf("%w: %w", tagparser.ErrInvalidOperand, err))
+	}
+	if result == nil {
+		fail(fmt.Errorf("%w: rule returned a nil schema", tagparser.ErrInvalidOperand))
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
+)
+
+var errNotStringSchema = errors.New("not a string schema")
+
+func init() {
+	mustRegisterTagRule("sku", tagparser.FieldFamilyString, func(schema core.ZodSchema, operand string) (core.ZodSchema, error) {
+		prefix := "SKU-"
+		if operand != "" {
+			prefix = operand
+		}
+		switch s := schema.(type) {
+		case *ZodString[string]:
+			return s.StartsWith(prefix).Min(len(prefix) + 1), nil
+		case *ZodString[*string]:
+			return s.StartsWith(prefix).Min(len(prefix) + 1), nil
+		default:
+			return nil, fmt.Errorf("%w: %T", errNotStringSchema, schema)
+		}
+	})
+	mustRegisterTagRule("maxlen", tagparser.FieldFamilyString, func(schema core.ZodSchema, operand string) (core.ZodSchema, error) {
+		limit, err := strconv.Atoi(operand)
+		if err != nil {
+			return nil, err
+		}
+		return schema.(*ZodString[string]).Max(limit), nil
+	}, WithTagRuleOperand(tagparser.OperandRequired))
+	mustRegisterTagRule("even", tagparser.FieldFamilySignedInteger, func(schema core.ZodSchema, _ string) (core.ZodSchema, error) {
+		return schema.(*ZodIntegerTyped[int, int]).MultipleOf(2), nil
+	}, WithTagRuleOperand(tagparser.OperandNone))
+}
+
+func mustRegisterTagRule(name string, family tagparser.FieldFamily, fn TagRuleFunc, opts ...TagRuleOption) {
+	if err := RegisterTagRule(name, family, fn, opts...); err != nil {
+		panic(err)
+	}
+}
+
+func TestFromStructAppliesRegisteredTagRules(t *testing.T) {
+	type Item struct {
+		SKU    string  `json:"sku" gozod:"required,sku"`
+		Legacy *string `json:"legacy" gozod:"sku=OLD-"`
+		Name   string  `json:"name" gozod:"required,maxlen=4"`
+		Pairs  int     `json:"pairs" gozod:"required,even"`
+	}
+
+	schema, err := FromStruct[Item]()
+	require.NoError(t, err)
+
+	_, err = schema.Parse(Item{SKU: "SKU-1", Name: "bolt", Pairs: 2})
+	require.NoError(t, err)
+	legacy := "OLD-7"
+	_, err = schema.Parse(Item{SKU: "SKU-1", Legacy: &legacy, Name: "bolt", Pairs: 2})
+	require.NoError(t, err)
+
+	for _, item := range []Item{
+		{SKU: "ABC-1", Name: "bolt", Pairs: 2},
+		{SKU: "SKU-", Name: "bolt", Pairs: 2},
+		{SKU: "SKU-1", Name: "bolts", Pairs: 2},
+		{SKU: "SKU-1", Name: "bolt", Pairs: 3},
+	} {
+		_, err := schema.Parse(item)
+		assert.Error(t, err, "%+v", item)
+	}
+}
+
+func TestFromStructRejectsMisusedRegisteredTagRules(t *testing.T) {
+	type OperandOnBareRule struct {
+		Pairs int `gozod:"even=4"`
+	}
+	type MissingOperand struct {
+		Name string `gozod:"maxlen"`
+	}
+	type WrongFamily struct {
+		Count int `gozod:"sku"`
+	}
+	type BadOperand struct {
+		Name string `gozod:"maxlen=many"`
+	}
+	type Nested struct {
+		Inner BadOperand `gozod:"required"`
+	}
+
+	_, err := FromStruct[OperandOnBareRule]()
+	require.ErrorIs(t, err, tagparser.ErrInvalidArity)
+	_, err = FromStruct[MissingOperand]()
+	require.ErrorIs(t, err, tagparser.ErrMissingOperand)
+	_, err = FromStruct[WrongFamily]()
+	require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+
+	_, err = FromStruct[BadOperand]()
+	require.ErrorIs(t, err, tagparser.ErrInvalidOperand)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	var compileErr *tagparser.CompileError
+	require.ErrorAs(t, err, &compileErr)
+	assert.Equal(t, "Name", compileErr.Field)
+	assert.Equal(t, "maxlen=many", compileErr.Rule)
+
+	_, err = FromStruct[Nested]()
+	require.ErrorIs(t, err, tagparser.ErrInvalidOperand)
+}
+
+func TestFromStructRejectsRulesRegisteredWithoutFunction(t *testing.T) {
+	require.NoError(t, tagparser.RegisterRule("codegen_only", tagparser.FieldFamilyString, tagparser.OperandNone))
+	type Item struct {
+		Code string `gozod:"codegen_only"`
+	}
+
+	_, err := FromStruct[Item]()
+	require.ErrorIs(t, err, tagparser.ErrUnknownRule)
+}
+
+func TestRegisterTagRuleRejectsInvalidRegistrations(t *testing.T) {
+	noop := func(schema core.ZodSchema, _ string) (core.ZodSchema, error) { return schema, nil }
+
+	err := RegisterTagRule("nil_func", tagparser.FieldFamilyString, nil)
+	require.ErrorIs(t, err, tagparser.ErrInvalidRuleRegistration)
+	require.ErrorIs(t, err, ErrNilTagRuleFunc)
+
+	err = RegisterTagRule("email", tagparser.FieldFamilyString, noop)
+	require.ErrorIs(t, err, tagparser.ErrInvalidRuleRegistration)
+
+	err = RegisterTagRule("sku", tagparser.FieldFamilyString, noop)
+	require.ErrorIs(t, err, tagparser.ErrInvalidRuleRegistration)
+	err = RegisterTagRule("sku", tagparser.FieldFamilyString, noop, WithTagRuleOperand(tagparser.OperandRequired))
+	require.ErrorIs(t, err, tagparser.ErrInvalidRuleRegistration)
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/internal/issues"
+	"github.com/kaptinlin/gozod/pkg/tagparser"
 )
 
 // =============================================================================
@@ -191,3 +194,223 @@ func TestFromStruct_NestedFieldAppliesTagPlanOnce(t *testing.T) {
 	assert.True(t, child.IsOptional())
 	assert.Len(t, child.Internals().Modifiers, 1)
 }
+
+func TestFromStruct_RequiredWithTag(t *testing.T) {
+	type Payment struct {
+		BillingAddress string `json:"billing_address"`
+		BillingName    string `json:"billing_name" gozod:"required_with=BillingAddress"`
+	}
+
+	schema := MustFromStruct[Payment]()
+	assert.Equal(t, map[string][]string{"billing_address": {"billing_name"}}, schema.RequiredDependencies())
+
+	_, err := schema.Parse(Payment{})
+	require.NoError(t, err)
+	_, err = schema.Parse(Payment{BillingName: "Ada"})
+	require.NoError(t, err)
+	_, err = schema.Parse(Payment{BillingAddress: "Main St", BillingName: "Ada"})
+	require.NoError(t, err)
+	_, err = schema.Parse(Payment{BillingAddress: "Main St"})
+	require.Error(t, err)
+
+	t.Run("nested structs treat zero values as absent", func(t *testing.T) {
+		type Order struct {
+			Payment Payment `gozod:"required"`
+		}
+		order := MustFromStruct[Order]()
+		_, err := order.Parse(Order{Payment: Payment{BillingName: "Ada"}})
+		require.NoError(t, err)
+		_, err = order.Parse(Order{Payment: Payment{BillingAddress: "Main St"}})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown field is a construction error", func(t *testing.T) {
+		type Broken struct {
+			CreditCard string `gozod:"required_with=Billing"`
+		}
+		schema, err := FromStruct[Broken]()
+		assert.Nil(t, schema)
+		assert.ErrorIs(t, err, tagparser.ErrUnknownField)
+		assert.ErrorContains(t, err, "Broken.CreditCard")
+	})
+}
+
+func TestFromStruct_CrossFieldTags(t *testing.T) {
+	type Signup struct {
+		Password string `json:"password" gozod:"required"`
+		Confirm  string `json:"confirm" gozod:"eqfield=Password"`
+		MinAge   int    `json:"min_age"`
+		MaxAge   *int   `json:"max_age" gozod:"gtefield=min_age"`
+		Plan     string `json:"plan"`
+		Trial    bool   `json:"trial"`
+		Company  string `json:"company" gozod:"required_if=Plan team Trial false"`
+	}
+
+	schema := MustFromStruct[Signup]()
+	assert.Equal(t, []FieldComparison{
+		{Field: "confirm", Relation: FieldEqual, Other: "password"},
+		{Field: "max_age", Relation: FieldGreaterEqual, Other: "min_age"},
+	}, schema.FieldComparisons())
+	assert.Equal(t, map[string][]FieldCondition{
+		"company": {{Field: "plan", Value: "team"}, {Field: "trial", Value: false}},
+	}, schema.RequiredIfConditions())
+
+	eighteen, twelve := 18, 12
+	_, err := schema.Parse(Signup{Password: "secret"})
+	require.NoError(t, err)
+	_, err = schema.Parse(Signup{Password: "secret", Confirm: "secret", MinAge: 13, MaxAge: &eighteen, Plan: "team", Company: "Acme"})
+	require.NoError(t, err)
+	_, err = schema.Parse(Signup{Password: "secret", Plan: "team", Trial: true})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(Signup{Password: "secret", Confirm: "secrets", MinAge: 13, MaxAge: &twelve, Plan: "team"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 3)
+	assert.Equal(t, []any{"confirm"}, zodErr.Issues[0].Path)
+	assert.Equal(t, []any{"max_age"}, zodErr.Issues[1].Path)
+	assert.Equal(t, []any{"company"}, zodErr.Issues[2].Path)
+
+	t.Run("nested structs report at the nested field", func(t *testing.T) {
+		type Account struct {
+			Signup Signup `json:"signup" gozod:"required"`
+		}
+		account := MustFromStruct[Account]()
+		_, err := account.Parse(Account{Signup: Signup{Password: "secret", Confirm: "secret"}})
+		require.NoError(t, err)
+		_, err = account.Parse(Account{Signup: Signup{Password: "secret", Confirm: "other"}})
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, []any{"signup", "confirm"}, zodErr.Issues[0].Path)
+	})
+
+	t.Run("invalid rules are construction errors", func(t *testing.T) {
+		type UnknownSibling struct {
+			End int `gozod:"gtfield=Start"`
+		}
+		type MismatchedFamily struct {
+			Start string
+			End   int `gozod:"gtfield=Start"`
+		}
+		type UnorderedFamily struct {
+			Tags  []string
+			Other []string `gozod:"gtfield=Tags"`
+		}
+		type BadCondition struct {
+			Count  int
+			Reason string `gozod:"required_if=Count many"`
+		}
+		type OddConditions struct {
+			Status string
+			Reason string `gozod:"required_if=Status"`
+		}
+
+		_, err := FromStruct[UnknownSibling]()
+		require.ErrorIs(t, err, tagparser.ErrUnknownField)
+		assert.ErrorContains(t, err, "UnknownSibling.End")
+		_, err = FromStruct[MismatchedFamily]()
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		_, err = FromStruct[UnorderedFamily]()
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		_, err = FromStruct[BadCondition]()
+		require.ErrorIs(t, err, tagparser.ErrInvalidOperand)
+		_, err = FromStruct[OddConditions]()
+		require.ErrorIs(t, err, tagparser.ErrInvalidArity)
+	})
+}
+
+func TestFromStruct_ElementTags(t *testing.T) {
+	type Post struct {
+		Tags    []string          `json:"tags" gozod:"required,min=1,dive,min=2,max=5"`
+		Scores  [3]int            `json:"scores" gozod:"dive,gte=0,lte=100"`
+		Emails  map[string]string `json:"emails" gozod:"keys,min=2,values,email"`
+		Labels  *[]string         `json:"labels" gozod:"dive,min=1"`
+		Matrix  [][]int           `json:"matrix" gozod:"dive,min=1,dive,positive"`
+		Authors map[string][]int  `json:"authors" gozod:"values,dive,positive"`
+	}
+
+	schema := MustFromStruct[Post]()
+	labels := []string{"go"}
+	valid := Post{
+		Tags:    []string{"go", "zod"},
+		Scores:  [3]int{0, 50, 100},
+		Emails:  map[string]string{"ops": "ops@example.com"},
+		Labels:  &labels,
+		Matrix:  [][]int{{1}, {2, 3}},
+		Authors: map[string][]int{"ann": {1, 2}},
+	}
+	_, err := schema.Parse(valid)
+	require.NoError(t, err)
+
+	empty := ""
+	invalid := Post{
+		Tags:    []string{"go", "golang", "x"},
+		Scores:  [3]int{0, 101, 50},
+		Emails:  map[string]string{"a": "a@example.com", "ops": "not-an-email"},
+		Labels:  &[]string{empty},
+		Matrix:  [][]int{{1}, {}, {1, -1}},
+		Authors: map[string][]int{"ann": {1, 0}},
+	}
+	_, err = schema.Parse(invalid)
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	paths := make([][]any, 0, len(zodErr.Issues))
+	for _, issue := range zodErr.Issues {
+		paths = append(paths, issue.Path)
+	}
+	assert.ElementsMatch(t, [][]any{
+		{"tags", 1},
+		{"tags", 2},
+		{"scores", 1},
+		{"emails", "a"},
+		{"emails", "ops"},
+		{"labels", 0},
+		{"matrix", 1},
+		{"matrix", 2, 1},
+		{"authors", "ann", 1},
+	}, paths)
+
+	t.Run("map scope operands are first rules", func(t *testing.T) {
+		type Contacts struct {
+			Emails map[string]string `json:"emails" gozod:"keys=min=2,values=email"`
+		}
+
+		_, err := MustFromStruct[Contacts]().Parse(Contacts{
+			Emails: map[string]string{"a": "a@example.com", "ops": "not-an-email"},
+		})
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		paths := make([][]any, 0, len(zodErr.Issues))
+		for _, issue := range zodErr.Issues {
+			paths = append(paths, issue.Path)
+		}
+		assert.ElementsMatch(t, [][]any{{"emails", "a"}, {"emails", "ops"}}, paths)
+	})
+
+	t.Run("element rules on non-collections are construction errors", func(t *testing.T) {
+		type DiveString struct {
+			Name string `gozod:"dive,min=1"`
+		}
+		type KeysOnSlice struct {
+			Tags []string `gozod:"keys,min=1"`
+		}
+		type WrongElementFamily struct {
+			Counts []int `gozod:"dive,email"`
+		}
+		type MarkerOperand struct {
+			Tags []string `gozod:"dive=1"`
+		}
+
+		_, err := FromStruct[DiveString]()
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		assert.ErrorContains(t, err, "DiveString.Name")
+		_, err = FromStruct[KeysOnSlice]()
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		_, err = FromStruct[WrongElementFamily]()
+		require.ErrorIs(t, err, tagparser.ErrInapplicableRule)
+		assert.ErrorContains(t, err, "Counts[]")
+		_, err = FromStruct[MarkerOperand]()
+		require.ErrorIs(t, err, tagparser.ErrInvalidArity)
+	})
+}
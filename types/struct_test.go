@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -1828,3 +1829,159 @@ func TestStruct_PreservesNestedIssueDetails(t *testing.T) {
 	assert.Equal(t, []any{"email"}, issue.Path)
 	assert.Equal(t, "email", issue.Format)
 }
+
+func TestStruct_ParseWithContext(t *testing.T) {
+	schema := Struct[User](core.StructSchema{
+		"name":  String().Min(1),
+		"email": String().Email(),
+	})
+
+	t.Run("canceled context reports a canceled issue", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := schema.ParseWithContext(ctx, User{Name: "Ada", Email: "ada@example.com"})
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+		assert.Equal(t, "Validation canceled", zodErr.Issues[0].Message)
+	})
+
+	t.Run("expired deadline reports a canceled issue", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		<-ctx.Done()
+
+		_, err := schema.ParseAnyContext(ctx, User{Name: "Ada", Email: "ada@example.com"})
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+	})
+
+	t.Run("live context parses normally", func(t *testing.T) {
+		result, err := schema.ParseWithContext(context.Background(), User{Name: "Ada", Email: "ada@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", result.Name)
+	})
+}
+
+func TestStruct_Dependencies(t *testing.T) {
+	type Payment struct {
+		CreditCard     string `json:"credit_card"`
+		BillingAddress string `json:"billing_address"`
+		BillingName    string
+	}
+
+	schema := Struct[Payment](core.StructSchema{
+		"credit_card":     String().Optional(),
+		"billing_address": String().Optional(),
+		"BillingName":     String().Optional(),
+	}).DependentRequired(map[string][]string{
+		"credit_card": {"billing_address", "BillingName"},
+	})
+
+	_, err := schema.Parse(Payment{})
+	require.NoError(t, err)
+	_, err = schema.Parse(Payment{CreditCard: "4242", BillingAddress: "Main St", BillingName: "Ada"})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(Payment{CreditCard: "4242", BillingName: "Ada"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"billing_address"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "credit_card", zodErr.Issues[0].Params["dependency"])
+
+	t.Run("dependent schemas validate the whole struct", func(t *testing.T) {
+		withSchema := schema.DependentSchemas(map[string]core.ZodSchema{
+			"billing_address": Struct[Payment](core.StructSchema{
+				"billing_address": String().Min(3),
+			}),
+		})
+		_, err := withSchema.Parse(Payment{CreditCard: "4242", BillingAddress: "x", BillingName: "Ada"})
+		require.Error(t, err)
+		_, err = withSchema.Parse(Payment{CreditCard: "4242", BillingAddress: "Main St", BillingName: "Ada"})
+		require.NoError(t, err)
+	})
+
+	t.Run("go field names resolve to field keys", func(t *testing.T) {
+		byGoName := Struct[Payment](core.StructSchema{
+			"credit_card":     String().Optional(),
+			"billing_address": String().Optional(),
+		}).DependentRequired(map[string][]string{
+			"CreditCard": {"BillingAddress"},
+		}).DependentSchemas(map[string]core.ZodSchema{
+			"BillingAddress": Struct[Payment](),
+		})
+		assert.Equal(t, map[string][]string{"credit_card": {"billing_address"}}, byGoName.RequiredDependencies())
+		assert.Contains(t, byGoName.SchemaDependencies(), "billing_address")
+
+		_, err := byGoName.Parse(Payment{CreditCard: "4242"})
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, []any{"billing_address"}, zodErr.Issues[0].Path)
+		assert.Equal(t, "credit_card", zodErr.Issues[0].Params["dependency"])
+	})
+
+	t.Run("modifiers keep dependencies", func(t *testing.T) {
+		assert.Equal(t, schema.RequiredDependencies(), schema.Partial().RequiredDependencies())
+		_, err := schema.Partial().Parse(Payment{CreditCard: "4242"})
+		require.Error(t, err)
+	})
+}
+
+func TestStruct_CrossFieldRules(t *testing.T) {
+	type Booking struct {
+		Start  int    `json:"start"`
+		End    int    `json:"end"`
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+
+	schema := Struct[Booking](core.StructSchema{
+		"start":  Int().Optional(),
+		"end":    Int().Optional(),
+		"status": String().Optional(),
+		"reason": String().Optional(),
+	}).CompareFields(FieldComparison{Field: "End", Relation: FieldGreater, Other: "start"}).
+		RequiredIf("reason", FieldCondition{Field: "status", Value: "cancelled"})
+
+	_, err := schema.Parse(Booking{})
+	require.NoError(t, err)
+	_, err = schema.Parse(Booking{Start: 1, End: 2, Status: "cancelled", Reason: "weather"})
+	require.NoError(t, err)
+	_, err = schema.Parse(Booking{Start: 1, Status: "confirmed"})
+	require.NoError(t, err)
+
+	_, err = schema.Parse(Booking{Start: 3, End: 2, Status: "cancelled"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 2)
+	assert.Equal(t, core.InvalidFieldComparison, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{"End"}, zodErr.Issues[0].Path)
+	assert.Equal(t, "Invalid input: expected End to be greater than start", zodErr.Issues[0].Message)
+	assert.Equal(t, core.MissingRequired, zodErr.Issues[1].Code)
+	assert.Equal(t, []any{"reason"}, zodErr.Issues[1].Path)
+
+	t.Run("modifiers keep cross-field rules", func(t *testing.T) {
+		assert.Equal(t, schema.FieldComparisons(), schema.Partial().FieldComparisons())
+		assert.Equal(t, schema.RequiredIfConditions(), schema.Required().RequiredIfConditions())
+		_, err := schema.Partial().Parse(Booking{Start: 3, End: 2})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown relation panics", func(t *testing.T) {
+		assert.Panics(t, func() {
+			schema.CompareFields(FieldComparison{Field: "end", Relation: "after", Other: "start"})
+		})
+		assert.Panics(t, func() { schema.RequiredIf("reason") })
+	})
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/checks"
+	"github.com/kaptinlin/gozod/pkg/regex"
+)
+
+// ZodTemplateLiteral validates strings against a sequence of literal and
+// schema parts compiled into one regular expression, like Zod's
+// z.templateLiteral. String modifiers are promoted from the embedded
+// *ZodString[T].
+type ZodTemplateLiteral[T StringConstraint] struct {
+	*ZodString[T]
+}
+
+func newTemplateLiteral[T StringConstraint](s *ZodString[T]) *ZodTemplateLiteral[T] {
+	return &ZodTemplateLiteral[T]{s}
+}
+
+// StrictParse validates input with compile-time type safety.
+func (z *ZodTemplateLiteral[T]) StrictParse(input T, ctx ...*core.ParseContext) (T, error) {
+	return z.ZodString.StrictParse(input, ctx...)
+}
+
+// MustStrictParse validates input with compile-time type safety and panics on error.
+func (z *ZodTemplateLiteral[T]) MustStrictParse(input T, ctx ...*core.ParseContext) T {
+	result, err := z.StrictParse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Optional returns a new schema that accepts nil values.
+func (z *ZodTemplateLiteral[T]) Optional() *ZodTemplateLiteral[*string] {
+	return newTemplateLiteral(z.ZodString.Optional())
+}
+
+// Nilable returns a new schema that accepts nil values.
+func (z *ZodTemplateLiteral[T]) Nilable() *ZodTemplateLiteral[*string] {
+	return newTemplateLiteral(z.ZodString.Nilable())
+}
+
+// Nullish returns a new schema combining optional and nilable.
+func (z *ZodTemplateLiteral[T]) Nullish() *ZodTemplateLiteral[*string] {
+	return newTemplateLiteral(z.ZodString.Nullish())
+}
+
+// TemplateLiteral creates a schema for strings built from parts in order.
+// A part is either a literal value (string, number, bool or nil) matched
+// verbatim, or a schema matched by its pattern: Literal and Enum match their
+// values, integer and float schemas match decimal numbers, Bool matches
+// "true" or "false", and string schemas match their regex or format pattern,
+// or any text within their length bounds. It panics on a part that
+// cannot be expressed as a pattern.
+func TemplateLiteral(parts ...any) *ZodTemplateLiteral[string] {
+	return TemplateLiteralTyped[string](parts...)
+}
+
+// TemplateLiteralPtr creates a *string template literal schema.
+func TemplateLiteralPtr(parts ...any) *ZodTemplateLiteral[*string] {
+	return TemplateLiteralTyped[*string](parts...)
+}
+
+// TemplateLiteralTyped creates a template literal schema with the given type constraint.
+func TemplateLiteralTyped[T StringConstraint](parts ...any) *ZodTemplateLiteral[T] {
+	segments := make([]string, len(parts))
+	for i, part := range parts {
+		segments[i] = templateSegment(part)
+	}
+	base := StringTyped[T]()
+	internals := base.Internals().Clone()
+	internals.AddCheck(checks.TemplateLiteral(segments))
+	return newTemplateLiteral(base.withInternals(internals))
+}
+
+// templateSegment returns the unanchored regex source matching one part.
+func templateSegment(part any) string {
+	if schema, ok := part.(core.ZodSchema); ok {
+		return templateSchemaSegment(schema)
+	}
+	if part == nil {
+		return "null"
+	}
+	switch reflect.ValueOf(part).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return regexp.QuoteMeta(fmt.Sprint(part))
+	default:
+		panic(fmt.Sprintf("TemplateLiteral: unsupported literal part %T", part))
+	}
+}
+
+func templateSchemaSegment(schema core.ZodSchema) string {
+	in := schema.Internals()
+	switch in.Type {
+	case core.ZodTypeLiteral, core.ZodTypeEnum:
+		values := make([]string, 0, len(in.Values))
+		for v := range in.Values {
+			values = append(values, templateSegment(v))
+		}
+		slices.Sort(values)
+		return strings.Join(values, "|")
+	case core.ZodTypeInt, core.ZodTypeInt8, core.ZodTypeInt16, core.ZodTypeInt32, core.ZodTypeInt64,
+		core.ZodTypeUint, core.ZodTypeUint8, core.ZodTypeUint16, core.ZodTypeUint32, core.ZodTypeUint64,
+		core.ZodTypeInteger, core.ZodTypeBigInt:
+		return unanchoredPattern(regex.Integer)
+	case core.ZodTypeFloat, core.ZodTypeFloat32, core.ZodTypeFloat64, core.ZodTypeNumber:
+		return unanchoredPattern(regex.Number)
+	case core.ZodTypeBool:
+		return "true|false"
+	case core.ZodTypeNil:
+		return "null"
+	}
+
+	var regexPatterns, formatPatterns []string
+	minLen, maxLen := 0, 0
+	for _, check := range in.Checks {
+		def := check.Zod().Def
+		p, hasPattern := def.Params["pattern"].(string)
+		_, isFormat := def.Params["format"]
+		switch {
+		case def.Check == "regex" && hasPattern:
+			regexPatterns = appendUnique(regexPatterns, p)
+			continue
+		case isFormat && hasPattern:
+			formatPatterns = appendUnique(formatPatterns, p)
+			continue
+		case def.Check == "min_length":
+			minLen, _ = def.Params["minimum"].(int)
+			continue
+		case def.Check == "max_length":
+			maxLen, _ = def.Params["maximum"].(int)
+			continue
+		case def.Check == "length_equals":
+			minLen, _ = def.Params["exact"].(int)
+			maxLen = minLen
+			continue
+		case def.Check == "length_range":
+			minLen, _ = def.Params["minimum"].(int)
+			maxLen, _ = def.Params["maximum"].(int)
+			continue
+		}
+		panic(fmt.Sprintf("TemplateLiteral: %s check on a %s part has no pattern", def.Check, in.Type))
+	}
+
+	// An explicit Regex narrows a format, as UUIDv4 does over UUID.
+	patterns := regexPatterns
+	if len(patterns) == 0 {
+		patterns = formatPatterns
+	}
+	switch {
+	case len(patterns) == 1:
+		return unanchoredPattern(regexp.MustCompile(patterns[0]))
+	case len(patterns) > 1:
+		panic(fmt.Sprintf("TemplateLiteral: %s part has %d patterns, want one", in.Type, len(patterns)))
+	case in.Type == core.ZodTypeString:
+		return unanchoredPattern(regex.StringRegex(minLen, maxLen))
+	default:
+		panic(fmt.Sprintf("TemplateLiteral: unsupported %s part", in.Type))
+	}
+}
+
+func appendUnique(patterns []string, p string) []string {
+	if slices.Contains(patterns, p) {
+		return patterns
+	}
+	return append(patterns, p)
+}
+
+// unanchoredPattern strips the leading ^ and trailing $ from re so it can be
+// embedded in a larger pattern.
+func unanchoredPattern(re *regexp.Regexp) string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil || parsed.Op != syntax.OpConcat {
+		return re.String()
+	}
+	sub := parsed.Sub
+	if len(sub) > 0 && (sub[0].Op == syntax.OpBeginText || sub[0].Op == syntax.OpBeginLine) {
+		sub = sub[1:]
+	}
+	if n := len(sub); n > 0 && (sub[n-1].Op == syntax.OpEndText || sub[n-1].Op == syntax.OpEndLine) {
+		sub = sub[:n-1]
+	}
+	parsed.Sub = sub
+	return parsed.String()
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+func TestTemplateLiteral_ComposesParts(t *testing.T) {
+	schema := TemplateLiteral("order-", Int(), "-", Enum("eu", "us"), ":", UUID())
+
+	got, err := schema.Parse("order-42-eu:0b6f0f0e-6c55-4b6e-9a57-8f3b0f6b8a11")
+	require.NoError(t, err)
+	assert.Equal(t, "order-42-eu:0b6f0f0e-6c55-4b6e-9a57-8f3b0f6b8a11", got)
+
+	_, err = schema.Parse("order-42-eu:not-a-uuid")
+	require.Error(t, err)
+	_, err = schema.Parse(42)
+	require.Error(t, err)
+
+	literals := TemplateLiteral("v", 2, ".", Literal("x"), "-", true)
+	_, err = literals.Parse("v2.x-true")
+	require.NoError(t, err)
+	_, err = literals.Parse("v2.x-false")
+	require.Error(t, err)
+}
+
+func TestTemplateLiteral_StringPartBounds(t *testing.T) {
+	schema := TemplateLiteral("#", String().Min(2).Max(3), "!")
+
+	_, err := schema.Parse("#abc!")
+	require.NoError(t, err)
+	_, err = schema.Parse("#a!")
+	require.Error(t, err)
+	_, err = schema.Parse("#abcd!")
+	require.Error(t, err)
+}
+
+func TestTemplateLiteral_ReportsFailedSegment(t *testing.T) {
+	schema := TemplateLiteral("user-", Int(), "@", Enum("a", "b"))
+
+	tests := []struct {
+		input   string
+		segment int
+		pattern string
+	}{
+		{"admin-1@a", 0, "user-"},
+		{"user-x@a", 1, `-?[0-9]+`},
+		{"user-1@c", 3, "a|b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := schema.Parse(tt.input)
+			var zodErr *issues.ZodError
+			require.True(t, issues.IsZodError(err, &zodErr))
+			require.Len(t, zodErr.Issues, 1)
+			issue := zodErr.Issues[0]
+			assert.Equal(t, core.InvalidFormat, issue.Code)
+			assert.Equal(t, "template_literal", issue.Format)
+			assert.Equal(t, tt.pattern, issue.Pattern)
+			assert.Equal(t, tt.segment, issue.Params["segment"])
+		})
+	}
+}
+
+func TestTemplateLiteral_Modifiers(t *testing.T) {
+	schema := TemplateLiteral("id-", Int()).Optional()
+
+	got, err := schema.Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	fallback, err := TemplateLiteral("id-", Int()).Catch("id-0").Parse("id-x")
+	require.NoError(t, err)
+	assert.Equal(t, "id-0", fallback)
+}
+
+func TestTemplateLiteral_PanicsOnUnsupportedPart(t *testing.T) {
+	assert.Panics(t, func() { TemplateLiteral(Slice[string](String())) })
+	assert.Panics(t, func() { TemplateLiteral(String().StartsWith("a")) })
+	assert.Panics(t, func() { TemplateLiteral([]string{"a"}) })
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	"github.com/kaptinlin/gozod/core"
@@ -110,6 +111,18 @@ func (z *ZodTime[T]) ParseAny(input any, ctx ...*core.ParseContext) (any, error)
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodTime[T]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (T, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodTime[T]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // Modifier Methods
 // =============================================================================
@@ -193,6 +206,24 @@ func (z *ZodTime[T]) PrefaultFunc(fn func() time.Time) *ZodTime[T] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodTime[T]) Catch(v time.Time) *ZodTime[T] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodTime[T]) CatchFunc(fn func(core.CatchContext) time.Time) *ZodTime[T] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any {
+		return fn(ctx)
+	})
+	return z.withInternals(in)
+}
+
 // =============================================================================
 // Metadata Methods
 // =============================================================================
@@ -209,6 +240,40 @@ func (z *ZodTime[T]) Describe(desc string) *ZodTime[T] {
 	return z.Meta(core.GlobalMeta{Description: desc})
 }
 
+// =============================================================================
+// Range Validation Methods
+// =============================================================================
+
+// Before adds validation that the time is strictly before limit.
+func (z *ZodTime[T]) Before(limit time.Time, params ...any) *ZodTime[T] {
+	return z.withCheck(checks.Before(limit, params...))
+}
+
+// After adds validation that the time is strictly after limit.
+func (z *ZodTime[T]) After(limit time.Time, params ...any) *ZodTime[T] {
+	return z.withCheck(checks.After(limit, params...))
+}
+
+// Between adds validation that the time lies within [start, end], inclusive.
+func (z *ZodTime[T]) Between(start, end time.Time, params ...any) *ZodTime[T] {
+	in := z.internals.Clone()
+	in.AddCheck(checks.NotBefore(start, params...))
+	in.AddCheck(checks.NotAfter(end, params...))
+	return z.withInternals(in)
+}
+
+// Past adds validation that the time is strictly before the parse clock.
+// The clock defaults to time.Now and can be injected via core.ParseContext.Now.
+func (z *ZodTime[T]) Past(params ...any) *ZodTime[T] {
+	return z.withCheck(checks.Past(params...))
+}
+
+// Future adds validation that the time is strictly after the parse clock.
+// The clock defaults to time.Now and can be injected via core.ParseContext.Now.
+func (z *ZodTime[T]) Future(params ...any) *ZodTime[T] {
+	return z.withCheck(checks.Future(params...))
+}
+
 // =============================================================================
 // Transformation and Validation Methods
 // =============================================================================
@@ -244,7 +309,24 @@ func (z *ZodTime[T]) Pipe(target core.ZodType[any]) *core.ZodPipe[T, any] {
 
 // Refine applies a custom validation function matching the schema's output type T.
 func (z *ZodTime[T]) Refine(fn func(T) bool, params ...any) *ZodTime[T] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v T) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodTime[T]) RefineContext(fn func(context.Context, T) bool, params ...any) *ZodTime[T] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodTime[T]) RefineParallel(fn func(context.Context, T) bool, params ...any) *ZodTime[T] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodTime[T]) refine(fn func(context.Context, T) bool, parallel bool, params ...any) *ZodTime[T] {
+	wrapper := func(ctx context.Context, v any) bool {
 		var zero T
 		switch any(zero).(type) {
 		case time.Time:
@@ -252,15 +334,15 @@ func (z *ZodTime[T]) Refine(fn func(T) bool, params ...any) *ZodTime[T] {
 				return false
 			}
 			if timeVal, ok := v.(time.Time); ok {
-				return fn(any(timeVal).(T))
+				return fn(ctx, any(timeVal).(T))
 			}
 			return false
 		case *time.Time:
 			if v == nil {
-				return fn(any((*time.Time)(nil)).(T))
+				return fn(ctx, any((*time.Time)(nil)).(T))
 			}
 			if timeVal, ok := v.(time.Time); ok {
-				return fn(any(&timeVal).(T))
+				return fn(ctx, any(&timeVal).(T))
 			}
 			return false
 		default:
@@ -274,7 +356,7 @@ func (z *ZodTime[T]) Refine(fn func(T) bool, params ...any) *ZodTime[T] {
 		msg = sp.Error
 	}
 
-	check := checks.NewCustom[any](wrapper, msg)
+	check := checks.WithParallel(checks.NewCustom[any](wrapper, msg), parallel)
 	return z.withCheck(check)
 }
 
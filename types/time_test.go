@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
 	. "github.com/kaptinlin/gozod/types"
 )
 
@@ -552,6 +553,103 @@ func TestTime_Refine(t *testing.T) {
 	})
 }
 
+func TestTime_RangeChecks(t *testing.T) {
+	lower := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	upper := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("After rejects bound and earlier times", func(t *testing.T) {
+		schema := Time().After(lower)
+
+		_, err := schema.Parse(lower.Add(time.Second))
+		require.NoError(t, err)
+
+		_, err = schema.Parse(lower)
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+		assert.Equal(t, "date", zodErr.Issues[0].Origin)
+		assert.Equal(t, lower, zodErr.Issues[0].Minimum)
+		assert.False(t, zodErr.Issues[0].Inclusive)
+	})
+
+	t.Run("Before rejects bound and later times", func(t *testing.T) {
+		schema := TimePtr().Before(upper)
+
+		_, err := schema.Parse(upper.Add(-time.Second))
+		require.NoError(t, err)
+
+		_, err = schema.Parse(&upper)
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.TooBig, zodErr.Issues[0].Code)
+		assert.Equal(t, "date", zodErr.Issues[0].Origin)
+		assert.Equal(t, upper, zodErr.Issues[0].Maximum)
+	})
+
+	t.Run("Between is inclusive on both ends", func(t *testing.T) {
+		schema := Time().Between(lower, upper)
+
+		for _, valid := range []time.Time{lower, upper, lower.AddDate(1, 0, 0)} {
+			_, err := schema.Parse(valid)
+			require.NoError(t, err, valid)
+		}
+		_, err := schema.Parse(lower.Add(-time.Nanosecond))
+		require.Error(t, err)
+		_, err = schema.Parse(upper.Add(time.Nanosecond))
+		require.Error(t, err)
+	})
+
+	t.Run("Past and Future read the parse context clock", func(t *testing.T) {
+		now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		ctx := &core.ParseContext{Now: func() time.Time { return now }}
+
+		_, err := Time().Past().Parse(now.Add(-time.Minute), ctx)
+		require.NoError(t, err)
+		_, err = Time().Past().Parse(now, ctx)
+		require.Error(t, err)
+
+		_, err = Time().Future().Parse(now.Add(time.Minute), ctx)
+		require.NoError(t, err)
+		_, err = Time().Future().Parse(now.Add(-time.Minute), ctx)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+		assert.Equal(t, now, zodErr.Issues[0].Minimum)
+	})
+
+	t.Run("Past defaults to the wall clock", func(t *testing.T) {
+		_, err := Time().Past().Parse(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		_, err = Time().Past().Parse(time.Now().Add(time.Hour))
+		require.Error(t, err)
+	})
+
+	t.Run("custom error message", func(t *testing.T) {
+		_, err := Time().After(lower, "too early").Parse(lower)
+		require.Error(t, err)
+
+		var zodErr *issues.ZodError
+		require.True(t, issues.IsZodError(err, &zodErr))
+		assert.Equal(t, "too early", zodErr.Issues[0].Message)
+	})
+
+	t.Run("range checks are copy-on-write", func(t *testing.T) {
+		base := Time()
+		_ = base.After(upper)
+
+		_, err := base.Parse(lower)
+		require.NoError(t, err)
+	})
+}
+
 func TestTime_RefineAny(t *testing.T) {
 	t.Run("refineAny time schema", func(t *testing.T) {
 		// Only accept times after 2023 via RefineAny on Time() schema
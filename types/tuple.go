@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -65,6 +66,12 @@ func (z *ZodTuple[T, R]) Rest() core.ZodSchema {
 	return z.internals.Rest
 }
 
+// evaluate reports that a tuple evaluates every item: items past the fixed
+// ones are either validated by the rest schema or rejected.
+func (z *ZodTuple[T, R]) evaluate(any, *core.ParseContext) evaluation {
+	return evaluation{allItems: true}
+}
+
 // IsOptional reports whether this schema accepts undefined or missing values.
 func (z *ZodTuple[T, R]) IsOptional() bool {
 	return z.internals.IsOptional()
@@ -167,6 +174,18 @@ func (z *ZodTuple[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodTuple[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodTuple[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // MODIFIER METHODS
 // =============================================================================
@@ -207,6 +226,30 @@ func (z *ZodTuple[T, R]) Default(v []any) *ZodTuple[T, R] {
 	return z.withInternals(newInternals)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodTuple[T, R]) Catch(v []any) *ZodTuple[T, R] {
+	newInternals := z.internals.Clone()
+	newInternals.SetCatchValue(v)
+	return z.withInternals(newInternals)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodTuple[T, R]) CatchFunc(fn func(core.CatchContext) []any) *ZodTuple[T, R] {
+	newInternals := z.internals.Clone()
+	newInternals.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(newInternals)
+}
+
+// Readonly returns a deep copy of every successful result, so parsed values
+// never share backing storage with the input or with earlier results.
+func (z *ZodTuple[T, R]) Readonly() *ZodTuple[T, R] {
+	in := z.internals.Clone()
+	in.SetReadonly(true)
+	return z.withInternals(in)
+}
+
 // =============================================================================
 // VALIDATION METHODS
 // =============================================================================
@@ -237,16 +280,49 @@ func (z *ZodTuple[T, R]) NonEmpty(params ...any) *ZodTuple[T, R] {
 	return z.Min(1, params...)
 }
 
+// Unique requires all elements to be distinct, like JSON Schema
+// uniqueItems. Each duplicate is reported at its index, with the index of the
+// first occurrence in the issue's Params["firstIndex"].
+func (z *ZodTuple[T, R]) Unique(params ...any) *ZodTuple[T, R] {
+	newInternals := z.internals.Clone()
+	newInternals.AddCheck(checks.Unique(nil, params...))
+	return z.withInternals(newInternals)
+}
+
+// UniqueBy is like Unique but compares elements by the value key returns.
+func (z *ZodTuple[T, R]) UniqueBy(key func(any) any, params ...any) *ZodTuple[T, R] {
+	newInternals := z.internals.Clone()
+	newInternals.AddCheck(checks.Unique(key, params...))
+	return z.withInternals(newInternals)
+}
+
 // Refine adds a custom validation function.
 func (z *ZodTuple[T, R]) Refine(fn func([]any) bool, params ...any) *ZodTuple[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v []any) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodTuple[T, R]) RefineContext(fn func(context.Context, []any) bool, params ...any) *ZodTuple[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodTuple[T, R]) RefineParallel(fn func(context.Context, []any) bool, params ...any) *ZodTuple[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodTuple[T, R]) refine(fn func(context.Context, []any) bool, parallel bool, params ...any) *ZodTuple[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if arr, ok := v.([]any); ok {
-			return fn(arr)
+			return fn(ctx, arr)
 		}
 		return false
 	}
 	newInternals := z.internals.Clone()
-	newInternals.AddCheck(checks.NewCustom[any](wrapper, params...))
+	newInternals.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, params...), parallel))
 	return z.withInternals(newInternals)
 }
 
@@ -349,6 +425,9 @@ func (z *ZodTuple[T, R]) validateTupleForEngine(arr []any, chks []core.ZodCheck,
 		if i >= len(arr) {
 			break
 		}
+		if issue, canceled := canceledIssue(ctx, arr); canceled {
+			return nil, issues.CreateArrayValidationIssues(append(collectedIssues, issue))
+		}
 
 		val, err := schema.ParseAny(arr[i], ctx)
 		if err != nil {
@@ -361,6 +440,9 @@ func (z *ZodTuple[T, R]) validateTupleForEngine(arr []any, chks []core.ZodCheck,
 	// Validate rest elements (beyond fixed items).
 	if z.internals.Rest != nil && len(arr) > len(z.internals.Items) {
 		for i := len(z.internals.Items); i < len(arr); i++ {
+			if issue, canceled := canceledIssue(ctx, arr); canceled {
+				return nil, issues.CreateArrayValidationIssues(append(collectedIssues, issue))
+			}
 			val, err := z.internals.Rest.ParseAny(arr[i], ctx)
 			if err != nil {
 				collectedIssues = append(collectedIssues, collectParseIssues(err, i, arr[i])...)
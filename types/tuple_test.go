@@ -705,3 +705,24 @@ func TestTuple_EdgeCases(t *testing.T) {
 		assert.Len(t, result, 2)
 	})
 }
+
+func TestTuple_Unique(t *testing.T) {
+	schema := TupleWithRest([]core.ZodSchema{String()}, String()).Unique()
+
+	_, err := schema.Parse([]any{"a", "b", "c"})
+	require.NoError(t, err)
+
+	_, err = schema.Parse([]any{"a", "b", "a"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+	assert.Equal(t, []any{2}, zodErr.Issues[0].Path)
+	assert.Equal(t, 0, zodErr.Issues[0].Params["firstIndex"])
+
+	byLength := Tuple(String(), String()).UniqueBy(func(v any) any { return len(v.(string)) })
+	_, err = byLength.Parse([]any{"a", "bb"})
+	require.NoError(t, err)
+	_, err = byLength.Parse([]any{"a", "b"})
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"slices"
@@ -23,6 +24,8 @@ type ZodUnionInternals struct {
 	core.ZodTypeInternals
 	Def     *ZodUnionDef
 	Options []core.ZodSchema
+	// unevaluated validates what no accepting option evaluated, when set.
+	unevaluated unevaluatedSchemas
 }
 
 // ZodUnion validates that input matches at least one member schema.
@@ -113,6 +116,14 @@ func (z *ZodUnion[T, R]) validate(
 	}
 
 	if matched {
+		if z.internals.unevaluated.isSet() {
+			evaluated := evaluatedByAccepting(z.internals.Options, input, parseCtx)
+			closed, err := z.internals.unevaluated.apply(evaluated, input, match, parseCtx)
+			if err != nil {
+				return nil, err
+			}
+			match = closed
+		}
 		if len(chks) > 0 {
 			return engine.ApplyChecks[any](match, chks, parseCtx)
 		}
@@ -179,6 +190,18 @@ func (z *ZodUnion[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, er
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodUnion[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodUnion[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a new schema that accepts undefined/missing values.
 func (z *ZodUnion[T, R]) Optional() *ZodUnion[T, *T] {
 	in := z.internals.Clone()
@@ -210,6 +233,7 @@ func (z *ZodUnion[T, R]) NonOptional() *ZodUnion[T, T] {
 		ZodTypeInternals: *in,
 		Def:              z.internals.Def,
 		Options:          z.internals.Options,
+		unevaluated:      z.internals.unevaluated,
 	}}
 }
 
@@ -241,6 +265,22 @@ func (z *ZodUnion[T, R]) PrefaultFunc(fn func() T) *ZodUnion[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodUnion[T, R]) Catch(v T) *ZodUnion[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodUnion[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodUnion[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta attaches metadata to this schema.
 func (z *ZodUnion[T, R]) Meta(meta core.GlobalMeta) *ZodUnion[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -261,6 +301,49 @@ func (z *ZodUnion[T, R]) Options() []core.ZodSchema {
 	return slices.Clone(z.internals.Options)
 }
 
+// Unevaluated validates the object keys and array items that no accepting
+// option evaluated against schema, like JSON Schema unevaluatedProperties
+// and unevaluatedItems together. Unevaluated(Never()) closes the union: keys
+// no accepting option knows are rejected as unrecognized. A nil schema
+// removes it.
+func (z *ZodUnion[T, R]) Unevaluated(schema core.ZodSchema) *ZodUnion[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated = unevaluatedSchemas{properties: schema, items: schema}
+	return clone
+}
+
+// UnevaluatedProperties is like Unevaluated for object keys only, like JSON
+// Schema unevaluatedProperties.
+func (z *ZodUnion[T, R]) UnevaluatedProperties(schema core.ZodSchema) *ZodUnion[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated.properties = schema
+	return clone
+}
+
+// UnevaluatedItems is like Unevaluated for array items only, like JSON Schema
+// unevaluatedItems.
+func (z *ZodUnion[T, R]) UnevaluatedItems(schema core.ZodSchema) *ZodUnion[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated.items = schema
+	return clone
+}
+
+// UnevaluatedPropertiesSchema returns the schema for unevaluated object keys, or nil.
+func (z *ZodUnion[T, R]) UnevaluatedPropertiesSchema() core.ZodSchema {
+	return z.internals.unevaluated.properties
+}
+
+// UnevaluatedItemsSchema returns the schema for unevaluated array items, or nil.
+func (z *ZodUnion[T, R]) UnevaluatedItemsSchema() core.ZodSchema {
+	return z.internals.unevaluated.items
+}
+
+// evaluate reports what the accepting options and the unevaluated schemas
+// evaluate of value.
+func (z *ZodUnion[T, R]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	return z.internals.unevaluated.evaluation(evaluatedByAccepting(z.internals.Options, value, ctx))
+}
+
 // Transform creates a type-safe transformation pipeline.
 func (z *ZodUnion[T, R]) Transform(
 	fn func(T, *core.RefinementContext) (any, error),
@@ -286,16 +369,43 @@ func (z *ZodUnion[T, R]) Refine(
 	fn func(R) bool,
 	params ...any,
 ) *ZodUnion[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodUnion[T, R]) RefineContext(
+	fn func(context.Context, R) bool,
+	params ...any,
+) *ZodUnion[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodUnion[T, R]) RefineParallel(
+	fn func(context.Context, R) bool,
+	params ...any,
+) *ZodUnion[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodUnion[T, R]) refine(
+	fn func(context.Context, R) bool,
+	parallel bool,
+	params ...any,
+) *ZodUnion[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		cv, ok := convertToUnionConstraint[T, R](v)
 		if !ok {
 			return false
 		}
-		return fn(cv)
+		return fn(ctx, cv)
 	}
 
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	return z.withInternals(in)
 }
 
@@ -326,6 +436,7 @@ func (z *ZodUnion[T, R]) withPtrInternals(
 		ZodTypeInternals: *in,
 		Def:              z.internals.Def,
 		Options:          z.internals.Options,
+		unevaluated:      z.internals.unevaluated,
 	}}
 	finalizeClone(clone)
 	return clone
@@ -338,6 +449,7 @@ func (z *ZodUnion[T, R]) withInternals(
 		ZodTypeInternals: *in,
 		Def:              z.internals.Def,
 		Options:          z.internals.Options,
+		unevaluated:      z.internals.unevaluated,
 	}}
 	finalizeClone(clone)
 	return clone
@@ -351,6 +463,7 @@ func (z *ZodUnion[T, R]) CloneFrom(source any) {
 				ZodTypeInternals: *src.internals.Clone(),
 				Def:              src.internals.Def,
 				Options:          src.internals.Options,
+				unevaluated:      src.internals.unevaluated,
 			}
 		})
 	}
@@ -720,3 +720,52 @@ func TestZodXor_EnhancedCoverage(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestZodUnion_Unevaluated(t *testing.T) {
+	card := Object(core.ObjectSchema{"card": String()})
+	iban := Object(core.ObjectSchema{"iban": String()})
+	schema := Union([]any{card, iban}).Unevaluated(Never())
+
+	_, err := schema.Parse(map[string]any{"card": "4242"})
+	require.NoError(t, err)
+	_, err = schema.Parse(map[string]any{"card": "4242", "iban": "DE00"})
+	require.NoError(t, err, "keys evaluated by every accepting option count")
+
+	_, err = schema.Parse(map[string]any{"card": "4242", "note": "x"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.UnrecognizedKeys, zodErr.Issues[0].Code)
+	assert.Equal(t, []string{"note"}, zodErr.Issues[0].Keys)
+
+	t.Run("unevaluated items", func(t *testing.T) {
+		schema := Union([]any{String(), Unknown()}).UnevaluatedItems(Int())
+		_, err := schema.Parse([]any{1, 2})
+		require.NoError(t, err)
+		_, err = schema.Parse([]any{1, "2"})
+		require.True(t, issues.IsZodError(err, &zodErr))
+		require.Len(t, zodErr.Issues, 1)
+		assert.Equal(t, core.InvalidElement, zodErr.Issues[0].Code)
+		assert.Equal(t, []any{1}, zodErr.Issues[0].Path)
+
+		_, err = Union([]any{Slice[any](Unknown()), Unknown()}).UnevaluatedItems(Int()).Parse([]any{"a"})
+		require.NoError(t, err, "slices evaluate every item")
+	})
+}
+
+func TestZodXor_Unevaluated(t *testing.T) {
+	card := Object(core.ObjectSchema{"card": String()})
+	iban := Object(core.ObjectSchema{"iban": String()})
+	schema := Xor([]any{card, iban}).Unevaluated(Never())
+
+	_, err := schema.Parse(map[string]any{"iban": "DE00"})
+	require.NoError(t, err)
+	_, err = schema.Parse(map[string]any{"iban": "DE00", "note": "x"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.UnrecognizedKeys, zodErr.Issues[0].Code)
+
+	assert.NotNil(t, schema.UnevaluatedPropertiesSchema())
+	assert.Nil(t, Xor([]any{card, iban}).UnevaluatedItemsSchema())
+}
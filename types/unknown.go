@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"github.com/kaptinlin/gozod/core"
 	"github.com/kaptinlin/gozod/internal/checks"
 	"github.com/kaptinlin/gozod/internal/engine"
@@ -102,6 +103,18 @@ func (z *ZodUnknown[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any,
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodUnknown[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodUnknown[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // Optional returns a pointer-typed schema that accepts missing values.
 func (z *ZodUnknown[T, R]) Optional() *ZodUnknown[T, *T] {
 	in := z.internals.Clone()
@@ -172,6 +185,22 @@ func (z *ZodUnknown[T, R]) PrefaultFunc(fn func() T) *ZodUnknown[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodUnknown[T, R]) Catch(v T) *ZodUnknown[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodUnknown[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodUnknown[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta returns a schema with merged metadata.
 func (z *ZodUnknown[T, R]) Meta(meta core.GlobalMeta) *ZodUnknown[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -199,23 +228,40 @@ func (z *ZodUnknown[T, R]) Overwrite(transform func(T) T, params ...any) *ZodUnk
 
 // Refine applies a custom validation function matching the schema's output type R.
 func (z *ZodUnknown[T, R]) Refine(fn func(R) bool, params ...any) *ZodUnknown[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodUnknown[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodUnknown[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodUnknown[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodUnknown[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodUnknown[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodUnknown[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		if r, ok := v.(R); ok {
-			return fn(r)
+			return fn(ctx, r)
 		}
 		// For R=*any, wrap value in pointer for pointer constraint types.
 		var zero R
 		if _, ok := any(zero).(*any); ok {
 			if v == nil {
-				return fn(any((*any)(nil)).(R))
+				return fn(ctx, any((*any)(nil)).(R))
 			}
-			return fn(any(new(v)).(R))
+			return fn(ctx, any(new(v)).(R))
 		}
 		return false
 	}
 
 	in := z.internals.Clone()
-	in.AddCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	in.AddCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 	return z.withInternals(in)
 }
 
@@ -0,0 +1,504 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/checks"
+	"github.com/kaptinlin/gozod/internal/engine"
+	"github.com/kaptinlin/gozod/internal/issues"
+	"github.com/kaptinlin/gozod/internal/utils"
+)
+
+// =============================================================================
+// TYPE DEFINITIONS
+// =============================================================================
+
+// ZodWhenDef defines the schema definition for conditional validation.
+type ZodWhenDef struct {
+	core.ZodTypeDef
+	Condition core.ZodSchema
+	Then      core.ZodSchema // nil accepts input matching Condition
+	Else      core.ZodSchema // nil accepts input not matching Condition
+}
+
+// ZodWhenInternals contains the internal state for conditional schema.
+type ZodWhenInternals struct {
+	core.ZodTypeInternals
+	Def       *ZodWhenDef
+	Condition core.ZodSchema
+	Then      core.ZodSchema
+	Else      core.ZodSchema
+}
+
+// ZodWhen validates input against Then when it matches Condition and against
+// Else otherwise, like JSON Schema if/then/else. The condition only selects
+// the branch: its issues are never reported and its output is discarded.
+// T is the base type, R is the constraint type (T or *T for optional/nilable).
+type ZodWhen[T any, R any] struct {
+	internals *ZodWhenInternals
+}
+
+// =============================================================================
+// CORE METHODS
+// =============================================================================
+
+// Internals returns the internal state for framework usage.
+func (z *ZodWhen[T, R]) Internals() *core.ZodTypeInternals {
+	return &z.internals.ZodTypeInternals
+}
+
+// IsOptional reports whether this schema accepts undefined/missing values.
+func (z *ZodWhen[T, R]) IsOptional() bool {
+	return z.internals.IsOptional()
+}
+
+// IsNilable reports whether this schema accepts nil values.
+func (z *ZodWhen[T, R]) IsNilable() bool {
+	return z.internals.IsNilable()
+}
+
+// Parse validates input against the branch its condition selects.
+func (z *ZodWhen[T, R]) Parse(input any, ctx ...*core.ParseContext) (R, error) {
+	parseCtx := resolveCtx(ctx)
+
+	result, err := engine.ParseComplex[any](
+		input,
+		&z.internals.ZodTypeInternals,
+		core.ZodTypeWhen,
+		z.extractType,
+		z.extractPtr,
+		z.validate,
+		parseCtx,
+	)
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+	return convertUnionToConstraint[T, R](result), nil
+}
+
+func (z *ZodWhen[T, R]) extractType(input any) (any, bool) {
+	return input, true
+}
+
+func (z *ZodWhen[T, R]) extractPtr(input any) (*any, bool) {
+	if input == nil {
+		return nil, true
+	}
+	return &input, true
+}
+
+// validate runs the condition, then the selected branch, then the checks.
+// A condition that fails because the caller context is done selects no
+// branch; the parse reports the cancellation instead.
+func (z *ZodWhen[T, R]) validate(input any, chks []core.ZodCheck, parseCtx *core.ParseContext) (any, error) {
+	branch := z.internals.Else
+	if _, err := z.internals.Condition.ParseAny(input, parseCtx); err == nil {
+		branch = z.internals.Then
+	} else if ctxErr := parseCtx.ContextErr(); ctxErr != nil {
+		return nil, issues.CreateCanceledError(ctxErr, input, parseCtx)
+	}
+
+	result := input
+	if branch != nil {
+		var err error
+		result, err = branch.ParseAny(input, parseCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(chks) > 0 {
+		return engine.ApplyChecks[any](result, chks, parseCtx)
+	}
+	return result, nil
+}
+
+// evaluate reports what the condition, when it matches, and the selected
+// branch evaluate of value, like JSON Schema if/then/else.
+func (z *ZodWhen[T, R]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	branch := z.internals.Else
+	var e evaluation
+	if _, err := z.internals.Condition.ParseAny(value, ctx); err == nil {
+		e = evaluatedBy(z.internals.Condition, value, ctx)
+		branch = z.internals.Then
+	}
+	if branch != nil {
+		e.merge(evaluatedBy(branch, value, ctx))
+	}
+	return e
+}
+
+// MustParse is like Parse but panics on validation failure.
+func (z *ZodWhen[T, R]) MustParse(input any, ctx ...*core.ParseContext) R {
+	result, err := z.Parse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// StrictParse validates input with compile-time type safety.
+// The input must exactly match the schema's base type T.
+func (z *ZodWhen[T, R]) StrictParse(input T, ctx ...*core.ParseContext) (R, error) {
+	constraintInput, ok := convertToUnionConstraint[T, R](input)
+	if !ok {
+		var zero R
+		parseCtx := resolveCtx(ctx)
+		return zero, issues.CreateTypeConversionError(
+			fmt.Sprintf("%T", input),
+			"when constraint type",
+			any(input),
+			parseCtx,
+		)
+	}
+
+	return engine.ParseComplexStrict[any, R](
+		constraintInput,
+		&z.internals.ZodTypeInternals,
+		core.ZodTypeWhen,
+		z.extractType,
+		z.extractPtr,
+		z.validate,
+		ctx...,
+	)
+}
+
+// MustStrictParse is like StrictParse but panics on validation failure.
+func (z *ZodWhen[T, R]) MustStrictParse(input T, ctx ...*core.ParseContext) R {
+	result, err := z.StrictParse(input, ctx...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseAny validates input and returns an untyped result for runtime scenarios.
+func (z *ZodWhen[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, error) {
+	return z.Parse(input, ctx...)
+}
+
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodWhen[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodWhen[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
+// =============================================================================
+// MODIFIER METHODS
+// =============================================================================
+
+// Optional returns a new schema that accepts undefined/missing values.
+func (z *ZodWhen[T, R]) Optional() *ZodWhen[T, *T] {
+	in := z.internals.Clone()
+	in.SetOptional(true)
+	return z.withPtrInternals(in)
+}
+
+// Nilable returns a new schema that accepts nil values.
+func (z *ZodWhen[T, R]) Nilable() *ZodWhen[T, *T] {
+	in := z.internals.Clone()
+	in.SetNilable(true)
+	return z.withPtrInternals(in)
+}
+
+// Nullish returns a new schema that accepts both undefined and nil values.
+func (z *ZodWhen[T, R]) Nullish() *ZodWhen[T, *T] {
+	in := z.internals.Clone()
+	in.SetOptional(true)
+	in.SetNilable(true)
+	return z.withPtrInternals(in)
+}
+
+// NonOptional returns a new schema that enforces non-nil values.
+func (z *ZodWhen[T, R]) NonOptional() *ZodWhen[T, T] {
+	in := z.internals.Clone()
+	in.SetOptional(false)
+	in.SetNonOptional(true)
+	return &ZodWhen[T, T]{internals: z.cloneState(in)}
+}
+
+// Default sets a value to use when input is nil, bypassing validation.
+func (z *ZodWhen[T, R]) Default(v T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetDefaultValue(v)
+	return z.withInternals(in)
+}
+
+// DefaultFunc sets a function to produce the default value when input is nil.
+func (z *ZodWhen[T, R]) DefaultFunc(fn func() T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetDefaultFunc(func() any { return fn() })
+	return z.withInternals(in)
+}
+
+// Prefault sets a fallback value that goes through the full validation pipeline.
+func (z *ZodWhen[T, R]) Prefault(v T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetPrefaultValue(v)
+	return z.withInternals(in)
+}
+
+// PrefaultFunc sets a function to produce the prefault value.
+func (z *ZodWhen[T, R]) PrefaultFunc(fn func() T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetPrefaultFunc(func() any { return fn() })
+	return z.withInternals(in)
+}
+
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodWhen[T, R]) Catch(v T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodWhen[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
+// Meta attaches metadata to this schema.
+func (z *ZodWhen[T, R]) Meta(meta core.GlobalMeta) *ZodWhen[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	core.ApplySchemaMeta(z, clone, meta)
+	return clone
+}
+
+// Describe sets a human-readable description for this schema.
+func (z *ZodWhen[T, R]) Describe(description string) *ZodWhen[T, R] {
+	return z.Meta(core.GlobalMeta{Description: description})
+}
+
+// =============================================================================
+// TYPE-SPECIFIC METHODS
+// =============================================================================
+
+// Condition returns the schema that selects the branch.
+func (z *ZodWhen[T, R]) Condition() core.ZodSchema {
+	return z.internals.Condition
+}
+
+// Then returns the schema applied when the condition matches, or nil.
+func (z *ZodWhen[T, R]) Then() core.ZodSchema {
+	return z.internals.Then
+}
+
+// Else returns the schema applied when the condition does not match, or nil.
+func (z *ZodWhen[T, R]) Else() core.ZodSchema {
+	return z.internals.Else
+}
+
+// =============================================================================
+// TRANSFORMATION AND PIPELINE METHODS
+// =============================================================================
+
+// Transform creates a type-safe transformation pipeline.
+func (z *ZodWhen[T, R]) Transform(fn func(T, *core.RefinementContext) (any, error)) *core.ZodTransform[R, any] {
+	wrapperFn := func(input R, ctx *core.RefinementContext) (any, error) {
+		return fn(extractUnionValue[T, R](input), ctx)
+	}
+	return core.NewZodTransform[R, any](z, wrapperFn)
+}
+
+// Pipe chains this schema's output into another schema for further validation.
+func (z *ZodWhen[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
+	wrapperFn := func(input R, ctx *core.ParseContext) (any, error) {
+		return target.Parse(extractUnionValue[T, R](input), ctx)
+	}
+	return core.NewZodPipe[R, any](z, target, wrapperFn)
+}
+
+// =============================================================================
+// REFINEMENT METHODS
+// =============================================================================
+
+// Refine adds a custom validation check with type-safe access to the parsed value.
+func (z *ZodWhen[T, R]) Refine(fn func(R) bool, params ...any) *ZodWhen[T, R] {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodWhen[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodWhen[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodWhen[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodWhen[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodWhen[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodWhen[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
+		cv, ok := convertToUnionConstraint[T, R](v)
+		if !ok {
+			return false
+		}
+		return fn(ctx, cv)
+	}
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
+}
+
+// RefineAny adds a custom validation check operating on the raw value.
+func (z *ZodWhen[T, R]) RefineAny(fn func(any) bool, params ...any) *ZodWhen[T, R] {
+	return z.withCheck(checks.NewCustom[any](fn, utils.NormalizeCustomParams(params...)))
+}
+
+// =============================================================================
+// COMPOSITION METHODS (Zod v4 Compatibility)
+// =============================================================================
+
+// And creates an intersection of this schema with another.
+func (z *ZodWhen[T, R]) And(other any) *ZodIntersection[any, any] {
+	return Intersection(z, other)
+}
+
+// Or creates a union of this schema with another, enabling chaining.
+func (z *ZodWhen[T, R]) Or(other any) *ZodUnion[any, any] {
+	return Union([]any{z, other})
+}
+
+// =============================================================================
+// HELPER METHODS
+// =============================================================================
+
+func (z *ZodWhen[T, R]) withCheck(c core.ZodCheck) *ZodWhen[T, R] {
+	in := z.internals.Clone()
+	in.AddCheck(c)
+	return z.withInternals(in)
+}
+
+// cloneState pairs in with the definition and branches of z.
+func (z *ZodWhen[T, R]) cloneState(in *core.ZodTypeInternals) *ZodWhenInternals {
+	return &ZodWhenInternals{
+		ZodTypeInternals: *in,
+		Def:              z.internals.Def,
+		Condition:        z.internals.Condition,
+		Then:             z.internals.Then,
+		Else:             z.internals.Else,
+	}
+}
+
+func (z *ZodWhen[T, R]) withPtrInternals(in *core.ZodTypeInternals) *ZodWhen[T, *T] {
+	clone := &ZodWhen[T, *T]{internals: z.cloneState(in)}
+	finalizeClone(clone)
+	return clone
+}
+
+func (z *ZodWhen[T, R]) withInternals(in *core.ZodTypeInternals) *ZodWhen[T, R] {
+	clone := &ZodWhen[T, R]{internals: z.cloneState(in)}
+	finalizeClone(clone)
+	return clone
+}
+
+// CloneFrom copies configuration from another schema.
+func (z *ZodWhen[T, R]) CloneFrom(source any) {
+	src, ok := source.(*ZodWhen[T, R])
+	if !ok || src == nil {
+		return
+	}
+	cloneWithPreservedChecks(src, z, func() {
+		z.internals = src.cloneState(src.internals.Clone())
+	})
+}
+
+// =============================================================================
+// CONSTRUCTOR FUNCTIONS
+// =============================================================================
+
+func newZodWhenFromDef[T any, R any](def *ZodWhenDef) *ZodWhen[T, R] {
+	internals := &ZodWhenInternals{
+		ZodTypeInternals: engine.NewBaseZodTypeInternals(def.Type),
+		Def:              def,
+		Condition:        def.Condition,
+		Then:             def.Then,
+		Else:             def.Else,
+	}
+
+	internals.Constructor = func(newDef *core.ZodTypeDef) core.ZodType[any] {
+		whenDef := &ZodWhenDef{
+			ZodTypeDef: *newDef,
+			Condition:  def.Condition,
+			Then:       def.Then,
+			Else:       def.Else,
+		}
+		return any(newZodWhenFromDef[T, R](whenDef)).(core.ZodType[any])
+	}
+
+	schema := &ZodWhen[T, R]{internals: internals}
+
+	if def.Error != nil {
+		internals.Error = def.Error
+	}
+	for _, check := range def.Checks {
+		internals.AddCheck(check)
+	}
+
+	return schema
+}
+
+// =============================================================================
+// FACTORY FUNCTIONS
+// =============================================================================
+
+// When creates a conditional schema: input that parses with condition is
+// validated by then, other input by otherwise. A nil then or otherwise
+// accepts its branch unchanged. The condition result is discarded.
+func When(condition, then, otherwise any, args ...any) *ZodWhen[any, any] {
+	return WhenTyped[any, any](condition, then, otherwise, args...)
+}
+
+// WhenPtr creates a conditional schema with pointer constraint type.
+func WhenPtr(condition, then, otherwise any, args ...any) *ZodWhen[any, *any] {
+	return WhenTyped[any, *any](condition, then, otherwise, args...)
+}
+
+// WhenTyped creates a typed conditional schema with generic constraints.
+func WhenTyped[T any, R any](condition, then, otherwise any, args ...any) *ZodWhen[T, R] {
+	param := utils.FirstParam(args...)
+	normalizedParams := utils.NormalizeParams(param)
+
+	if condition == nil {
+		panic("When condition: schema is required")
+	}
+	def := &ZodWhenDef{
+		ZodTypeDef: core.ZodTypeDef{
+			Type:   core.ZodTypeWhen,
+			Checks: []core.ZodCheck{},
+		},
+		Condition: whenBranch("condition", condition),
+		Then:      whenBranch("then", then),
+		Else:      whenBranch("else", otherwise),
+	}
+	if normalizedParams != nil {
+		utils.ApplySchemaParams(&def.ZodTypeDef, normalizedParams)
+	}
+
+	return newZodWhenFromDef[T, R](def)
+}
+
+func whenBranch(name string, schema any) core.ZodSchema {
+	if schema == nil {
+		return nil
+	}
+	zodSchema, err := core.ConvertToZodSchema(schema)
+	if err != nil {
+		panic(fmt.Sprintf("When %s: %v", name, err))
+	}
+	return zodSchema
+}
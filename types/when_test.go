@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kaptinlin/gozod/core"
+	"github.com/kaptinlin/gozod/internal/issues"
+	. "github.com/kaptinlin/gozod/types"
+)
+
+func TestWhen_SelectsBranchByCondition(t *testing.T) {
+	schema := When(String(), String().Min(3), Int().Positive())
+
+	result, err := schema.Parse("abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", result)
+
+	result, err = schema.Parse(7)
+	require.NoError(t, err)
+	assert.Equal(t, 7, result)
+
+	_, err = schema.Parse("ab")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+
+	_, err = schema.Parse(-1)
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.TooSmall, zodErr.Issues[0].Code)
+}
+
+func TestWhen_NilReachesConditionAndBranch(t *testing.T) {
+	schema := When(Nil(), Any(), String())
+
+	result, err := schema.Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = When(String(), Any(), Nil()).Parse(nil)
+	require.NoError(t, err)
+
+	_, err = When(String(), Any(), String()).Parse(nil)
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.InvalidType, zodErr.Issues[0].Code)
+
+	_, err = When(Nil(), String(), Any()).Parse(nil)
+	require.Error(t, err)
+}
+
+func TestWhen_MissingBranchAcceptsInput(t *testing.T) {
+	kind := Object(core.ObjectSchema{"kind": Literal("card")}).Passthrough()
+	card := Object(core.ObjectSchema{
+		"kind":   String(),
+		"number": String().Min(12),
+	}).Passthrough()
+	schema := When(kind, card, nil)
+
+	input := map[string]any{"kind": "cash"}
+	result, err := schema.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	_, err = schema.Parse(map[string]any{"kind": "card"})
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, []any{"number"}, zodErr.Issues[0].Path)
+
+	onlyElse := When(String(), nil, Never())
+	_, err = onlyElse.Parse("anything")
+	require.NoError(t, err)
+	_, err = onlyElse.Parse(1)
+	require.Error(t, err)
+}
+
+func TestWhen_ConditionIssuesAreNotReported(t *testing.T) {
+	schema := When(String().Min(10), String().Email(), String())
+
+	result, err := schema.Parse("short")
+	require.NoError(t, err)
+	assert.Equal(t, "short", result)
+}
+
+func TestWhen_CanceledConditionDoesNotSelectElse(t *testing.T) {
+	live := func(ctx context.Context, _ string) bool { return ctx.Err() == nil }
+	schema := When(String().RefineContext(live), String(), Any())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := schema.ParseWithContext(ctx, "x")
+	var zodErr *issues.ZodError
+	require.True(t, issues.IsZodError(err, &zodErr))
+	require.Len(t, zodErr.Issues, 1)
+	assert.Equal(t, core.Canceled, zodErr.Issues[0].Code)
+}
+
+func TestWhen_Accessors(t *testing.T) {
+	condition := String()
+	then := String().Min(1)
+	schema := When(condition, then, nil)
+
+	assert.Same(t, condition, schema.Condition())
+	assert.Same(t, then, schema.Then())
+	assert.Nil(t, schema.Else())
+	assert.Equal(t, core.ZodTypeWhen, schema.Internals().Type)
+
+	assert.Panics(t, func() { When(nil, String(), nil) })
+}
+
+func TestWhen_Modifiers(t *testing.T) {
+	base := When(String(), String().Min(2), Int())
+
+	optional := base.Optional()
+	result, err := optional.Parse(nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.False(t, base.IsOptional())
+
+	defaulted := base.Default("zz")
+	value, err := defaulted.Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "zz", value)
+
+	refined := base.RefineAny(func(v any) bool { return v != "no" }, "rejected")
+	_, err = refined.Parse("no")
+	require.Error(t, err)
+	_, err = base.Parse("no")
+	require.NoError(t, err)
+
+	described := base.Describe("conditional")
+	assert.Equal(t, "conditional", described.Internals().Metadata().Description)
+	assert.Same(t, base.Condition(), described.Condition())
+}
+
+func TestWhen_CloneFrom(t *testing.T) {
+	source := When(String(), String().Min(2), nil).Describe("source")
+	target := When(Int(), nil, nil)
+	target.CloneFrom(source)
+
+	_, err := target.Parse("x")
+	require.Error(t, err)
+	_, err = target.Parse(5)
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"slices"
 
@@ -26,6 +27,8 @@ type ZodXorInternals struct {
 	core.ZodTypeInternals
 	Def     *ZodXorDef
 	Options []core.ZodSchema
+	// unevaluated validates what no accepting option evaluated, when set.
+	unevaluated unevaluatedSchemas
 }
 
 // ZodXor validates that input matches exactly one member schema.
@@ -88,7 +91,10 @@ func (z *ZodXor[T, R]) extractPtr(input any) (*any, bool) {
 // This is an unexported helper method for internal validation logic.
 func (z *ZodXor[T, R]) validate(input any, chks []core.ZodCheck, parseCtx *core.ParseContext) (any, error) {
 	successes := make([]any, 0, len(z.internals.Options))
-	var allErrors []error
+	var (
+		allErrors []error
+		accepted  core.ZodSchema
+	)
 
 	for i, option := range z.internals.Options {
 		if option == nil {
@@ -102,10 +108,19 @@ func (z *ZodXor[T, R]) validate(input any, chks []core.ZodCheck, parseCtx *core.
 		}
 
 		successes = append(successes, result)
+		accepted = option
 	}
 
 	switch len(successes) {
 	case 1:
+		if z.internals.unevaluated.isSet() {
+			evaluated := evaluatedBy(accepted, input, parseCtx)
+			closed, err := z.internals.unevaluated.apply(evaluated, input, successes[0], parseCtx)
+			if err != nil {
+				return nil, err
+			}
+			successes[0] = closed
+		}
 		if len(chks) > 0 {
 			return engine.ApplyChecks[any](successes[0], chks, parseCtx)
 		}
@@ -169,6 +184,18 @@ func (z *ZodXor[T, R]) ParseAny(input any, ctx ...*core.ParseContext) (any, erro
 	return z.Parse(input, ctx...)
 }
 
+// ParseWithContext validates input under the caller context, which
+// collection walks and refinements observe for cancellation.
+func (z *ZodXor[T, R]) ParseWithContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (R, error) {
+	return z.Parse(input, core.WithContext(ctx, parseCtx...))
+}
+
+// ParseAnyContext validates input under the caller context and returns an
+// untyped result.
+func (z *ZodXor[T, R]) ParseAnyContext(ctx context.Context, input any, parseCtx ...*core.ParseContext) (any, error) {
+	return z.ParseAny(input, core.WithContext(ctx, parseCtx...))
+}
+
 // =============================================================================
 // MODIFIER METHODS
 // =============================================================================
@@ -205,6 +232,7 @@ func (z *ZodXor[T, R]) NonOptional() *ZodXor[T, T] {
 			ZodTypeInternals: *in,
 			Def:              z.internals.Def,
 			Options:          z.internals.Options,
+			unevaluated:      z.internals.unevaluated,
 		},
 	}
 }
@@ -237,6 +265,22 @@ func (z *ZodXor[T, R]) PrefaultFunc(fn func() T) *ZodXor[T, R] {
 	return z.withInternals(in)
 }
 
+// Catch returns v in place of the parse result when validation fails. The
+// fallback bypasses validation.
+func (z *ZodXor[T, R]) Catch(v T) *ZodXor[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchValue(v)
+	return z.withInternals(in)
+}
+
+// CatchFunc calls fn with the failed input and its issues when validation
+// fails and returns its result in place of the parse result.
+func (z *ZodXor[T, R]) CatchFunc(fn func(core.CatchContext) T) *ZodXor[T, R] {
+	in := z.internals.Clone()
+	in.SetCatchFunc(func(ctx core.CatchContext) any { return fn(ctx) })
+	return z.withInternals(in)
+}
+
 // Meta attaches metadata to this schema.
 func (z *ZodXor[T, R]) Meta(meta core.GlobalMeta) *ZodXor[T, R] {
 	clone := z.withInternals(z.internals.Clone())
@@ -265,6 +309,49 @@ func (z *ZodXor[T, R]) Options() []core.ZodSchema {
 // TRANSFORMATION AND PIPELINE METHODS
 // =============================================================================
 
+// Unevaluated validates the object keys and array items that no accepting
+// option evaluated against schema, like JSON Schema unevaluatedProperties
+// and unevaluatedItems together. Unevaluated(Never()) closes the xor: keys
+// no accepting option knows are rejected as unrecognized. A nil schema
+// removes it.
+func (z *ZodXor[T, R]) Unevaluated(schema core.ZodSchema) *ZodXor[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated = unevaluatedSchemas{properties: schema, items: schema}
+	return clone
+}
+
+// UnevaluatedProperties is like Unevaluated for object keys only, like JSON
+// Schema unevaluatedProperties.
+func (z *ZodXor[T, R]) UnevaluatedProperties(schema core.ZodSchema) *ZodXor[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated.properties = schema
+	return clone
+}
+
+// UnevaluatedItems is like Unevaluated for array items only, like JSON Schema
+// unevaluatedItems.
+func (z *ZodXor[T, R]) UnevaluatedItems(schema core.ZodSchema) *ZodXor[T, R] {
+	clone := z.withInternals(z.internals.Clone())
+	clone.internals.unevaluated.items = schema
+	return clone
+}
+
+// UnevaluatedPropertiesSchema returns the schema for unevaluated object keys, or nil.
+func (z *ZodXor[T, R]) UnevaluatedPropertiesSchema() core.ZodSchema {
+	return z.internals.unevaluated.properties
+}
+
+// UnevaluatedItemsSchema returns the schema for unevaluated array items, or nil.
+func (z *ZodXor[T, R]) UnevaluatedItemsSchema() core.ZodSchema {
+	return z.internals.unevaluated.items
+}
+
+// evaluate reports what the accepting options and the unevaluated schemas
+// evaluate of value.
+func (z *ZodXor[T, R]) evaluate(value any, ctx *core.ParseContext) evaluation {
+	return z.internals.unevaluated.evaluation(evaluatedByAccepting(z.internals.Options, value, ctx))
+}
+
 // Transform creates a type-safe transformation pipeline.
 func (z *ZodXor[T, R]) Transform(fn func(T, *core.RefinementContext) (any, error)) *core.ZodTransform[R, any] {
 	wrapperFn := func(input R, ctx *core.RefinementContext) (any, error) {
@@ -287,14 +374,31 @@ func (z *ZodXor[T, R]) Pipe(target core.ZodType[any]) *core.ZodPipe[R, any] {
 
 // Refine adds a custom validation check with type-safe access to the parsed value.
 func (z *ZodXor[T, R]) Refine(fn func(R) bool, params ...any) *ZodXor[T, R] {
-	wrapper := func(v any) bool {
+	return z.refine(func(_ context.Context, v R) bool { return fn(v) }, false, params...)
+}
+
+// RefineContext is like Refine, but the callback also receives the caller
+// context passed to ParseWithContext.
+func (z *ZodXor[T, R]) RefineContext(fn func(context.Context, R) bool, params ...any) *ZodXor[T, R] {
+	return z.refine(fn, false, params...)
+}
+
+// RefineParallel is like RefineContext and marks the refinement safe for
+// concurrent use, so enclosing walks may fan it out on the parse worker pool.
+func (z *ZodXor[T, R]) RefineParallel(fn func(context.Context, R) bool, params ...any) *ZodXor[T, R] {
+	return z.refine(fn, true, params...)
+}
+
+// refine adds a custom check whose callback receives the caller context.
+func (z *ZodXor[T, R]) refine(fn func(context.Context, R) bool, parallel bool, params ...any) *ZodXor[T, R] {
+	wrapper := func(ctx context.Context, v any) bool {
 		cv, ok := convertToUnionConstraint[T, R](v)
 		if !ok {
 			return false
 		}
-		return fn(cv)
+		return fn(ctx, cv)
 	}
-	return z.withCheck(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)))
+	return z.withCheck(checks.WithParallel(checks.NewCustom[any](wrapper, utils.NormalizeCustomParams(params...)), parallel))
 }
 
 // RefineAny adds a custom validation check operating on the raw value.
@@ -332,6 +436,7 @@ func (z *ZodXor[T, R]) withPtrInternals(in *core.ZodTypeInternals) *ZodXor[T, *T
 			ZodTypeInternals: *in,
 			Def:              z.internals.Def,
 			Options:          z.internals.Options,
+			unevaluated:      z.internals.unevaluated,
 		},
 	}
 	finalizeClone(clone)
@@ -344,6 +449,7 @@ func (z *ZodXor[T, R]) withInternals(in *core.ZodTypeInternals) *ZodXor[T, R] {
 			ZodTypeInternals: *in,
 			Def:              z.internals.Def,
 			Options:          z.internals.Options,
+			unevaluated:      z.internals.unevaluated,
 		},
 	}
 	finalizeClone(clone)
@@ -361,6 +467,7 @@ func (z *ZodXor[T, R]) CloneFrom(source any) {
 			ZodTypeInternals: *src.internals.Clone(),
 			Def:              src.internals.Def,
 			Options:          src.internals.Options,
+			unevaluated:      src.internals.unevaluated,
 		}
 	})
 }